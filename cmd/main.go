@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	crdv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -44,10 +45,13 @@ import (
 	subnetipreservationcontroller "github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetipreservation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/subnetset"
+	"github.com/vmware-tanzu/nsx-operator/pkg/inspect"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/health"
 	inventoryservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipblocksinfo"
 	nodeservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/node"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+	servicelbservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/servicelb"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 	subnetservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	subnetbindingservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
@@ -61,6 +65,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
 	ipaddressallocationservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
+	securitypolicyservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
@@ -78,12 +83,16 @@ var (
 	restoreMode          = false
 )
 
+// restoreCommitCheckInterval is the interval to check if the restore dry run is committed.
+const restoreCommitCheckInterval = 10 * time.Second
+
 func init() {
 	var err error
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(crdv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	utilruntime.Must(vmv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
 	config.AddFlags()
 
 	cf, err = config.NewNSXOperatorConfigFromFile()
@@ -114,47 +123,33 @@ func init() {
 	}
 }
 
-func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
-	// Generate webhook certificates and start refreshing webhook certificates periodically
-	if cf.CoeConfig.EnableVPCNetwork {
-		if err := pkgutil.GenerateWebhookCerts(); err != nil {
-			log.Error(err, "Failed to generate webhook certificates")
-			os.Exit(1)
-		}
-		log.Info("Successfully generated webhook certificates")
-		go refreshCertPeriodically()
-	}
-
-	// Initialize and start the system health reporter
-	if cf.CoeConfig.EnableVPCNetwork && cf.EnableInventory && cf.CoeConfig.EnableSha {
-		health.Start(nsxClient, cf, mgr.GetClient())
-	}
+// nsxServices are the services which populate their stores from NSX when they are initialized. They only read NSX
+// in the initialization, so the standby replica could initialize them in advance to keep the stores warm. The
+// periodic sync of the IPBlocksInfo CR is only started on the master.
+type nsxServices struct {
+	commonService              common.Service
+	vpcService                 *vpc.VPCService
+	subnetService              *subnetservice.SubnetService
+	ipAddressAllocationService *ipaddressallocationservice.IPAddressAllocationService
+	subnetPortService          *subnetportservice.SubnetPortService
+	staticRouteService         *staticroute.StaticRouteService
+	dnsRecordService           *dns.DNSRecordService
+	subnetBindingService       *subnetbindingservice.BindingService
+	subnetIPReservationService *subnetipreservationservice.IPReservationService
+	serviceLBService           *servicelbservice.ServiceLBService
+	nodeService                *nodeservice.NodeService
+	ipblocksInfoService        *ipblocksinfo.IPBlocksInfoService
+	inventoryService           *inventoryservice.InventoryService
+}
 
+func initializeNSXServices(mgr manager.Manager, nsxClient *nsx.Client) *nsxServices {
 	//  Embed the common commonService to sub-services.
 	commonService := common.Service{
 		Client:    mgr.GetClient(),
 		NSXClient: nsxClient,
 		NSXConfig: cf,
 	}
-
-	checkLicense(nsxClient, cf.LicenseValidationInterval)
-
-	if cf.K8sConfig.EnableRestore && cf.CoeConfig.EnableVPCNetwork {
-		var err error
-		restoreMode, err = pkgutil.CompareNSXRestore(mgr.GetClient(), nsxClient)
-		if err != nil {
-			log.Error(err, "NSX restore check failed")
-			os.Exit(1)
-		}
-	} else {
-		restoreMode = false
-	}
-
-	var reconcilerList []pkgutil.ReconcilerProvider
-
-	var vpcService *vpc.VPCService
-	var hookServer webhook.Server
-	var subnetSetReconcile *subnetset.SubnetSetReconciler
+	services := &nsxServices{commonService: commonService}
 
 	if cf.CoeConfig.EnableVPCNetwork {
 		// Check NSX version for VPC networking mode
@@ -165,60 +160,123 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 		log.Info("VPC mode is enabled")
 
 		var err error
-		vpcService, err = vpc.InitializeVPC(commonService)
+		services.vpcService, err = vpc.InitializeVPC(commonService)
 		if err != nil {
 			log.Error(err, "Failed to initialize vpc commonService", "controller", "VPC")
 			os.Exit(1)
 		}
-		subnetService, err := subnetservice.InitializeSubnetService(commonService)
+		services.subnetService, err = subnetservice.InitializeSubnetService(commonService)
 		if err != nil {
 			log.Error(err, "Failed to initialize subnet commonService")
 			os.Exit(1)
 		}
-		ipAddressAllocationService, err := ipaddressallocationservice.InitializeIPAddressAllocation(commonService, vpcService, false)
+		services.ipAddressAllocationService, err = ipaddressallocationservice.InitializeIPAddressAllocation(commonService, services.vpcService, false)
 		if err != nil {
 			log.Error(err, "Failed to initialize ipaddressallocation commonService", "controller", "IPAddressAllocation")
 		}
-		subnetPortService, err := subnetportservice.InitializeSubnetPort(commonService, vpcService, ipAddressAllocationService)
+		services.subnetPortService, err = subnetportservice.InitializeSubnetPort(commonService, services.vpcService, services.ipAddressAllocationService)
 		if err != nil {
 			log.Error(err, "Failed to initialize subnetport commonService", "controller", "SubnetPort")
 			os.Exit(1)
 		}
-		nodeService, err := nodeservice.InitializeNode(commonService)
-		if err != nil {
-			log.Error(err, "Failed to initialize node commonService", "controller", "Node")
-			os.Exit(1)
-		}
-		staticRouteService, err := staticroute.InitializeStaticRoute(commonService, vpcService, ipAddressAllocationService)
+		services.staticRouteService, err = staticroute.InitializeStaticRoute(commonService, services.vpcService, services.ipAddressAllocationService)
 		if err != nil {
 			log.Error(err, "Failed to initialize staticroute commonService", "controller", "StaticRoute")
 			os.Exit(1)
 		}
-		dnsRecordService, err := dns.InitializeDNSRecordService(commonService, vpcService)
+		services.dnsRecordService, err = dns.InitializeDNSRecordService(commonService, services.vpcService)
 		if err != nil {
 			log.Error(err, "Failed to initialize DNS record service", "controller", "DNS")
 			os.Exit(1)
 		}
-		ipblocksInfoService := ipblocksinfo.InitializeIPBlocksInfoService(commonService, subnetService)
-
-		subnetBindingService, err := subnetbindingservice.InitializeService(commonService)
+		services.subnetBindingService, err = subnetbindingservice.InitializeService(commonService)
 		if err != nil {
 			log.Error(err, "Failed to initialize SubnetConnectionBindingMap commonService")
 			os.Exit(1)
 		}
-		var inventoryService *inventoryservice.InventoryService
+		services.subnetIPReservationService, err = subnetipreservationservice.InitializeService(commonService, services.subnetPortService)
+		if err != nil {
+			log.Error(err, "Failed to initialize SubnetIPReservation commonService", "controller", "SubnetIPReservation")
+			os.Exit(1)
+		}
+		if cf.EnableServiceLBRealization {
+			services.serviceLBService, err = servicelbservice.InitializeServiceLB(commonService, services.vpcService)
+			if err != nil {
+				log.Error(err, "Failed to initialize Service LB commonService", "controller", "ServiceLb")
+				os.Exit(1)
+			}
+		}
+		services.nodeService, err = nodeservice.InitializeNode(commonService)
+		if err != nil {
+			log.Error(err, "Failed to initialize node commonService", "controller", "Node")
+			os.Exit(1)
+		}
+		services.ipblocksInfoService = ipblocksinfo.NewIPBlocksInfoService(commonService, services.subnetService)
 		if cf.EnableInventory {
-			inventoryService, err = inventoryservice.InitializeService(commonService, false)
+			services.inventoryService, err = inventoryservice.InitializeService(commonService, false)
 			if err != nil {
 				log.Error(err, "Failed to initialize inventory commonService", "controller", "Inventory")
 				os.Exit(1)
 			}
 		}
-		subnetIPReservationService, err := subnetipreservationservice.InitializeService(commonService, subnetPortService)
+	}
+	// The SecurityPolicy service is a singleton shared by the SecurityPolicy and NetworkPolicy controllers.
+	securitypolicyservice.GetSecurityService(commonService, services.vpcService)
+	return services
+}
+
+func startServiceController(mgr manager.Manager, nsxClient *nsx.Client, services *nsxServices) {
+	// The license is checked only on the master, as the check exits the process if the license is invalid, which
+	// should not happen to the warm standby replica.
+	checkLicense(nsxClient, cf.LicenseValidationInterval)
+
+	// Generate webhook certificates and start refreshing webhook certificates periodically
+	if cf.CoeConfig.EnableVPCNetwork {
+		if err := pkgutil.GenerateWebhookCerts(); err != nil {
+			log.Error(err, "Failed to generate webhook certificates")
+			os.Exit(1)
+		}
+		log.Info("Successfully generated webhook certificates")
+		go refreshCertPeriodically()
+	}
+
+	// Initialize and start the system health reporter
+	if cf.CoeConfig.EnableVPCNetwork && cf.EnableInventory && cf.CoeConfig.EnableSha {
+		health.Start(nsxClient, cf, mgr.GetClient())
+	}
+
+	commonService := services.commonService
+
+	if cf.K8sConfig.EnableRestore && cf.CoeConfig.EnableVPCNetwork {
+		var err error
+		restoreMode, err = pkgutil.CompareNSXRestore(mgr.GetClient(), nsxClient)
 		if err != nil {
-			log.Error(err, "Failed to initialize SubnetIPReservation commonService", "controller", "SubnetIPReservation")
+			log.Error(err, "NSX restore check failed")
 			os.Exit(1)
 		}
+	} else {
+		restoreMode = false
+	}
+
+	var reconcilerList []pkgutil.ReconcilerProvider
+
+	vpcService := services.vpcService
+	var hookServer webhook.Server
+	var subnetSetReconcile *subnetset.SubnetSetReconciler
+
+	if cf.CoeConfig.EnableVPCNetwork {
+		subnetService := services.subnetService
+		ipAddressAllocationService := services.ipAddressAllocationService
+		subnetPortService := services.subnetPortService
+		staticRouteService := services.staticRouteService
+		dnsRecordService := services.dnsRecordService
+		subnetBindingService := services.subnetBindingService
+		subnetIPReservationService := services.subnetIPReservationService
+		serviceLBService := services.serviceLBService
+		nodeService := services.nodeService
+		ipblocksInfoService := services.ipblocksInfoService
+		go ipblocksInfoService.StartPeriodicSync()
+		inventoryService := services.inventoryService
 
 		if _, err := os.Stat(config.WebhookCertDir); errors.Is(err, os.ErrNotExist) {
 			log.Error(err, "Server cert not found, disabling webhook server", "cert", config.WebhookCertDir)
@@ -253,7 +311,7 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 			subnetport.NewSubnetPortReconciler(mgr, subnetPortService, subnetService, vpcService, ipAddressAllocationService),
 			pod.NewPodReconciler(mgr, subnetPortService, subnetService, vpcService, nodeService),
 			networkpolicycontroller.NewNetworkPolicyReconciler(mgr, commonService, vpcService),
-			service.NewServiceLbReconciler(mgr, commonService, dnsRecordService),
+			service.NewServiceLbReconciler(mgr, commonService, dnsRecordService, serviceLBService),
 			subnetbindingcontroller.NewReconciler(mgr, subnetService, subnetBindingService),
 			subnetipreservationcontroller.NewReconciler(mgr, subnetIPReservationService, subnetService),
 		)
@@ -281,7 +339,21 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 
 	if restoreMode {
 		subnetSetReconcile.EnableRestoreMode()
-		err := pkgutil.ProcessRestore(reconcilerList, mgr.GetClient())
+		dryRun, err := pkgutil.IsRestoreDryRun(mgr.GetClient())
+		if err != nil {
+			log.Error(err, "Failed to check restore dry run")
+			os.Exit(1)
+		}
+		if dryRun {
+			if err := pkgutil.ProcessRestoreDryRun(reconcilerList, mgr.GetClient()); err != nil {
+				log.Error(err, "Failed to dry run restore")
+			}
+			if err := pkgutil.WaitForRestoreCommit(mgr.GetClient(), restoreCommitCheckInterval); err != nil {
+				log.Error(err, "Failed to wait for restore commit")
+				os.Exit(1)
+			}
+		}
+		err = pkgutil.ProcessRestore(reconcilerList, mgr.GetClient())
 		if err != nil {
 			log.Error(err, "Failed to process restore")
 			os.Exit(1)
@@ -300,6 +372,9 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 		}
 	}
 
+	realizestate.StartRealizationWatcher(make(chan struct{}))
+	startStoreResync()
+
 	// Update pod labels to determine if this pod is the master
 	err := updatePodLabels(mgr)
 	if err != nil {
@@ -308,7 +383,21 @@ func startServiceController(mgr manager.Manager, nsxClient *nsx.Client) {
 	}
 }
 
+// startStoreResync starts resyncing the NSX stores periodically, it only starts once.
+func startStoreResync() {
+	if cf.StoreResyncPeriod > 0 {
+		common.StartStoreResync(time.Duration(cf.StoreResyncPeriod)*time.Second, make(chan struct{}))
+	}
+}
+
 func electMaster(mgr manager.Manager, nsxClient *nsx.Client) {
+	var services *nsxServices
+	if cf.WarmStandby {
+		// Keep the stores warm on the standby replica, so that the new master only validates them on failover.
+		log.Info("Initializing NSX services on standby")
+		services = initializeNSXServices(mgr, nsxClient)
+		startStoreResync()
+	}
 	log.Info("I'm trying to be elected as master")
 	<-mgr.Elected()
 	log.Info("I'm the master now")
@@ -321,7 +410,14 @@ func electMaster(mgr manager.Manager, nsxClient *nsx.Client) {
 	// ensuring a smooth transition.
 	log.Info("Waiting a 15-second delay to let the old instance know that it has lost its lease")
 	time.Sleep(15 * time.Second)
-	startServiceController(mgr, nsxClient)
+	if services == nil {
+		services = initializeNSXServices(mgr, nsxClient)
+	} else {
+		// Only the resources changed in NSX since the last resync are searched.
+		log.Info("Validating the warm NSX stores")
+		common.ResyncStores()
+	}
+	startServiceController(mgr, nsxClient, services)
 }
 
 func main() {
@@ -354,13 +450,22 @@ func main() {
 	if cf.HAEnabled() {
 		go electMaster(mgr, nsxClient)
 	} else {
-		go startServiceController(mgr, nsxClient)
+		go func() {
+			startServiceController(mgr, nsxClient, initializeNSXServices(mgr, nsxClient))
+		}()
 	}
 
 	if metrics.AreMetricsExposed(cf) {
 		go updateHealthMetricsPeriodically(nsxClient)
 	}
 
+	if cf.AuditPeriod > 0 && cf.CoeConfig.EnableVPCNetwork {
+		if err := addAuditor(mgr, nsxClient); err != nil {
+			log.Error(err, "Failed to add the NSX resource auditor")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", nsxClient.NSXChecker.CheckNSXHealth); err != nil {
 		log.Error(err, "Failed to set up health check")
 		os.Exit(1)
@@ -377,6 +482,16 @@ func main() {
 	}
 }
 
+// addAuditor adds the auditor of the orphaned NSX resources to the manager, it runs on the leader. The auditor uses
+// an uncached client to avoid the informers of all the owner kinds.
+func addAuditor(mgr manager.Manager, nsxClient *nsx.Client) error {
+	k8sClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		return err
+	}
+	return mgr.Add(inspect.NewAuditor(nsxClient, k8sClient, cf, time.Duration(cf.AuditPeriod)*time.Second))
+}
+
 // Function for fetching nsx health status and feeding it to the prometheus metric.
 func getHealthStatus(nsxClient *nsx.Client) error {
 	status := 1
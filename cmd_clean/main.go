@@ -29,54 +29,17 @@ import (
 //
 //	./clean -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx'  -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -envoyhost=localhost -envoyport=1080 -log-level=1 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868
 var (
-	log         logger.CustomLogger
-	cf          *config.NSXOperatorConfig
-	mgrIp       string
-	vcEndpoint  string
-	vcUser      string
-	vcPasswd    string
-	nsxUser     string
-	nsxPasswd   string
-	vcSsoDomain string
-	vcHttpsPort int
-	thumbprint  string
-	caFile      string
-	cluster     string
-	envoyHost   string
-	envoyPort   int
+	log       logger.CustomLogger
+	cf        *config.NSXOperatorConfig
+	connFlags config.ConnectionFlags
 )
 
 func main() {
-	flag.StringVar(&vcEndpoint, "vc-endpoint", "", "vc endpoint")
-	flag.StringVar(&vcSsoDomain, "vc-sso-domain", "", "vc sso domain")
-	flag.StringVar(&mgrIp, "mgr-ip", "", "nsx manager ip, it should be host name if want to verify cert")
-	flag.StringVar(&vcUser, "vc-user", "", "vc username")
-	flag.StringVar(&vcPasswd, "vc-passwd", "", "vc password")
-	flag.IntVar(&vcHttpsPort, "vc-https-port", 443, "vc https port")
-	flag.StringVar(&thumbprint, "thumbprint", "", "nsx thumbprint")
-	flag.StringVar(&nsxUser, "nsx-user", "", "nsx username")
-	flag.StringVar(&nsxPasswd, "nsx-passwd", "", "nsx password")
-	flag.StringVar(&caFile, "ca-file", "", "ca file")
-	flag.StringVar(&cluster, "cluster", "", "cluster name")
-	flag.StringVar(&envoyHost, "envoyhost", "", "envoy host")
-	flag.IntVar(&envoyPort, "envoyport", 0, "envoy port")
+	connFlags.AddFlags(flag.CommandLine)
 	flag.IntVar(&config.LogLevel, "log-level", 2, "Use zap-core log system.")
 	flag.Parse()
 
-	cf = config.NewNSXOpertorConfig()
-	cf.NsxApiManagers = []string{mgrIp}
-	cf.VCUser = vcUser
-	cf.VCPassword = vcPasswd
-	cf.VCEndPoint = vcEndpoint
-	cf.NsxApiUser = nsxUser
-	cf.NsxApiPassword = nsxPasswd
-	cf.SsoDomain = vcSsoDomain
-	cf.HttpsPort = vcHttpsPort
-	cf.Thumbprint = []string{thumbprint}
-	cf.CaFile = []string{caFile}
-	cf.Cluster = cluster
-	cf.EnvoyHost = envoyHost
-	cf.EnvoyPort = envoyPort
+	cf = connFlags.NewNSXOperatorConfig()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
 	defer cancel()
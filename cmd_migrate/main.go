@@ -0,0 +1,128 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/migrate"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// usage:
+// export the NSX resources and the CRs of a Namespace, the kubeconfig of the source cluster is used:
+//
+//	./migrate -mode=export -namespace=ns-1 -file=ns-1.yaml -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx' -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868
+//
+// import the bundle to another cluster and project, the kubeconfig of the target cluster is used and nsx-operator
+// should be stopped in the target cluster during the import:
+//
+//	./migrate -mode=import -file=ns-1.yaml -target-cluster=domain-c10:0d5e1c9d-2b55-4d4e-a5a6-0d9c1d6b3f5e -target-project=/orgs/default/projects/p2 -target-namespace=ns-1 -nsx-user=admin -nsx-passwd='xxx' -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868
+const (
+	modeExport = "export"
+	modeImport = "import"
+)
+
+var (
+	log             logger.CustomLogger
+	cf              *config.NSXOperatorConfig
+	connFlags       config.ConnectionFlags
+	scheme          = runtime.NewScheme()
+	mode            string
+	namespace       string
+	file            string
+	targetCluster   string
+	targetProject   string
+	targetNamespace string
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+}
+
+func main() {
+	flag.StringVar(&mode, "mode", modeExport, "export or import")
+	flag.StringVar(&namespace, "namespace", "", "the Namespace to export")
+	flag.StringVar(&file, "file", "", "the bundle file, the .yaml or .yml extension selects the YAML format, otherwise JSON is used")
+	flag.StringVar(&targetCluster, "target-cluster", "", "the cluster name the NSX resources are tagged with on import, the exported cluster is used if empty")
+	flag.StringVar(&targetProject, "target-project", "", "the NSX project path the VPCs are imported to, the exported project is used if empty")
+	flag.StringVar(&targetNamespace, "target-namespace", "", "the Namespace the CRs are imported to, the exported Namespace is used if empty")
+	connFlags.AddFlags(flag.CommandLine)
+	flag.IntVar(&config.LogLevel, "log-level", 2, "Use zap-core log system.")
+	flag.Parse()
+
+	cf = connFlags.NewNSXOperatorConfig()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*10)
+	defer cancel()
+	log = logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
+	logger.Log = log
+	logf.SetLogger(log.Logger)
+	if err := run(ctx); err != nil {
+		log.Error(err, "Failed to migrate nsx resources", "mode", mode)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func run(ctx context.Context) error {
+	if file == "" {
+		return errors.New("file is required")
+	}
+	if mode != modeExport && mode != modeImport {
+		return fmt.Errorf("unsupported mode %q", mode)
+	}
+	if mode == modeExport && namespace == "" {
+		return errors.New("namespace is required for export")
+	}
+	if err := cf.ValidateConfigFromCmd(); err != nil {
+		return errors.Join(nsxutil.ValidationFailed, err)
+	}
+	cf.LibMode = true
+	nsxClient := nsx.GetClient(cf)
+	if nsxClient == nil {
+		return nsxutil.GetNSXClientFailed
+	}
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+
+	if mode == modeExport {
+		bundle, err := migrate.NewExporter(nsxClient, k8sClient).Export(ctx, namespace)
+		if err != nil {
+			return err
+		}
+		if err := migrate.WriteBundle(bundle, file); err != nil {
+			return err
+		}
+		log.Info("Exported Namespace", "namespace", namespace, "file", file, "nsxResources", len(bundle.NSXResources), "crs", len(bundle.CRs))
+		return nil
+	}
+	bundle, err := migrate.ReadBundle(file)
+	if err != nil {
+		return err
+	}
+	options := migrate.ImportOptions{Cluster: targetCluster, ProjectPath: targetProject, Namespace: targetNamespace}
+	if err := migrate.NewImporter(nsxClient, k8sClient).Import(ctx, bundle, options); err != nil {
+		return err
+	}
+	log.Info("Imported Namespace", "file", file, "nsxResources", len(bundle.NSXResources), "crs", len(bundle.CRs))
+	return nil
+}
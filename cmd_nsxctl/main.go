@@ -0,0 +1,201 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	legacyv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/inspect"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// usage:
+//
+//	./nsxctl [flags] owned <kind> [<namespace>/]<name>   list the NSX resources owned by the object, e.g. "owned Subnet ns-1/subnet-1"
+//	./nsxctl [flags] owner <nsx-path>                    show the object owning the NSX resource
+//	./nsxctl [flags] rules <namespace>/<pod>             list the NSX rules of the policies selecting the Pod
+//	./nsxctl [flags] orphans                             list the NSX resources whose owners do not exist
+//
+// the NSX connection flags are the same as the clean command, e.g.
+//
+//	./nsxctl -cluster=domain-c9:d75735a3-2847-45d2-a652-ef2d146afd54 -nsx-user=admin -nsx-passwd='xxx' -mgr-ip=nsxmanager-ob-22386469-1-dev-integ-nsxt-8791 -thumbprint=8bc2fa2b5879c27b1180fa44e5f747832f2ded6be483e3c3d2c4816a38870868 owner /orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+var (
+	log       logger.CustomLogger
+	cf        *config.NSXOperatorConfig
+	connFlags config.ConnectionFlags
+	scheme    = runtime.NewScheme()
+	output    string
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(legacyv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	flag.StringVar(&output, "o", outputTable, "output format, table or json")
+	connFlags.AddFlags(flag.CommandLine)
+	flag.IntVar(&config.LogLevel, "log-level", 0, "Use zap-core log system.")
+	flag.Parse()
+
+	cf = connFlags.NewNSXOperatorConfig()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	log = logger.ZapCustomLogger(cf.DefaultConfig.Debug, config.LogLevel)
+	logger.Log = log
+	logf.SetLogger(log.Logger)
+	if err := run(ctx, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func run(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("command is required, one of owned, owner, rules and orphans")
+	}
+	if output != outputTable && output != outputJSON {
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+	if err := cf.ValidateConfigFromCmd(); err != nil {
+		return errors.Join(nsxutil.ValidationFailed, err)
+	}
+	cf.LibMode = true
+	nsxClient := nsx.GetClient(cf)
+	if nsxClient == nil {
+		return nsxutil.GetNSXClientFailed
+	}
+	k8sClient, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+	inspector := inspect.NewInspector(nsxClient, k8sClient)
+
+	switch command := args[0]; command {
+	case "owned":
+		if len(args) != 3 {
+			return errors.New("usage: owned <kind> [<namespace>/]<name>")
+		}
+		namespace, name := splitNamespacedName(args[2])
+		resources, err := inspector.OwnedResources(ctx, args[1], namespace, name)
+		if err != nil {
+			return err
+		}
+		return printResult(out, resources, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tPATH\tDISPLAY NAME")
+			for _, resource := range resources {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", resource.ResourceType, resource.Path, resource.DisplayName)
+			}
+		})
+	case "owner":
+		if len(args) != 2 {
+			return errors.New("usage: owner <nsx-path>")
+		}
+		resource, owner, err := inspector.Owner(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		result := struct {
+			Resource *inspect.Resource `json:"resource"`
+			Owner    *inspect.Owner    `json:"owner"`
+		}{Resource: resource, Owner: owner}
+		return printResult(out, result, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tPATH\tOWNER KIND\tOWNER\tOWNER UID\tEXISTS")
+			if owner == nil {
+				fmt.Fprintf(w, "%s\t%s\t<none>\t\t\t\n", resource.ResourceType, resource.Path)
+				return
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n", resource.ResourceType, resource.Path, owner.Kind,
+				namespacedName(owner.Namespace, owner.Name), owner.UID, owner.Exists)
+		})
+	case "rules":
+		if len(args) != 2 {
+			return errors.New("usage: rules <namespace>/<pod>")
+		}
+		namespace, name := splitNamespacedName(args[1])
+		policies, err := inspector.EffectiveRules(ctx, namespace, name)
+		if err != nil {
+			return err
+		}
+		return printResult(out, policies, func(w io.Writer) {
+			fmt.Fprintln(w, "POLICY KIND\tPOLICY\tSEQUENCE\tACTION\tDIRECTION\tRULE PATH")
+			for _, policy := range policies {
+				for _, rule := range policy.Rules {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\n", policy.Policy.Kind,
+						namespacedName(policy.Policy.Namespace, policy.Policy.Name), rule.SequenceNumber, rule.Action,
+						rule.Direction, rule.Path)
+				}
+			}
+		})
+	case "orphans":
+		orphans, err := inspector.Orphans(ctx)
+		if err != nil {
+			return err
+		}
+		return printResult(out, orphans, func(w io.Writer) {
+			fmt.Fprintln(w, "TYPE\tPATH\tOWNER KIND\tOWNER NAMESPACE\tOWNER UID")
+			for _, orphan := range orphans {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", orphan.Resource.ResourceType, orphan.Resource.Path,
+					orphan.Owner.Kind, orphan.Owner.Namespace, orphan.Owner.UID)
+			}
+		})
+	default:
+		return fmt.Errorf("unknown command %q, one of owned, owner, rules and orphans", command)
+	}
+}
+
+// printResult writes the result in JSON, or as the table written by printTable.
+func printResult(out io.Writer, result interface{}, printTable func(w io.Writer)) error {
+	if output == outputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	printTable(w)
+	return w.Flush()
+}
+
+func splitNamespacedName(value string) (string, string) {
+	if namespace, name, found := strings.Cut(value, "/"); found {
+		return namespace, name
+	}
+	return "", value
+}
+
+func namespacedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
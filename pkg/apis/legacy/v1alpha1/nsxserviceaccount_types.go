@@ -12,8 +12,22 @@ type NSXServiceAccountSpec struct {
 	VPCName string `json:"vpcName,omitempty"`
 	// EnableCertRotation enables cert rotation feature in this cluster when NSXT >=4.1.3
 	EnableCertRotation bool `json:"enableCertRotation,omitempty"`
+	// CredentialMode is the type of credential used to access NSX. With Certificate, a client cert is generated
+	// and stored in the Secret in status. With Token, the bearer token of the ServiceAccount with the same name as the
+	// NSXServiceAccount is stored in the Secret in status and refreshed before it expires.
+	// +kubebuilder:validation:Enum=Certificate;Token
+	// +kubebuilder:default=Certificate
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="credentialMode is immutable"
+	CredentialMode NSXServiceAccountCredentialMode `json:"credentialMode,omitempty"`
 }
 
+type NSXServiceAccountCredentialMode string
+
+const (
+	NSXServiceAccountCredentialModeCertificate NSXServiceAccountCredentialMode = "Certificate"
+	NSXServiceAccountCredentialModeToken       NSXServiceAccountCredentialMode = "Token"
+)
+
 type NSXProxyEndpointAddress struct {
 	Hostname string `json:"hostname,omitempty"`
 	//+kubebuilder:validation:Format=ip
@@ -74,6 +88,10 @@ type NSXServiceAccountStatus struct {
 	ClusterName      string             `json:"clusterName,omitempty"`
 	Secrets          []NSXSecret        `json:"secrets,omitempty"`
 	NSXRestoreStatus *NSXRestoreStatus  `json:"nsxRestoreStatus,omitempty"`
+	// CertNotAfter is the expiry time of the current client cert.
+	CertNotAfter *metav1.Time `json:"certNotAfter,omitempty"`
+	// LastCertRotationTime is the time when the client cert was rotated last time.
+	LastCertRotationTime *metav1.Time `json:"lastCertRotationTime,omitempty"`
 }
 
 // +genclient
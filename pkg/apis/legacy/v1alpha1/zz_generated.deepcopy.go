@@ -228,6 +228,14 @@ func (in *NSXServiceAccountStatus) DeepCopyInto(out *NSXServiceAccountStatus) {
 		*out = new(NSXRestoreStatus)
 		**out = **in
 	}
+	if in.CertNotAfter != nil {
+		in, out := &in.CertNotAfter, &out.CertNotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCertRotationTime != nil {
+		in, out := &in.LastCertRotationTime, &out.LastCertRotationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXServiceAccountStatus.
@@ -16,6 +16,7 @@ const (
 	ExternalIPBlocksConfigured ConditionType = "ExternalIPBlocksConfigured"
 	DeleteFailure              ConditionType = "DeletionFailed"
 	UpdateFailure              ConditionType = "UpdateFailed"
+	Migrated                   ConditionType = "Migrated"
 )
 
 // Condition defines condition of custom resource.
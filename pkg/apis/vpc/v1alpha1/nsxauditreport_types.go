@@ -0,0 +1,73 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope="Cluster",path=nsxauditreports
+//+kubebuilder:printcolumn:name="Orphans",type=integer,JSONPath=`.status.orphanCount`,description="Number of the orphaned NSX resources"
+//+kubebuilder:printcolumn:name="DanglingReferences",type=integer,JSONPath=`.status.danglingReferenceCount`,description="Number of the dangling NSX references"
+//+kubebuilder:printcolumn:name="LastAuditTime",type=date,JSONPath=`.status.lastAuditTime`,description="Time of the last audit"
+
+// NSXAuditReport is the Schema for the nsxauditreports API. It reports the NSX resources created by nsx-operator whose
+// owners do not exist and the dangling references found by the periodic audit, the object named nsx-operator-audit
+// is used. The audit never deletes the NSX resources.
+type NSXAuditReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status NSXAuditReportStatus `json:"status,omitempty"`
+}
+
+// NSXAuditReportStatus defines the observed state of NSXAuditReport.
+type NSXAuditReportStatus struct {
+	// LastAuditTime is the time the last audit finished.
+	LastAuditTime *metav1.Time `json:"lastAuditTime,omitempty"`
+	// Error is the error of the last audit, the results of the previous audit are kept if it failed.
+	Error string `json:"error,omitempty"`
+	// OrphanCount is the number of the NSX resources tagged with the cluster whose owners do not exist.
+	OrphanCount int `json:"orphanCount,omitempty"`
+	// Orphans are the orphaned NSX resources, truncated to 100 items.
+	Orphans []AuditedNSXResource `json:"orphans,omitempty"`
+	// DanglingReferenceCount is the number of the dangling references.
+	DanglingReferenceCount int `json:"danglingReferenceCount,omitempty"`
+	// DanglingReferences are the NSX resources which are not referenced or reference nothing, e.g. the Groups
+	// referenced by no Rule, truncated to 100 items.
+	DanglingReferences []AuditedNSXResource `json:"danglingReferences,omitempty"`
+}
+
+// AuditedNSXResource is an NSX resource reported by the audit.
+type AuditedNSXResource struct {
+	// ResourceType of the NSX resource.
+	ResourceType string `json:"resourceType"`
+	// Path of the NSX resource.
+	Path string `json:"path"`
+	// OwnerKind is the kind of the owner of the orphaned NSX resource.
+	OwnerKind string `json:"ownerKind,omitempty"`
+	// OwnerNamespace is the Namespace of the owner of the orphaned NSX resource.
+	OwnerNamespace string `json:"ownerNamespace,omitempty"`
+	// OwnerUID is the UID of the owner of the orphaned NSX resource.
+	OwnerUID string `json:"ownerUID,omitempty"`
+	// Reason of the dangling reference.
+	Reason string `json:"reason,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NSXAuditReportList contains a list of NSXAuditReport.
+type NSXAuditReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NSXAuditReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NSXAuditReport{}, &NSXAuditReportList{})
+}
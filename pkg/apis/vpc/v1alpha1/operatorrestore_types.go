@@ -0,0 +1,97 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RestorePhase is the phase of the operator restore or of a reconciler in the restore.
+type RestorePhase string
+
+const (
+	RestorePhasePending         RestorePhase = "Pending"
+	RestorePhaseRunning         RestorePhase = "Running"
+	RestorePhaseSucceeded       RestorePhase = "Succeeded"
+	RestorePhaseFailed          RestorePhase = "Failed"
+	RestorePhaseDryRunCompleted RestorePhase = "DryRunCompleted"
+	// RestorePhaseSkipped is set for the reconciler which does not support the dry run.
+	RestorePhaseSkipped RestorePhase = "Skipped"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope="Cluster",path=operatorrestores
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`,description="Phase of the restore"
+//+kubebuilder:printcolumn:name="DryRun",type=boolean,JSONPath=`.status.dryRun`,description="Whether the restore is a dry run"
+//+kubebuilder:printcolumn:name="StartTime",type=date,JSONPath=`.status.startTime`,description="Start time of the restore"
+//+kubebuilder:printcolumn:name="EndTime",type=date,JSONPath=`.status.endTime`,description="End time of the restore"
+
+// OperatorRestore is the Schema for the operatorrestores API. It records the progress of the nsx-operator restore
+// after NSX is restored from a backup, the object named nsx-operator-restore is used.
+type OperatorRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperatorRestoreSpec   `json:"spec,omitempty"`
+	Status OperatorRestoreStatus `json:"status,omitempty"`
+}
+
+// OperatorRestoreSpec defines the desired state of OperatorRestore.
+type OperatorRestoreSpec struct {
+	// DryRun makes the restore only report the CRs which would be re-realized and the NSX resources which would be
+	// deleted by the garbage collection, without changing NSX. The restore is committed once DryRun is set to false.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// OperatorRestoreStatus defines the observed state of OperatorRestore.
+type OperatorRestoreStatus struct {
+	// Phase of the restore.
+	Phase RestorePhase `json:"phase,omitempty"`
+	// DryRun is true if the status is reported by a dry run.
+	DryRun bool `json:"dryRun,omitempty"`
+	// StartTime is the time the restore started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time the restore finished.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// Reconcilers is the progress of each reconciler, in the order of the restore.
+	Reconcilers []ReconcilerRestoreStatus `json:"reconcilers,omitempty"`
+}
+
+// ReconcilerRestoreStatus is the restore progress of a reconciler.
+type ReconcilerRestoreStatus struct {
+	// Name of the reconciler.
+	Name string `json:"name"`
+	// GarbageCollection is the phase of the garbage collection of the reconciler.
+	GarbageCollection RestorePhase `json:"garbageCollection,omitempty"`
+	// Restore is the phase of re-realizing the CRs of the reconciler.
+	Restore RestorePhase `json:"restore,omitempty"`
+	// Error is the error of the garbage collection or the restore.
+	Error string `json:"error,omitempty"`
+	// CRsToRestore is the namespaced names of the CRs which would be re-realized, it is reported by the dry run,
+	// kept when the restore is committed, and truncated to 100 items.
+	CRsToRestore []string `json:"crsToRestore,omitempty"`
+	// CRsToRestoreCount is the number of the CRs which would be re-realized.
+	CRsToRestoreCount int `json:"crsToRestoreCount,omitempty"`
+	// NSXResourcesToDelete is the paths of the NSX resources which would be deleted by the garbage collection, it is
+	// reported by the dry run, kept when the restore is committed, and truncated to 100 items.
+	NSXResourcesToDelete []string `json:"nsxResourcesToDelete,omitempty"`
+	// NSXResourcesToDeleteCount is the number of the NSX resources which would be deleted.
+	NSXResourcesToDeleteCount int `json:"nsxResourcesToDeleteCount,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OperatorRestoreList contains a list of OperatorRestore.
+type OperatorRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorRestore{}, &OperatorRestoreList{})
+}
@@ -27,6 +27,14 @@ type StaticRouteSpec struct {
 	// Next hop gateway
 	// +kubebuilder:validation:MinItems=1
 	NextHops []NextHop `json:"nextHops"`
+	// Description of the static route on NSX.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Tags added to the static route on NSX, in addition to the tags set by NSX Operator.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Tags []StaticRouteTag `json:"tags,omitempty"`
 }
 
 // NextHop defines next hop configuration for network.
@@ -34,6 +42,24 @@ type NextHop struct {
 	// Next hop gateway IP address.
 	// +kubebuilder:validation:Format=ip
 	IPAddress string `json:"ipAddress"`
+	// Administrative distance of the next hop. The next hops with the lowest
+	// distance are used, and the others are backup paths. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	AdminDistance int64 `json:"adminDistance,omitempty"`
+}
+
+// StaticRouteTag defines a tag added to the static route on NSX.
+// +kubebuilder:validation:XValidation:rule="!has(self.scope) || !self.scope.startsWith('nsx-op/')",message="tag scope prefix nsx-op/ is reserved"
+type StaticRouteTag struct {
+	// Scope of the tag.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	Scope string `json:"scope,omitempty"`
+	// Value of the tag.
+	// +kubebuilder:validation:MaxLength=256
+	Tag string `json:"tag"`
 }
 
 // StaticRouteStatus defines the observed state of StaticRoute.
@@ -50,6 +76,8 @@ type StaticRouteStatus struct {
 // +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.network`,description="Network in CIDR format"
 // +kubebuilder:printcolumn:name="NetworkIPAllocationName",type=string,JSONPath=`.spec.networkIpAllocationName`,description="IPAddressAllocation CR name"
 // +kubebuilder:printcolumn:name="NextHops",type=string,JSONPath=`.spec.nextHops[*].ipAddress`,description="Next Hops"
+// +kubebuilder:printcolumn:name="AdminDistances",type=string,JSONPath=`.spec.nextHops[*].adminDistance`,description="Administrative distances of the Next Hops"
+// +kubebuilder:printcolumn:name="Description",type=string,JSONPath=`.spec.description`,description="Description of the static route",priority=1
 type StaticRoute struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -98,6 +98,9 @@ type SubnetStatus struct {
 	// +kubebuilder:default=false
 	Shared     bool        `json:"shared,omitempty"`
 	Conditions []Condition `json:"conditions,omitempty"`
+	// VPCFullID is the full ID of the VPC of the Subnet resolved from spec.vpcName, in the
+	// format projectID:vpcID.
+	VPCFullID string `json:"vpcFullID,omitempty"`
 }
 
 // +genclient
@@ -145,6 +148,30 @@ type SubnetAdvancedConfig struct {
 	// Supports up to 2 addresses for dual-stack Subnets (1 IPv4 + 1 IPv6).
 	// +kubebuilder:validation:MaxItems=2
 	DHCPServerAddresses []string `json:"dhcpServerAddresses,omitempty"`
+	// SegmentProfiles specifies the NSX segment profiles bound to the Subnet.
+	SegmentProfiles SegmentProfiles `json:"segmentProfiles,omitempty"`
+}
+
+// SegmentProfiles defines the NSX segment profiles bound to a Subnet or SubnetPort.
+// Each profile is referenced by its NSX policy path, e.g. "/infra/mac-discovery-profiles/mac-learning"
+// or "/orgs/default/projects/proj-1/infra/mac-discovery-profiles/mac-learning", and must exist in NSX.
+// The NSX default profile is used if a profile is not set.
+type SegmentProfiles struct {
+	// Path of the segment security profile.
+	// +kubebuilder:validation:MaxLength=1024
+	SegmentSecurityProfile string `json:"segmentSecurityProfile,omitempty"`
+	// Path of the SpoofGuard profile.
+	// +kubebuilder:validation:MaxLength=1024
+	SpoofGuardProfile string `json:"spoofGuardProfile,omitempty"`
+	// Path of the IP discovery profile.
+	// +kubebuilder:validation:MaxLength=1024
+	IPDiscoveryProfile string `json:"ipDiscoveryProfile,omitempty"`
+	// Path of the MAC discovery profile.
+	// +kubebuilder:validation:MaxLength=1024
+	MACDiscoveryProfile string `json:"macDiscoveryProfile,omitempty"`
+	// Path of the QoS profile.
+	// +kubebuilder:validation:MaxLength=1024
+	QoSProfile string `json:"qosProfile,omitempty"`
 }
 
 type StaticIPAllocation struct {
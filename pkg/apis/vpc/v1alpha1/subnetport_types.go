@@ -4,6 +4,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,12 +18,20 @@ const (
 )
 
 // +kubebuilder:validation:XValidation:rule="!has(self.subnetSet) || !has(self.subnet)",message="Only one of subnet or subnetSet can be specified or both set to empty in which case default SubnetSet for VM will be used"
+// +kubebuilder:validation:XValidation:rule="!has(self.bandwidth) || !has(self.segmentProfiles) || !has(self.segmentProfiles.qosProfile)",message="bandwidth and segmentProfiles.qosProfile cannot be specified together"
 // SubnetPortSpec defines the desired state of SubnetPort.
 type SubnetPortSpec struct {
 	// Subnet defines the parent Subnet name of the SubnetPort.
+	// Changing the parent Subnet or SubnetSet of a realized SubnetPort migrates it to the new parent,
+	// the attachment ID and MAC address of the SubnetPort are preserved. The IP addresses are also preserved
+	// if the SubnetPort is annotated with nsx.vmware.com/preserve-ip-on-migration: "true" and the new Subnet contains them.
 	Subnet string `json:"subnet,omitempty"`
 	// SubnetSet defines the parent SubnetSet name of the SubnetPort.
 	SubnetSet string `json:"subnetSet,omitempty"`
+	// VPCName defines the VPC of the SubnetPort. It is the name of an additional VPC in
+	// the VPCNetworkConfiguration of the Namespace, and the parent Subnet or SubnetSet
+	// must belong to it. The primary VPC is used if it is not set.
+	VPCName string `json:"vpcName,omitempty"`
 	// AddressBindings defines static address bindings used for the SubnetPort.
 	AddressBindings []PortAddressBinding `json:"addressBindings,omitempty"`
 	// InterfaceIPType decides the address families of static IP allocation, when
@@ -38,6 +47,21 @@ type SubnetPortSpec struct {
 	// will be back-filled based on InterfaceIPType and Subnet configuration.
 	// +kubebuilder:validation:Enum=IPv4;IPv6;IPv4IPv6;None
 	StaticIPAllocationType StaticIPAllocationType `json:"staticIPAllocationType,omitempty"`
+	// SegmentProfiles specifies the NSX segment profiles bound to the SubnetPort,
+	// which override the profiles bound to the parent Subnet.
+	SegmentProfiles SegmentProfiles `json:"segmentProfiles,omitempty"`
+	// Bandwidth specifies the bandwidth limits of the SubnetPort, which are realized
+	// with an NSX QoS profile bound to the SubnetPort.
+	Bandwidth *PortBandwidth `json:"bandwidth,omitempty"`
+}
+
+// PortBandwidth defines the bandwidth limits of the Port in bits per second, e.g. "10M".
+// The limits are rounded down to Mbps, and must be at least 1M.
+type PortBandwidth struct {
+	// Ingress is the bandwidth limit of the traffic received by the Port.
+	Ingress *resource.Quantity `json:"ingress,omitempty"`
+	// Egress is the bandwidth limit of the traffic sent by the Port.
+	Egress *resource.Quantity `json:"egress,omitempty"`
 }
 
 // PortAddressBinding defines static addresses for the Port.
@@ -55,6 +79,16 @@ type SubnetPortStatus struct {
 	// SubnetPort attachment state.
 	Attachment             PortAttachment         `json:"attachment,omitempty"`
 	NetworkInterfaceConfig NetworkInterfaceConfig `json:"networkInterfaceConfig,omitempty"`
+	// RealizedParent is the parent Subnet or SubnetSet in the spec which the SubnetPort is realized on.
+	RealizedParent *SubnetPortParent `json:"realizedParent,omitempty"`
+}
+
+// SubnetPortParent defines the parent of the SubnetPort. The default SubnetSet is used if both are empty.
+type SubnetPortParent struct {
+	// Subnet is the parent Subnet name of the SubnetPort.
+	Subnet string `json:"subnet,omitempty"`
+	// SubnetSet is the parent SubnetSet name of the SubnetPort.
+	SubnetSet string `json:"subnetSet,omitempty"`
 }
 
 // VIF attachment state of a SubnetPort.
@@ -26,6 +26,13 @@ type VPCNetworkConfigurationSpec struct {
 	// NSX Project the Namespace is associated with.
 	NSXProject string `json:"nsxProject,omitempty"`
 
+	// Additional pre-created VPCs the Namespace is associated with besides the primary VPC.
+	// Subnets, SubnetSets and SubnetPorts select one of them by setting vpcName to its name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AdditionalVPCs []AdditionalVPC `json:"additionalVPCs,omitempty"`
+
 	// VPCConnectivityProfile Path. This profile has configuration related to creating VPC transit gateway attachment.
 	VPCConnectivityProfile string `json:"vpcConnectivityProfile,omitempty"`
 
@@ -62,6 +69,16 @@ type SharedSubnet struct {
 	Name string `json:"name,omitempty"`
 }
 
+// AdditionalVPC defines a pre-created VPC attached to the Namespace in addition to the primary VPC.
+type AdditionalVPC struct {
+	// Name of the VPC in the Namespace, referred by the vpcName of Subnets, SubnetSets and SubnetPorts.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// NSX path of the VPC.
+	VPC string `json:"vpc"`
+}
+
 // VPCNetworkConfigurationStatus defines the observed state of VPCNetworkConfiguration
 type VPCNetworkConfigurationStatus struct {
 	// VPCs describes VPC info, now it includes Load Balancer Subnet info which are needed
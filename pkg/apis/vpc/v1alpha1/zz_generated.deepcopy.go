@@ -12,6 +12,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalVPC) DeepCopyInto(out *AdditionalVPC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalVPC.
+func (in *AdditionalVPC) DeepCopy() *AdditionalVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressBinding) DeepCopyInto(out *AddressBinding) {
 	*out = *in
@@ -108,6 +123,21 @@ func (in *AddressBindingStatus) DeepCopy() *AddressBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditedNSXResource) DeepCopyInto(out *AuditedNSXResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditedNSXResource.
+func (in *AuditedNSXResource) DeepCopy() *AuditedNSXResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditedNSXResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -367,6 +397,93 @@ func (in *IPPoolRange) DeepCopy() *IPPoolRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXAuditReport) DeepCopyInto(out *NSXAuditReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXAuditReport.
+func (in *NSXAuditReport) DeepCopy() *NSXAuditReport {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXAuditReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NSXAuditReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXAuditReportList) DeepCopyInto(out *NSXAuditReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NSXAuditReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXAuditReportList.
+func (in *NSXAuditReportList) DeepCopy() *NSXAuditReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXAuditReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NSXAuditReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXAuditReportStatus) DeepCopyInto(out *NSXAuditReportStatus) {
+	*out = *in
+	if in.LastAuditTime != nil {
+		in, out := &in.LastAuditTime, &out.LastAuditTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Orphans != nil {
+		in, out := &in.Orphans, &out.Orphans
+		*out = make([]AuditedNSXResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.DanglingReferences != nil {
+		in, out := &in.DanglingReferences, &out.DanglingReferences
+		*out = make([]AuditedNSXResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXAuditReportStatus.
+func (in *NSXAuditReportStatus) DeepCopy() *NSXAuditReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXAuditReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInfo) DeepCopyInto(out *NetworkInfo) {
 	*out = *in
@@ -486,6 +603,110 @@ func (in *NextHop) DeepCopy() *NextHop {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorRestore) DeepCopyInto(out *OperatorRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorRestore.
+func (in *OperatorRestore) DeepCopy() *OperatorRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorRestoreList) DeepCopyInto(out *OperatorRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorRestoreList.
+func (in *OperatorRestoreList) DeepCopy() *OperatorRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorRestoreSpec) DeepCopyInto(out *OperatorRestoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorRestoreSpec.
+func (in *OperatorRestoreSpec) DeepCopy() *OperatorRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorRestoreStatus) DeepCopyInto(out *OperatorRestoreStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Reconcilers != nil {
+		in, out := &in.Reconcilers, &out.Reconcilers
+		*out = make([]ReconcilerRestoreStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorRestoreStatus.
+func (in *OperatorRestoreStatus) DeepCopy() *OperatorRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortAddressBinding) DeepCopyInto(out *PortAddressBinding) {
 	*out = *in
@@ -516,6 +737,56 @@ func (in *PortAttachment) DeepCopy() *PortAttachment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortBandwidth) DeepCopyInto(out *PortBandwidth) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortBandwidth.
+func (in *PortBandwidth) DeepCopy() *PortBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(PortBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcilerRestoreStatus) DeepCopyInto(out *ReconcilerRestoreStatus) {
+	*out = *in
+	if in.CRsToRestore != nil {
+		in, out := &in.CRsToRestore, &out.CRsToRestore
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NSXResourcesToDelete != nil {
+		in, out := &in.NSXResourcesToDelete, &out.NSXResourcesToDelete
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcilerRestoreStatus.
+func (in *ReconcilerRestoreStatus) DeepCopy() *ReconcilerRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcilerRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityPolicy) DeepCopyInto(out *SecurityPolicy) {
 	*out = *in
@@ -767,6 +1038,21 @@ func (in *SecurityPolicyTarget) DeepCopy() *SecurityPolicyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SegmentProfiles) DeepCopyInto(out *SegmentProfiles) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SegmentProfiles.
+func (in *SegmentProfiles) DeepCopy() *SegmentProfiles {
+	if in == nil {
+		return nil
+	}
+	out := new(SegmentProfiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SharedSubnet) DeepCopyInto(out *SharedSubnet) {
 	*out = *in
@@ -890,6 +1176,11 @@ func (in *StaticRouteSpec) DeepCopyInto(out *StaticRouteSpec) {
 		*out = make([]NextHop, len(*in))
 		copy(*out, *in)
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]StaticRouteTag, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRouteSpec.
@@ -924,6 +1215,21 @@ func (in *StaticRouteStatus) DeepCopy() *StaticRouteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRouteTag) DeepCopyInto(out *StaticRouteTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRouteTag.
+func (in *StaticRouteTag) DeepCopy() *StaticRouteTag {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRouteTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -1332,6 +1638,21 @@ func (in *SubnetPortList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPortParent) DeepCopyInto(out *SubnetPortParent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortParent.
+func (in *SubnetPortParent) DeepCopy() *SubnetPortParent {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPortParent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetPortSpec) DeepCopyInto(out *SubnetPortSpec) {
 	*out = *in
@@ -1340,6 +1661,11 @@ func (in *SubnetPortSpec) DeepCopyInto(out *SubnetPortSpec) {
 		*out = make([]PortAddressBinding, len(*in))
 		copy(*out, *in)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(PortBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortSpec.
@@ -1364,6 +1690,11 @@ func (in *SubnetPortStatus) DeepCopyInto(out *SubnetPortStatus) {
 	}
 	out.Attachment = in.Attachment
 	in.NetworkInterfaceConfig.DeepCopyInto(&out.NetworkInterfaceConfig)
+	if in.RealizedParent != nil {
+		in, out := &in.RealizedParent, &out.RealizedParent
+		*out = new(SubnetPortParent)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPortStatus.
@@ -1648,6 +1979,11 @@ func (in *VPCNetworkConfigurationSpec) DeepCopyInto(out *VPCNetworkConfiguration
 		*out = make([]SharedSubnet, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalVPCs != nil {
+		in, out := &in.AdditionalVPCs, &out.AdditionalVPCs
+		*out = make([]AdditionalVPC, len(*in))
+		copy(*out, *in)
+	}
 	if in.PrivateIPs != nil {
 		in, out := &in.PrivateIPs, &out.PrivateIPs
 		*out = make([]string, len(*in))
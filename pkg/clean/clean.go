@@ -17,6 +17,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/servicelb"
 	sr "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
@@ -88,14 +89,17 @@ func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger,
 	cleanupService.log = log
 
 	if err := cleanupService.cleanupVPCResources(ctx); err != nil {
+		logBatchUpdateFailures(log, err)
 		return errors.Join(nsxutil.CleanupResourceFailed, err)
 	}
 
 	if err := cleanupService.cleanupInfraResources(ctx); err != nil {
+		logBatchUpdateFailures(log, err)
 		return errors.Join(nsxutil.CleanupResourceFailed, err)
 	}
 
 	if err := cleanupService.cleanupHealthResources(ctx); err != nil {
+		logBatchUpdateFailures(log, err)
 		return errors.Join(nsxutil.CleanupResourceFailed, err)
 	}
 
@@ -103,6 +107,16 @@ func Clean(ctx context.Context, cf *config.NSXOperatorConfig, log *logr.Logger,
 	return nil
 }
 
+// logBatchUpdateFailures logs the NSX resources which failed to be deleted in batches, so that the resources blocking
+// the cleanup can be found without going through the logs of every batch.
+func logBatchUpdateFailures(log *logr.Logger, err error) {
+	for _, batchErr := range common.FindBatchUpdateErrors(err) {
+		for _, failure := range batchErr.Failures {
+			log.Error(failure.Err, "Failed to delete NSX resource", "resourceType", batchErr.ResourceType, "id", failure.ID, "path", failure.Path)
+		}
+	}
+}
+
 // InitializeCleanupService initializes all the CR services
 func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Client, log *logr.Logger) (*CleanupService, error) {
 	cleanupService := NewCleanupService()
@@ -165,6 +179,11 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 			return dnsRecordService, nil
 		}
 	}
+	wrapInitializeServiceLB := func(service common.Service) cleanupFunc {
+		return func() (interface{}, error) {
+			return servicelb.InitializeServiceLB(service, vpcService)
+		}
+	}
 	wrapInitializeSubnetBinding := func(service common.Service) cleanupFunc {
 		return func() (interface{}, error) {
 			return subnetbinding.InitializeService(service)
@@ -224,6 +243,7 @@ func InitializeCleanupService(cf *config.NSXOperatorConfig, nsxClient *nsx.Clien
 	loggedAdd("VPC", wrapInitializeVPC(commonService))
 	loggedAdd("IPAddressAllocation", wrapInitializeIPAddressAllocation(commonService))
 	loggedAdd("DNSRecord", wrapInitializeDNSRecordService(commonService))
+	loggedAdd("ServiceLB", wrapInitializeServiceLB(commonService))
 	loggedAdd("Inventory", wrapInitializeInventory(commonService))
 	loggedAdd("LBInfraCleaner", wrapInitializeLBInfraCleaner(commonService))
 	loggedAdd("HealthCleaner", wrapInitializeHealthCleaner(commonService))
@@ -19,6 +19,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/servicelb"
 	sr "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
@@ -191,6 +192,9 @@ func TestInitializeCleanupService_Success(t *testing.T) {
 	patches.ApplyFunc(dns.InitializeDNSRecordService, func(service common.Service, vpcService common.VPCServiceProvider) (*dns.DNSRecordService, error) {
 		return &dns.DNSRecordService{}, nil
 	})
+	patches.ApplyFunc(servicelb.InitializeServiceLB, func(service common.Service, vpcService common.VPCServiceProvider) (*servicelb.ServiceLBService, error) {
+		return &servicelb.ServiceLBService{}, nil
+	})
 	patches.ApplyFunc(subnetbinding.InitializeService, func(service common.Service) (*subnetbinding.BindingService, error) {
 		return &subnetbinding.BindingService{}, nil
 	})
@@ -217,9 +221,9 @@ func TestInitializeCleanupService_Success(t *testing.T) {
 	cleanupService, err := InitializeCleanupService(cf, nsxClient, &log)
 	assert.NoError(t, err)
 	assert.NotNil(t, cleanupService)
-	// vpcPreCleaners: SubnetPort, SubnetBinding, SubnetIPReservation, Inventory, SecurityPolicy, LBInfraCleaner, NSXServiceAccount, HealthCleaner = 8
-	assert.Len(t, cleanupService.vpcPreCleaners, 7)
-	assert.Len(t, cleanupService.vpcChildrenCleaners, 5)
+	// vpcPreCleaners: SubnetPort, SubnetBinding, SubnetIPReservation, SecurityPolicy, VPC, ServiceLB, Inventory, NSXServiceAccount = 8
+	assert.Len(t, cleanupService.vpcPreCleaners, 8)
+	assert.Len(t, cleanupService.vpcChildrenCleaners, 6)
 	assert.Len(t, cleanupService.infraCleaners, 3)
 }
 
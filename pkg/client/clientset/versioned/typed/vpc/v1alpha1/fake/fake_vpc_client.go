@@ -27,10 +27,18 @@ func (c *FakeCrdV1alpha1) IPBlocksInfos() v1alpha1.IPBlocksInfoInterface {
 	return newFakeIPBlocksInfos(c)
 }
 
+func (c *FakeCrdV1alpha1) NSXAuditReports() v1alpha1.NSXAuditReportInterface {
+	return newFakeNSXAuditReports(c)
+}
+
 func (c *FakeCrdV1alpha1) NetworkInfos(namespace string) v1alpha1.NetworkInfoInterface {
 	return newFakeNetworkInfos(c, namespace)
 }
 
+func (c *FakeCrdV1alpha1) OperatorRestores() v1alpha1.OperatorRestoreInterface {
+	return newFakeOperatorRestores(c)
+}
+
 func (c *FakeCrdV1alpha1) SecurityPolicies(namespace string) v1alpha1.SecurityPolicyInterface {
 	return newFakeSecurityPolicies(c, namespace)
 }
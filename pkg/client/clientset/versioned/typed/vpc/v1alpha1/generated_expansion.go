@@ -11,8 +11,12 @@ type IPAddressAllocationExpansion interface{}
 
 type IPBlocksInfoExpansion interface{}
 
+type NSXAuditReportExpansion interface{}
+
 type NetworkInfoExpansion interface{}
 
+type OperatorRestoreExpansion interface{}
+
 type SecurityPolicyExpansion interface{}
 
 type StaticRouteExpansion interface{}
@@ -18,7 +18,9 @@ type CrdV1alpha1Interface interface {
 	AddressBindingsGetter
 	IPAddressAllocationsGetter
 	IPBlocksInfosGetter
+	NSXAuditReportsGetter
 	NetworkInfosGetter
+	OperatorRestoresGetter
 	SecurityPoliciesGetter
 	StaticRoutesGetter
 	SubnetsGetter
@@ -46,10 +48,18 @@ func (c *CrdV1alpha1Client) IPBlocksInfos() IPBlocksInfoInterface {
 	return newIPBlocksInfos(c)
 }
 
+func (c *CrdV1alpha1Client) NSXAuditReports() NSXAuditReportInterface {
+	return newNSXAuditReports(c)
+}
+
 func (c *CrdV1alpha1Client) NetworkInfos(namespace string) NetworkInfoInterface {
 	return newNetworkInfos(c, namespace)
 }
 
+func (c *CrdV1alpha1Client) OperatorRestores() OperatorRestoreInterface {
+	return newOperatorRestores(c)
+}
+
 func (c *CrdV1alpha1Client) SecurityPolicies(namespace string) SecurityPolicyInterface {
 	return newSecurityPolicies(c, namespace)
 }
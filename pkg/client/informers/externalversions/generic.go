@@ -46,8 +46,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().IPAddressAllocations().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("ipblocksinfos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().IPBlocksInfos().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("nsxauditreports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().NSXAuditReports().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networkinfos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().NetworkInfos().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("operatorrestores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().OperatorRestores().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("securitypolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Crd().V1alpha1().SecurityPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("staticroutes"):
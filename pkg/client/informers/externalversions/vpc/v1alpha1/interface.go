@@ -17,8 +17,12 @@ type Interface interface {
 	IPAddressAllocations() IPAddressAllocationInformer
 	// IPBlocksInfos returns a IPBlocksInfoInformer.
 	IPBlocksInfos() IPBlocksInfoInformer
+	// NSXAuditReports returns a NSXAuditReportInformer.
+	NSXAuditReports() NSXAuditReportInformer
 	// NetworkInfos returns a NetworkInfoInformer.
 	NetworkInfos() NetworkInfoInformer
+	// OperatorRestores returns a OperatorRestoreInformer.
+	OperatorRestores() OperatorRestoreInformer
 	// SecurityPolicies returns a SecurityPolicyInformer.
 	SecurityPolicies() SecurityPolicyInformer
 	// StaticRoutes returns a StaticRouteInformer.
@@ -63,11 +67,21 @@ func (v *version) IPBlocksInfos() IPBlocksInfoInformer {
 	return &iPBlocksInfoInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// NSXAuditReports returns a NSXAuditReportInformer.
+func (v *version) NSXAuditReports() NSXAuditReportInformer {
+	return &nSXAuditReportInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // NetworkInfos returns a NetworkInfoInformer.
 func (v *version) NetworkInfos() NetworkInfoInformer {
 	return &networkInfoInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OperatorRestores returns a OperatorRestoreInformer.
+func (v *version) OperatorRestores() OperatorRestoreInformer {
+	return &operatorRestoreInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // SecurityPolicies returns a SecurityPolicyInformer.
 func (v *version) SecurityPolicies() SecurityPolicyInformer {
 	return &securityPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
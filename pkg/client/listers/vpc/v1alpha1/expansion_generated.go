@@ -25,6 +25,10 @@ type IPAddressAllocationNamespaceListerExpansion interface{}
 // IPBlocksInfoLister.
 type IPBlocksInfoListerExpansion interface{}
 
+// NSXAuditReportListerExpansion allows custom methods to be added to
+// NSXAuditReportLister.
+type NSXAuditReportListerExpansion interface{}
+
 // NetworkInfoListerExpansion allows custom methods to be added to
 // NetworkInfoLister.
 type NetworkInfoListerExpansion interface{}
@@ -33,6 +37,10 @@ type NetworkInfoListerExpansion interface{}
 // NetworkInfoNamespaceLister.
 type NetworkInfoNamespaceListerExpansion interface{}
 
+// OperatorRestoreListerExpansion allows custom methods to be added to
+// OperatorRestoreLister.
+type OperatorRestoreListerExpansion interface{}
+
 // SecurityPolicyListerExpansion allows custom methods to be added to
 // SecurityPolicyLister.
 type SecurityPolicyListerExpansion interface{}
@@ -130,6 +130,9 @@ type NsxConfig struct {
 	InventoryBatchPeriod      int      `ini:"inventory_batch_period"`
 	InventoryBatchSize        int      `ini:"inventory_batch_size"`
 	EnableInventory           bool     `ini:"enable_inventory"`
+	// InventoryResyncPeriod is the interval in seconds of the full inventory reconciliation,
+	// which diffs the inventory stores against Kubernetes and repairs the drift. 0 disables it.
+	InventoryResyncPeriod int `ini:"inventory_resync_period"`
 	// VpcWcpEnhance controls StatefulSet pod SubnetPort behavior together with NSX version.
 	// When omitted (nil), treated as false; only an explicit true enables the enhancement path.
 	VpcWcpEnhance *bool `ini:"vpc_wcp_enhance"`
@@ -137,6 +140,26 @@ type NsxConfig struct {
 	RestoreVif *bool `ini:"restore_vif"`
 	// TnIdCheckInterval is the interval in seconds to check TN ID for node.
 	TnIdCheckInterval int `ini:"tn_id_check_interval"`
+	// SharedSubnetPollInterval is the interval in seconds to refresh every shared Subnet from NSX.
+	// The shared Subnets changed in NSX are refreshed within a minute regardless of this interval.
+	SharedSubnetPollInterval int `ini:"shared_subnet_poll_interval"`
+	// EnableServiceLBRealization enables the operator to realize the Services of type LoadBalancer with the
+	// native VPC load balancer. It only takes effect in VPC mode with the NSX load balancer.
+	EnableServiceLBRealization bool `ini:"enable_service_lb_realization"`
+	// CertRotationLeadDays is the number of days before expiry at which the NSXServiceAccount client cert is rotated.
+	CertRotationLeadDays int `ini:"cert_rotation_lead_days"`
+	// HAPIBatchWindow is the window in milliseconds to coalesce the H-API writes of the SubnetPorts, Subnets,
+	// StaticRoutes and IPAddressAllocations from concurrent reconciles into one patch per VPC and resource type.
+	// 0 disables the coalescing.
+	HAPIBatchWindow int `ini:"hapi_batch_window"`
+	// HAPIBatchSize is the maximum number of the resources in one coalesced patch.
+	HAPIBatchSize int `ini:"hapi_batch_size"`
+	// StoreResyncPeriod is the interval in seconds to resync the NSX resource stores with the resources changed in
+	// NSX out of band, the owner CRs of the drifted resources are reconciled. 0 disables it.
+	StoreResyncPeriod int `ini:"store_resync_period"`
+	// AuditPeriod is the interval in seconds of the audit which reports the NSX resources whose owners do not exist
+	// and the dangling references, nothing is deleted. 0 disables it, which is the default.
+	AuditPeriod int `ini:"audit_period"`
 }
 
 type K8sConfig struct {
@@ -152,6 +175,14 @@ type K8sConfig struct {
 	// Expected values: "IPv4" (default), "IPv6", or "DualStack".
 	// Use GetIPAddressType() to obtain the canonical v1alpha1.IPAddressType.
 	IPFamily string `ini:"ip_family"`
+	// ServiceAccountIssuer is the OIDC discovery URL of the Kubernetes ServiceAccount token issuer. It is registered
+	// to NSX as an OIDC endpoint for the NSXServiceAccounts in Token credential mode.
+	ServiceAccountIssuer string `ini:"service_account_issuer"`
+	// ServiceAccountIssuerThumbprint is the SHA-256 thumbprint of the ServiceAccountIssuer server certificate.
+	ServiceAccountIssuerThumbprint string `ini:"service_account_issuer_thumbprint"`
+	// ServiceAccountTokenAudience is the audience of the ServiceAccount tokens issued for the NSXServiceAccounts in
+	// Token credential mode. The API server audiences are used if it is empty.
+	ServiceAccountTokenAudience string `ini:"service_account_token_audience"`
 }
 
 // GetIPAddressType parses the raw IPFamily string and returns the canonical
@@ -187,6 +218,9 @@ type VCConfig struct {
 
 type HAConfig struct {
 	EnableHA *bool `ini:"enable"`
+	// WarmStandby initializes the NSX stores on the standby replica and keeps them synced with NSX, so that the new
+	// master only validates them against NSX on failover instead of populating them again.
+	WarmStandby bool `ini:"warm_standby"`
 }
 
 type Validate interface {
@@ -269,9 +303,14 @@ func NewNSXOpertorConfig() *NSXOperatorConfig {
 		&DefaultConfig{},
 		&CoeConfig{EnableSha: true},
 		&NsxConfig{
-			InventoryBatchPeriod: 5,
-			InventoryBatchSize:   50,
-			TnIdCheckInterval:    300,
+			InventoryBatchPeriod:     5,
+			InventoryBatchSize:       50,
+			InventoryResyncPeriod:    3600,
+			TnIdCheckInterval:        300,
+			SharedSubnetPollInterval: 600,
+			CertRotationLeadDays:     7,
+			HAPIBatchSize:            500,
+			StoreResyncPeriod:        1800,
 		},
 		&K8sConfig{},
 		&VCConfig{},
@@ -483,6 +522,59 @@ func (nsxConfig *NsxConfig) ValidateConfigFromCmd() error {
 	return nsxConfig.validate(true)
 }
 
+// ConnectionFlags are the NSX and VC connection flags shared by the command line tools.
+type ConnectionFlags struct {
+	MgrIP       string
+	VCEndpoint  string
+	VCUser      string
+	VCPasswd    string
+	NSXUser     string
+	NSXPasswd   string
+	VCSsoDomain string
+	VCHttpsPort int
+	Thumbprint  string
+	CAFile      string
+	Cluster     string
+	EnvoyHost   string
+	EnvoyPort   int
+}
+
+// AddFlags registers the connection flags to the flag set.
+func (f *ConnectionFlags) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.VCEndpoint, "vc-endpoint", "", "vc endpoint")
+	fs.StringVar(&f.VCSsoDomain, "vc-sso-domain", "", "vc sso domain")
+	fs.StringVar(&f.MgrIP, "mgr-ip", "", "nsx manager ip, it should be host name if want to verify cert")
+	fs.StringVar(&f.VCUser, "vc-user", "", "vc username")
+	fs.StringVar(&f.VCPasswd, "vc-passwd", "", "vc password")
+	fs.IntVar(&f.VCHttpsPort, "vc-https-port", 443, "vc https port")
+	fs.StringVar(&f.Thumbprint, "thumbprint", "", "nsx thumbprint")
+	fs.StringVar(&f.NSXUser, "nsx-user", "", "nsx username")
+	fs.StringVar(&f.NSXPasswd, "nsx-passwd", "", "nsx password")
+	fs.StringVar(&f.CAFile, "ca-file", "", "ca file")
+	fs.StringVar(&f.Cluster, "cluster", "", "cluster name")
+	fs.StringVar(&f.EnvoyHost, "envoyhost", "", "envoy host")
+	fs.IntVar(&f.EnvoyPort, "envoyport", 0, "envoy port")
+}
+
+// NewNSXOperatorConfig returns the default NSXOperatorConfig with the connection flags applied.
+func (f *ConnectionFlags) NewNSXOperatorConfig() *NSXOperatorConfig {
+	cf := NewNSXOpertorConfig()
+	cf.NsxApiManagers = []string{f.MgrIP}
+	cf.VCUser = f.VCUser
+	cf.VCPassword = f.VCPasswd
+	cf.VCEndPoint = f.VCEndpoint
+	cf.NsxApiUser = f.NSXUser
+	cf.NsxApiPassword = f.NSXPasswd
+	cf.SsoDomain = f.VCSsoDomain
+	cf.HttpsPort = f.VCHttpsPort
+	cf.Thumbprint = []string{f.Thumbprint}
+	cf.CaFile = []string{f.CAFile}
+	cf.Cluster = f.Cluster
+	cf.EnvoyHost = f.EnvoyHost
+	cf.EnvoyPort = f.EnvoyPort
+	return cf
+}
+
 func (nsxConfig *NsxConfig) GetNSXLBSize() string {
 	lbsSize := nsxConfig.NSXLBSize
 	if lbsSize == "" {
@@ -5,6 +5,7 @@ package config
 
 import (
 	"errors"
+	"flag"
 	"io/fs"
 	"os"
 	"testing"
@@ -238,3 +239,22 @@ func TestNsxConfig_GetServiceSize(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionFlags_NewNSXOperatorConfig(t *testing.T) {
+	var connFlags ConnectionFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	connFlags.AddFlags(fs)
+	err := fs.Parse([]string{"-mgr-ip=10.0.0.1", "-nsx-user=admin", "-nsx-passwd=pass", "-cluster=cluster-1", "-thumbprint=abc", "-envoyport=1080"})
+	assert.NoError(t, err)
+
+	cf := connFlags.NewNSXOperatorConfig()
+	assert.Equal(t, []string{"10.0.0.1"}, cf.NsxApiManagers)
+	assert.Equal(t, "admin", cf.NsxApiUser)
+	assert.Equal(t, "pass", cf.NsxApiPassword)
+	assert.Equal(t, "cluster-1", cf.Cluster)
+	assert.Equal(t, []string{"abc"}, cf.Thumbprint)
+	assert.Equal(t, []string{""}, cf.CaFile)
+	assert.Equal(t, 1080, cf.EnvoyPort)
+	assert.Equal(t, 443, cf.HttpsPort)
+	assert.NoError(t, cf.ValidateConfigFromCmd())
+}
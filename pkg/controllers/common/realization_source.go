@@ -0,0 +1,47 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+)
+
+// realizationEventBufferSize is the number of the realization events waiting to be enqueued, the events exceeding it
+// are dropped, and the owner CRs are requeued by the ResultRequeueAfterRealization returned when they started waiting.
+const realizationEventBufferSize = 1024
+
+// NewRealizationSource returns a source which enqueues the owner CRs of the kind when the intent paths they watch
+// with RealizeStateService.CheckRealizeStateAsync are realized, realized with errors or not realized in time.
+// newObject returns an empty CR of the owner type.
+func NewRealizationSource(kind string, newObject func() client.Object) source.Source {
+	return newRealizationSource(kind, newObject, &handler.EnqueueRequestForObject{})
+}
+
+// NewRealizationSourceWithMapFunc returns a source like NewRealizationSource, but the owner CRs are mapped to the
+// objects to enqueue by mapFunc, e.g. the SubnetPorts waiting for the Subnet created for the SubnetSet.
+func NewRealizationSourceWithMapFunc(kind string, newObject func() client.Object, mapFunc handler.MapFunc) source.Source {
+	return newRealizationSource(kind, newObject, handler.EnqueueRequestsFromMapFunc(mapFunc))
+}
+
+func newRealizationSource(kind string, newObject func() client.Object, eventHandler handler.EventHandler) source.Source {
+	events := make(chan event.GenericEvent, realizationEventBufferSize)
+	realizestate.RegisterRealizationHandler(kind, func(owner types.NamespacedName) {
+		obj := newObject()
+		obj.SetNamespace(owner.Namespace)
+		obj.SetName(owner.Name)
+		select {
+		case events <- event.GenericEvent{Object: obj}:
+			log.Debug("Enqueued owner of realized intent path", "kind", kind, "Namespace", owner.Namespace, "Name", owner.Name)
+		default:
+			log.Info("Dropped realization event as the queue is full", "kind", kind, "Namespace", owner.Namespace, "Name", owner.Name)
+		}
+	})
+	return source.Channel(events, eventHandler)
+}
@@ -0,0 +1,100 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	// storeDriftEventBufferSize is the number of the drift events waiting to be enqueued, the owner CRs of the events
+	// exceeding it are deferred, e.g. before the controller is started.
+	storeDriftEventBufferSize = 1024
+	// storeDriftRetryInterval is the interval to retry enqueuing the deferred owner CRs. Unlike the realization
+	// events, the drift events are not backed by a requeue of the owner CRs, so they are not dropped.
+	storeDriftRetryInterval = 10 * time.Second
+)
+
+// StoreDriftMapFunc maps the drifted NSX resource to its owner CR, nil is returned if it is not owned by a CR.
+type StoreDriftMapFunc func(obj interface{}) client.Object
+
+// storeDriftQueue buffers the owner CRs of the drifted NSX resources for the source.
+type storeDriftQueue struct {
+	resourceType string
+	events       chan event.GenericEvent
+	lock         sync.Mutex
+	// deferred are the owner CRs which couldn't be enqueued as the buffer was full.
+	deferred  map[types.NamespacedName]client.Object
+	retryOnce sync.Once
+}
+
+func newStoreDriftQueue(resourceType string, bufferSize int) *storeDriftQueue {
+	return &storeDriftQueue{
+		resourceType: resourceType,
+		events:       make(chan event.GenericEvent, bufferSize),
+		deferred:     make(map[types.NamespacedName]client.Object),
+	}
+}
+
+func (q *storeDriftQueue) enqueue(owner client.Object) {
+	select {
+	case q.events <- event.GenericEvent{Object: owner}:
+		log.Debug("Enqueued owner of drifted NSX resource", "resourceType", q.resourceType, "Namespace", owner.GetNamespace(), "Name", owner.GetName())
+		return
+	default:
+	}
+	log.Info("Deferred drift event as the queue is full", "resourceType", q.resourceType, "Namespace", owner.GetNamespace(), "Name", owner.GetName())
+	q.lock.Lock()
+	q.deferred[client.ObjectKeyFromObject(owner)] = owner
+	q.lock.Unlock()
+	q.retryOnce.Do(func() {
+		go wait.Forever(q.retryDeferred, storeDriftRetryInterval)
+	})
+}
+
+// retryDeferred enqueues the deferred owner CRs until the buffer is full again.
+func (q *storeDriftQueue) retryDeferred() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for key, owner := range q.deferred {
+		select {
+		case q.events <- event.GenericEvent{Object: owner}:
+			delete(q.deferred, key)
+		default:
+			log.Debug("Drift events are still deferred as the queue is full", "resourceType", q.resourceType, "count", len(q.deferred))
+			return
+		}
+	}
+}
+
+// NewStoreDriftSource returns a source which enqueues the owner CRs of the NSX resources of the resource type
+// drifted from the store, e.g. changed or deleted in NSX out of band.
+func NewStoreDriftSource(resourceType string, mapFunc StoreDriftMapFunc) source.Source {
+	return NewStoreDriftSourceForTypes([]string{resourceType}, mapFunc)
+}
+
+// NewStoreDriftSourceForTypes returns a source like NewStoreDriftSource for the CRs owning NSX resources of multiple
+// resource types.
+func NewStoreDriftSourceForTypes(resourceTypes []string, mapFunc StoreDriftMapFunc) source.Source {
+	queue := newStoreDriftQueue(strings.Join(resourceTypes, ","), storeDriftEventBufferSize)
+	for _, resourceType := range resourceTypes {
+		servicecommon.RegisterStoreDriftHandler(resourceType, func(obj interface{}) {
+			if owner := mapFunc(obj); owner != nil {
+				queue.enqueue(owner)
+			}
+		})
+	}
+	return source.Channel(queue.events, &handler.EnqueueRequestForObject{})
+}
@@ -0,0 +1,34 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+)
+
+func TestStoreDriftQueueRetryDeferred(t *testing.T) {
+	queue := newStoreDriftQueue("Subnet", 1)
+	subnet1 := &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet1"}}
+	subnet2 := &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "subnet2"}}
+
+	queue.enqueue(subnet1)
+	// The buffer is full, so the second owner is deferred instead of dropped.
+	queue.enqueue(subnet2)
+	queue.enqueue(subnet2)
+	assert.Len(t, queue.deferred, 1)
+
+	// The deferred owner stays deferred while the buffer is still full.
+	queue.retryDeferred()
+	assert.Len(t, queue.deferred, 1)
+
+	assert.Equal(t, subnet1, (<-queue.events).Object)
+	queue.retryDeferred()
+	assert.Empty(t, queue.deferred)
+	assert.Equal(t, subnet2, (<-queue.events).Object)
+}
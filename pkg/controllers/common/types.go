@@ -5,6 +5,8 @@ import (
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 )
 
 const (
@@ -44,8 +46,11 @@ var (
 	ResultRequeueAfter10sec = ctrl.Result{RequeueAfter: 10 * time.Second}
 	ResultRequeueAfter60sec = ctrl.Result{RequeueAfter: 60 * time.Second}
 	// for unstable event, eg: failed to k8s resources when reconciling, may due to k8s unstable
-	ResultRequeueAfter5mins     = ctrl.Result{RequeueAfter: 5 * time.Minute}
-	AnnotationNamespaceVPCError = "nsx.vmware.com/vpc_error"
+	ResultRequeueAfter5mins = ctrl.Result{RequeueAfter: 5 * time.Minute}
+	// for the CRs waiting for the NSX realization, they are requeued by the realization watcher, the delayed requeue
+	// is a backstop in case the realization event is dropped
+	ResultRequeueAfterRealization = ctrl.Result{RequeueAfter: realizestate.RealizationTimeout}
+	AnnotationNamespaceVPCError   = "nsx.vmware.com/vpc_error"
 )
 
 const (
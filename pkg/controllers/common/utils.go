@@ -22,6 +22,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -206,6 +207,15 @@ func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Re
 	defer WUnlockSubnetSet(subnetSet.GetUID(), subnetSetLock)
 	subnetList := subnetService.GetSubnetsByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
 	for _, nsxSubnet := range subnetList {
+		// The Subnet created for the other SubnetPorts may still be realizing, the SubnetPort waits for it instead
+		// of creating another Subnet.
+		if err := subnetService.CheckSubnetSetSubnetRealizeState(subnetSet, nsxSubnet); err != nil {
+			if nsxutil.IsRealizationPendingError(err) {
+				return "", nil, nil, err
+			}
+			log.Info("Skipped the Subnet failed to be realized", "Subnet", *nsxSubnet.Path, "error", err)
+			continue
+		}
 		canAllocate, err := subnetPortService.AllocatePortFromSubnet(nsxSubnet, false, interfaceIPType)
 		if err != nil {
 			return "", nil, nil, err
@@ -219,13 +229,12 @@ func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Re
 		return "", nil, nil, errors.New("failed to generate subnet tags")
 	}
 	log.Info("The existing subnets are not available, creating new subnet", "subnetList", subnetList, "subnetSet.Name", subnetSet.Name, "subnetSet.Namespace", subnetSet.Namespace)
-	vpcInfoList := vpcService.ListVPCInfo(subnetSet.Namespace)
-	if len(vpcInfoList) == 0 {
-		err := errors.New("no VPC found")
+	vpcInfo, err := servicecommon.GetVPCInfoByName(vpcService, subnetSet.Namespace, subnetSet.Spec.VPCName)
+	if err != nil {
 		log.Error(err, "Failed to allocate Subnet")
 		return "", nil, nil, err
 	}
-	nsxSubnet, err := subnetService.CreateOrUpdateSubnet(subnetSet, vpcInfoList[0], tags)
+	nsxSubnet, err := subnetService.CreateOrUpdateSubnet(subnetSet, *vpcInfo, tags)
 	if err != nil {
 		return "", nil, nil, err
 	}
@@ -236,7 +245,7 @@ func AllocateSubnetFromSubnetSet(client k8sclient.Client, apiReader k8sclient.Re
 	if canAllocate {
 		return *nsxSubnet.Path, nil, nil, nil
 	}
-	return "", nil, nil, fmt.Errorf("cannot allocate Port from SubnetSet %s", subnetSet.Name)
+	return "", nil, nil, nsxutil.SubnetExhaustedError{Desc: fmt.Sprintf("cannot allocate Port from SubnetSet %s", subnetSet.Name)}
 }
 
 func GetDefaultSubnetSetByNamespace(client k8sclient.Client, namespace string, resourceType string) (*v1alpha1.SubnetSet, error) {
@@ -307,7 +316,15 @@ func GenericGarbageCollector(cancel chan bool, timeout time.Duration, f func(ctx
 		case <-cancel:
 			return
 		case <-ticker.C:
-			f(ctx)
+			if err := f(ctx); err != nil {
+				log.Error(err, "Failed to collect garbage")
+				// Log the NSX resources failed to be deleted in batches, the others in the same batches are deleted.
+				for _, batchErr := range servicecommon.FindBatchUpdateErrors(err) {
+					for _, failure := range batchErr.Failures {
+						log.Error(failure.Err, "Failed to delete NSX resource in garbage collection", "resourceType", batchErr.ResourceType, "id", failure.ID, "path", failure.Path)
+					}
+				}
+			}
 		}
 	}
 }
@@ -334,12 +351,17 @@ func (u *StatusUpdater) UpdateSuccess(ctx context.Context, obj k8sclient.Object,
 	metrics.CounterInc(u.NSXConfig, metrics.ControllerUpdateSuccessTotal, u.MetricResType)
 }
 
+// UpdateFail classifies err with a stable reason code and a remediation hint before it is passed to setStatusFn, so
+// that setStatusFn can set the reason code to the condition with nsxutil.ErrorReason. The reason code is also the
+// reason of the warning event, which is the only place to report it for the resources without status, e.g. Pods and
+// NetworkPolicies.
 func (u *StatusUpdater) UpdateFail(ctx context.Context, obj k8sclient.Object, err error, msg string, setStatusFn UpdateFailStatusFn, args ...interface{}) {
-	log.Error(err, fmt.Sprintf("Failed to create or update %s CR", u.ResourceType), "Reason", msg, "Namespace", obj.GetNamespace(), "Name", obj.GetName())
+	err = nsxutil.WithErrorReason(err)
+	log.Error(err, fmt.Sprintf("Failed to create or update %s CR", u.ResourceType), "Reason", msg, "ErrorReason", nsxutil.ErrorReason(err, ""), "Namespace", obj.GetNamespace(), "Name", obj.GetName())
 	if setStatusFn != nil {
 		setStatusFn(u.Client, ctx, obj, metav1.Now(), err, args...)
 	}
-	u.Recorder.Event(obj, v1.EventTypeWarning, ReasonFailUpdate, fmt.Sprintf("%v", err))
+	u.Recorder.Event(obj, v1.EventTypeWarning, nsxutil.ErrorReason(err, ReasonFailUpdate), fmt.Sprintf("%v", err))
 	metrics.CounterInc(u.NSXConfig, metrics.ControllerUpdateFailTotal, u.MetricResType)
 }
 
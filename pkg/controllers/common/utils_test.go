@@ -31,6 +31,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -307,6 +308,18 @@ func TestStatusUpdater_UpdateFail(t *testing.T) {
 	statusUpdater.UpdateFail(context.TODO(), &v1alpha1.Subnet{}, fmt.Errorf("mock error"), "log message", func(_ client.Client, _ context.Context, _ client.Object, _ metav1.Time, e error, _ ...interface{}) {
 		assert.Contains(t, e.Error(), "mock error")
 	})
+	statusUpdater.UpdateFail(context.TODO(), &v1alpha1.Subnet{}, nsxutil.IPBlockAllExhaustedError{Desc: "all ip blocks are exhausted"}, "log message", func(_ client.Client, _ context.Context, _ client.Object, _ metav1.Time, e error, _ ...interface{}) {
+		assert.Equal(t, nsxutil.ErrorReasonIPBlockExhausted, nsxutil.ErrorReason(e, "SubnetNotReady"))
+		assert.True(t, strings.HasPrefix(e.Error(), "all ip blocks are exhausted. "))
+	})
+
+	// The reason code is the reason of the warning event, the generic reason is used for the errors not classified.
+	recorder := record.NewFakeRecorder(2)
+	statusUpdater.Recorder = recorder
+	statusUpdater.UpdateFail(context.TODO(), &v1alpha1.Subnet{}, fmt.Errorf("mock error"), "log message", nil)
+	assert.Equal(t, "Warning FailUpdate mock error", <-recorder.Events)
+	statusUpdater.UpdateFail(context.TODO(), &v1.Pod{}, nsxutil.SubnetExhaustedError{Desc: "Subnet subnet-1 is exhausted"}, "log message", nil)
+	assert.True(t, strings.HasPrefix(<-recorder.Events, "Warning SubnetIPExhausted Subnet subnet-1 is exhausted. "))
 }
 
 func TestStatusUpdater_DeleteSuccess(t *testing.T) {
@@ -0,0 +1,143 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func watchGateway(c *InventoryController, mgr ctrl.Manager) error {
+	gatewayInformer, err := mgr.GetCache().GetInformer(context.Background(), &gatewayv1.Gateway{})
+	if err != nil {
+		// Gateway API CRDs are optional in the cluster.
+		if meta.IsNoMatchError(err) {
+			log.Info("Gateway API is not installed, skip watching Gateway")
+			return nil
+		}
+		log.Error(err, "Failed to create Gateway informer")
+		return err
+	}
+
+	_, err = gatewayInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.handleGateway(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.handleGateway(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.handleGateway(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add Gateway event handler")
+		return err
+	}
+	return nil
+}
+
+func watchHTTPRoute(c *InventoryController, mgr ctrl.Manager) error {
+	routeInformer, err := mgr.GetCache().GetInformer(context.Background(), &gatewayv1.HTTPRoute{})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			log.Info("Gateway API is not installed, skip watching HTTPRoute")
+			return nil
+		}
+		log.Error(err, "Failed to create HTTPRoute informer")
+		return err
+	}
+
+	_, err = routeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.handleHTTPRoute(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.handleHTTPRoute(oldObj)
+			c.handleHTTPRoute(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.handleHTTPRoute(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add HTTPRoute event handler")
+		return err
+	}
+	return nil
+}
+
+func (c *InventoryController) handleGateway(obj interface{}) {
+	var gateway *gatewayv1.Gateway
+	ok := false
+	switch obj1 := obj.(type) {
+	case *gatewayv1.Gateway:
+		gateway = obj1
+	case cache.DeletedFinalStateUnknown:
+		gateway, ok = obj1.Obj.(*gatewayv1.Gateway)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *gatewayv1.Gateway")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *gatewayv1.Gateway")
+			return
+		}
+	default:
+		return
+	}
+	log.Debug("Inventory processing Gateway", "Namespace", gateway.Namespace, "Name", gateway.Name)
+	c.triggerGateway(gateway)
+}
+
+func (c *InventoryController) handleHTTPRoute(obj interface{}) {
+	var route *gatewayv1.HTTPRoute
+	ok := false
+	switch obj1 := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		route = obj1
+	case cache.DeletedFinalStateUnknown:
+		route, ok = obj1.Obj.(*gatewayv1.HTTPRoute)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *gatewayv1.HTTPRoute")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *gatewayv1.HTTPRoute")
+			return
+		}
+	default:
+		return
+	}
+	log.Debug("Inventory processing HTTPRoute", "Namespace", route.Namespace, "Name", route.Name)
+	key, _ := keyFunc(route)
+	log.Debug("Adding HTTPRoute key to inventory object queue", "HTTPRoute key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.HTTPRoute, ExternalId: string(route.UID), Key: key})
+
+	// The application ids of the parent Gateways are resolved from the HTTPRoute backendRefs.
+	for _, parentRef := range route.Spec.ParentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+			continue
+		}
+		namespace := route.Namespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		gateway := &gatewayv1.Gateway{}
+		err := c.Client.Get(context.TODO(), types.NamespacedName{Name: string(parentRef.Name), Namespace: namespace}, gateway)
+		if err != nil {
+			log.Error(err, "Failed to get Gateway for HTTPRoute", "HTTPRoute", route.Name, "Gateway", parentRef.Name, "Namespace", namespace)
+			continue
+		}
+		c.triggerGateway(gateway)
+	}
+}
+
+func (c *InventoryController) triggerGateway(gateway *gatewayv1.Gateway) {
+	key, _ := keyFunc(gateway)
+	log.Debug("Adding Gateway key to inventory object queue", "Gateway key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.Gateway, ExternalId: string(gateway.UID), Key: key})
+}
@@ -0,0 +1,146 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func TestWatchGateway(t *testing.T) {
+	t.Run("SuccessfullyCreateInformer", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockInformer := &MockInformer{handlers: cache.ResourceEventHandlerFuncs{}}
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(mockInformer, nil)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(mockInformer, nil)
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		assert.Nil(t, watchGateway(controller, mgr))
+		assert.Nil(t, watchHTTPRoute(controller, mgr))
+	})
+
+	t.Run("GatewayAPINotInstalled", func(t *testing.T) {
+		mockCache := new(MockCache)
+		noMatchErr := &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gatewayv1.GroupName, Kind: "Gateway"}}
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(nil, noMatchErr)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(nil, noMatchErr)
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		assert.Nil(t, watchGateway(controller, mgr))
+		assert.Nil(t, watchHTTPRoute(controller, mgr))
+	})
+
+	t.Run("CreateInformerFailure", func(t *testing.T) {
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.Gateway{}).Return(nil, errors.New("connection timeout"))
+		mockCache.On("GetInformer", context.Background(), &gatewayv1.HTTPRoute{}).Return(nil, errors.New("connection timeout"))
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchGateway(controller, mgr)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection timeout")
+		err = watchHTTPRoute(controller, mgr)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection timeout")
+	})
+}
+
+func TestHandleGateway(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	controller := &InventoryController{
+		service:              &inventory.InventoryService{},
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "gateway",
+			UID:       "gateway-uid",
+		},
+	}
+	t.Run("NormalGateway", func(t *testing.T) {
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.Gateway, ExternalId: "gateway-uid", Key: "ns/gateway"}).Return().Once()
+		controller.handleGateway(gateway)
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithGateway", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		queue.On("Add", mock.Anything).Return().Once()
+		controller.handleGateway(cache.DeletedFinalStateUnknown{Obj: gateway})
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithInvalidObj", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleGateway(cache.DeletedFinalStateUnknown{Obj: "deleted gateway"})
+		queue.AssertExpectations(t)
+	})
+}
+
+func TestHandleHTTPRoute(t *testing.T) {
+	scheme := runtime.NewScheme()
+	gatewayv1.Install(scheme)
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "gateway",
+			UID:       "gateway-uid",
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).Build()
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	controller := &InventoryController{
+		Client:               k8sClient,
+		service:              &inventory.InventoryService{},
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "route",
+			UID:       "route-uid",
+		},
+	}
+	route.Spec.ParentRefs = []gatewayv1.ParentReference{{Name: "gateway"}, {Name: "missing-gateway"}}
+
+	t.Run("RouteAndParentGateway", func(t *testing.T) {
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.HTTPRoute, ExternalId: "route-uid", Key: "ns/route"}).Return().Once()
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.Gateway, ExternalId: "gateway-uid", Key: "ns/gateway"}).Return().Once()
+		controller.handleHTTPRoute(route)
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithRoute", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		queue.On("Add", mock.Anything).Return().Twice()
+		controller.handleHTTPRoute(cache.DeletedFinalStateUnknown{Obj: route})
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithInvalidObj", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleHTTPRoute(cache.DeletedFinalStateUnknown{Obj: "deleted route"})
+		queue.AssertExpectations(t)
+	})
+}
@@ -2,6 +2,7 @@ package inventory
 
 import (
 	"context"
+	"net/http"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	commonservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 const (
@@ -25,7 +27,8 @@ const (
 	minRetryDelay = 5 * time.Second
 	maxRetryDelay = 300 * time.Second
 
-	inventoryGCJitterFactor = 0.1
+	inventoryGCJitterFactor     = 0.1
+	inventoryResyncJitterFactor = 0.1
 )
 
 type WatchResourceFunc func(c *InventoryController, mgr ctrl.Manager) error
@@ -45,6 +48,9 @@ var (
 		watchIngress,
 		watchNode,
 		watchNetworkPolicy,
+		watchVirtualMachine,
+		watchGateway,
+		watchHTTPRoute,
 	}
 )
 
@@ -86,6 +92,10 @@ func (c *InventoryController) setupWithManager(mgr ctrl.Manager) error {
 			return err
 		}
 	}
+	if err := mgr.AddMetricsServerExtraHandler(InventoryDriftPath, http.HandlerFunc(c.serveDriftReport)); err != nil {
+		log.Error(err, "Failed to add inventory drift report handler")
+		return err
+	}
 	// Set up the queue
 	go c.Run(make(<-chan struct{}))
 	return nil
@@ -107,6 +117,9 @@ func (c *InventoryController) Run(stopCh <-chan struct{}) {
 	go wait.Until(c.inventoryWorker, time.Second, stopCh)
 	go wait.Until(c.inventoryTimeWorker, time.Second*time.Duration(c.cf.InventoryBatchPeriod), stopCh)
 	go wait.JitterUntil(c.inventoryGCWorker, commonservice.GCInterval, inventoryGCJitterFactor, true, stopCh)
+	if c.cf.InventoryResyncPeriod > 0 {
+		go wait.JitterUntil(c.inventoryResyncWorker, time.Second*time.Duration(c.cf.InventoryResyncPeriod), inventoryResyncJitterFactor, false, stopCh)
+	}
 
 	<-stopCh
 }
@@ -211,3 +224,9 @@ func (c *InventoryController) RestoreReconcile() error {
 func (c *InventoryController) CollectGarbage(ctx context.Context) error {
 	return c.CleanStaleInventoryObjects()
 }
+
+// RestoreDryRun implements the interface RestoreDryRunner. No inventory object is restored, only the stale inventory
+// objects are reported.
+func (c *InventoryController) RestoreDryRun(_ context.Context) (*util.RestorePlan, error) {
+	return &util.RestorePlan{NSXResourcesToDelete: c.service.ListStaleInventoryObjects()}, nil
+}
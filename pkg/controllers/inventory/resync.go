@@ -0,0 +1,60 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+// InventoryDriftPath is the debug endpoint on the metrics server which prints the drift
+// between the NSX inventory and Kubernetes without changing anything.
+const InventoryDriftPath = "/debug/inventory/drift"
+
+// inventoryResyncWorker reloads the inventory stores from NSX, then diffs them against Kubernetes and
+// enqueues the drifted objects, so the missed updates and deletes are repaired without restarting the operator.
+func (c *InventoryController) inventoryResyncWorker() {
+	defer c.inventoryMutex.Unlock()
+	c.inventoryMutex.Lock()
+
+	log.Info("Start full inventory resync")
+	if err := c.service.RefreshInventoryStores(); err != nil {
+		log.Error(err, "Failed to refresh inventory stores from NSX")
+		return
+	}
+	report, err := c.service.DetectDrift(context.TODO())
+	if err != nil {
+		log.Error(err, "Failed to detect inventory drift")
+		return
+	}
+	for _, key := range report.InventoryKeys() {
+		c.inventoryObjectQueue.Add(key)
+	}
+	log.Info("Finished full inventory resync", "drifted objects", report.Count())
+}
+
+func (c *InventoryController) serveDriftReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	report, err := c.detectDrift(r.Context())
+	if err != nil {
+		log.Error(err, "Failed to detect inventory drift")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Error(err, "Failed to write inventory drift report")
+	}
+}
+
+func (c *InventoryController) detectDrift(ctx context.Context) (inventory.DriftReport, error) {
+	defer c.inventoryMutex.Unlock()
+	c.inventoryMutex.Lock()
+	return c.service.DetectDrift(ctx)
+}
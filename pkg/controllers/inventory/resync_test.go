@@ -0,0 +1,107 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func TestInventoryResyncWorker(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	inventoryService := &inventory.InventoryService{}
+	driftedKey := inventory.InventoryKey{InventoryType: inventory.ContainerApplicationInstance, ExternalId: "pod-uid", Key: "ns/pod"}
+
+	t.Run("EnqueueDriftedObjects", func(t *testing.T) {
+		queue := MockObjectQueue[any]{}
+		controller := &InventoryController{
+			service:              inventoryService,
+			keyBuffer:            sets.New[inventory.InventoryKey](),
+			cf:                   cfg,
+			inventoryObjectQueue: &queue}
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "RefreshInventoryStores", func(_ *inventory.InventoryService) error {
+			return nil
+		})
+		patches.ApplyMethod(reflect.TypeOf(inventoryService), "DetectDrift", func(_ *inventory.InventoryService, _ context.Context) (inventory.DriftReport, error) {
+			return inventory.DriftReport{}, nil
+		})
+		patches.ApplyMethod(reflect.TypeOf(inventory.DriftReport{}), "InventoryKeys", func(_ inventory.DriftReport) []inventory.InventoryKey {
+			return []inventory.InventoryKey{driftedKey}
+		})
+		defer patches.Reset()
+		queue.On("Add", driftedKey).Return().Once()
+		controller.inventoryResyncWorker()
+		queue.AssertExpectations(t)
+	})
+
+	t.Run("RefreshStoresFailure", func(t *testing.T) {
+		queue := MockObjectQueue[any]{}
+		controller := &InventoryController{
+			service:              inventoryService,
+			keyBuffer:            sets.New[inventory.InventoryKey](),
+			cf:                   cfg,
+			inventoryObjectQueue: &queue}
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "RefreshInventoryStores", func(_ *inventory.InventoryService) error {
+			return errors.New("NSX unavailable")
+		})
+		patches.ApplyMethod(reflect.TypeOf(inventoryService), "DetectDrift", func(_ *inventory.InventoryService, _ context.Context) (inventory.DriftReport, error) {
+			assert.Fail(t, "DetectDrift should not be called")
+			return nil, nil
+		})
+		defer patches.Reset()
+		controller.inventoryResyncWorker()
+		queue.AssertExpectations(t)
+	})
+}
+
+func TestServeDriftReport(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	inventoryService := &inventory.InventoryService{}
+	controller := &InventoryController{
+		service:   inventoryService,
+		keyBuffer: sets.New[inventory.InventoryKey](),
+		cf:        cfg,
+	}
+
+	t.Run("PrintDrift", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DetectDrift", func(_ *inventory.InventoryService, _ context.Context) (inventory.DriftReport, error) {
+			return inventory.DriftReport{
+				inventory.ContainerApplicationInstance: {Missing: []inventory.DriftItem{{ExternalId: "pod-uid", Key: "ns/pod"}}},
+			}, nil
+		})
+		defer patches.Reset()
+		recorder := httptest.NewRecorder()
+		controller.serveDriftReport(recorder, httptest.NewRequest(http.MethodGet, InventoryDriftPath, nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		report := map[string]inventory.TypeDrift{}
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+		assert.Equal(t, []inventory.DriftItem{{ExternalId: "pod-uid", Key: "ns/pod"}}, report["ContainerApplicationInstance"].Missing)
+	})
+
+	t.Run("DetectFailure", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "DetectDrift", func(_ *inventory.InventoryService, _ context.Context) (inventory.DriftReport, error) {
+			return nil, errors.New("list failed")
+		})
+		defer patches.Reset()
+		recorder := httptest.NewRecorder()
+		controller.serveDriftReport(recorder, httptest.NewRequest(http.MethodGet, InventoryDriftPath, nil))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "list failed")
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		controller.serveDriftReport(recorder, httptest.NewRequest(http.MethodPost, InventoryDriftPath, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+}
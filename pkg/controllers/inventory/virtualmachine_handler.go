@@ -0,0 +1,118 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func watchVirtualMachine(c *InventoryController, mgr ctrl.Manager) error {
+	vmInformer, err := mgr.GetCache().GetInformer(context.Background(), &vmv1alpha1.VirtualMachine{})
+	if err != nil {
+		log.Error(err, "Failed to create VirtualMachine informer")
+		return err
+	}
+
+	_, err = vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.handleVirtualMachine(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.handleVirtualMachine(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.handleVirtualMachine(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add VirtualMachine event handler")
+		return err
+	}
+
+	// The VirtualMachine IPs and network errors come from its SubnetPorts.
+	subnetPortInformer, err := mgr.GetCache().GetInformer(context.Background(), &v1alpha1.SubnetPort{})
+	if err != nil {
+		log.Error(err, "Failed to create SubnetPort informer")
+		return err
+	}
+
+	_, err = subnetPortInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.handleVirtualMachineSubnetPort(obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.handleVirtualMachineSubnetPort(newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.handleVirtualMachineSubnetPort(obj)
+		},
+	})
+	if err != nil {
+		log.Error(err, "Failed to add SubnetPort event handler")
+		return err
+	}
+	return nil
+}
+
+func (c *InventoryController) handleVirtualMachine(obj interface{}) {
+	var vm *vmv1alpha1.VirtualMachine
+	ok := false
+	switch obj1 := obj.(type) {
+	case *vmv1alpha1.VirtualMachine:
+		vm = obj1
+	case cache.DeletedFinalStateUnknown:
+		vm, ok = obj1.Obj.(*vmv1alpha1.VirtualMachine)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *vmv1alpha1.VirtualMachine")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *vmv1alpha1.VirtualMachine")
+			return
+		}
+	default:
+		return
+	}
+	log.Debug("Inventory processing VirtualMachine", "namespace", vm.Namespace, "name", vm.Name)
+	c.triggerVirtualMachine(vm)
+}
+
+func (c *InventoryController) handleVirtualMachineSubnetPort(obj interface{}) {
+	var subnetPort *v1alpha1.SubnetPort
+	ok := false
+	switch obj1 := obj.(type) {
+	case *v1alpha1.SubnetPort:
+		subnetPort = obj1
+	case cache.DeletedFinalStateUnknown:
+		subnetPort, ok = obj1.Obj.(*v1alpha1.SubnetPort)
+		if !ok {
+			err := fmt.Errorf("obj is not valid *v1alpha1.SubnetPort")
+			log.Error(err, "DeletedFinalStateUnknown Obj is not *v1alpha1.SubnetPort")
+			return
+		}
+	default:
+		return
+	}
+	vmName, _, err := ctrcommon.GetVirtualMachineNameForSubnetPort(subnetPort)
+	if err != nil || vmName == "" {
+		return
+	}
+	vm := &vmv1alpha1.VirtualMachine{}
+	err = c.Client.Get(context.TODO(), types.NamespacedName{Name: vmName, Namespace: subnetPort.Namespace}, vm)
+	if err != nil {
+		log.Error(err, "Failed to get VirtualMachine for SubnetPort", "SubnetPort", subnetPort.Name, "Namespace", subnetPort.Namespace, "VirtualMachine", vmName)
+		return
+	}
+	c.triggerVirtualMachine(vm)
+}
+
+func (c *InventoryController) triggerVirtualMachine(vm *vmv1alpha1.VirtualMachine) {
+	key, _ := keyFunc(vm)
+	log.Debug("Adding VirtualMachine key to inventory object queue", "VirtualMachine key", key)
+	c.inventoryObjectQueue.Add(inventory.InventoryKey{InventoryType: inventory.VirtualMachine, ExternalId: string(vm.UID), Key: key})
+}
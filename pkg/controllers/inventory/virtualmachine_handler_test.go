@@ -0,0 +1,162 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/inventory"
+)
+
+func TestWatchVirtualMachine(t *testing.T) {
+	t.Run("SuccessfullyCreateInformer", func(t *testing.T) {
+		controller := &InventoryController{}
+		mockCache := new(MockCache)
+		mockInformer := &MockInformer{handlers: cache.ResourceEventHandlerFuncs{}}
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(mockInformer, nil)
+		mockCache.On("GetInformer", context.Background(), &v1alpha1.SubnetPort{}).Return(mockInformer, nil)
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateInformerFailure", func(t *testing.T) {
+		mockCache := new(MockCache)
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(nil, errors.New("connection timeout"))
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection timeout")
+	})
+
+	t.Run("CreateSubnetPortInformerFailure", func(t *testing.T) {
+		mockCache := new(MockCache)
+		mockInformer := &MockInformer{handlers: cache.ResourceEventHandlerFuncs{}}
+		mockCache.On("GetInformer", context.Background(), &vmv1alpha1.VirtualMachine{}).Return(mockInformer, nil)
+		mockCache.On("GetInformer", context.Background(), &v1alpha1.SubnetPort{}).Return(nil, errors.New("connection timeout"))
+		controller := &InventoryController{}
+		mgr := new(MockMgr)
+		mgr.On("GetCache").Return(mockCache)
+		err := watchVirtualMachine(controller, mgr)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "connection timeout")
+	})
+}
+
+func TestHandleVirtualMachine(t *testing.T) {
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	controller := &InventoryController{
+		service:              &inventory.InventoryService{},
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+	t.Run("NormalVirtualMachine", func(t *testing.T) {
+		vm := &vmv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "vm",
+				UID:       "vm-uid",
+			},
+		}
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.VirtualMachine, ExternalId: "vm-uid", Key: "ns/vm"}).Return().Once()
+		controller.handleVirtualMachine(vm)
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithVirtualMachine", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		vm := &vmv1alpha1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "deleted-ns",
+				Name:      "deleted-vm",
+				UID:       "deleted-uid",
+			},
+		}
+		queue.On("Add", mock.Anything).Return().Once()
+		controller.handleVirtualMachine(cache.DeletedFinalStateUnknown{Obj: vm})
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedStateWithInvalidObj", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleVirtualMachine(cache.DeletedFinalStateUnknown{Obj: "deleted vm"})
+		queue.AssertExpectations(t)
+	})
+}
+
+func TestHandleVirtualMachineSubnetPort(t *testing.T) {
+	scheme := runtime.NewScheme()
+	vmv1alpha1.AddToScheme(scheme)
+	vm := &vmv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "vm",
+			UID:       "vm-uid",
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vm).Build()
+	cfg := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}
+	queue := MockObjectQueue[any]{}
+	controller := &InventoryController{
+		Client:               k8sClient,
+		service:              &inventory.InventoryService{},
+		keyBuffer:            sets.New[inventory.InventoryKey](),
+		cf:                   cfg,
+		inventoryObjectQueue: &queue}
+
+	newSubnetPort := func(attachmentRef string) *v1alpha1.SubnetPort {
+		subnetPort := &v1alpha1.SubnetPort{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "port",
+			},
+		}
+		if attachmentRef != "" {
+			subnetPort.Annotations = map[string]string{servicecommon.AnnotationAttachmentRef: attachmentRef}
+		}
+		return subnetPort
+	}
+
+	t.Run("SubnetPortForVirtualMachine", func(t *testing.T) {
+		queue.On("Add", inventory.InventoryKey{InventoryType: inventory.VirtualMachine, ExternalId: "vm-uid", Key: "ns/vm"}).Return().Once()
+		controller.handleVirtualMachineSubnetPort(newSubnetPort("virtualmachine/vm/eth0"))
+		queue.AssertExpectations(t)
+	})
+	t.Run("DeletedSubnetPortForVirtualMachine", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		queue.On("Add", mock.Anything).Return().Once()
+		controller.handleVirtualMachineSubnetPort(cache.DeletedFinalStateUnknown{Obj: newSubnetPort("virtualmachine/vm/eth0")})
+		queue.AssertExpectations(t)
+	})
+	t.Run("SubnetPortWithoutAttachmentRef", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleVirtualMachineSubnetPort(newSubnetPort(""))
+		queue.AssertExpectations(t)
+	})
+	t.Run("VirtualMachineNotFound", func(t *testing.T) {
+		queue = MockObjectQueue[any]{}
+		controller.inventoryObjectQueue = &queue
+		controller.handleVirtualMachineSubnetPort(newSubnetPort("virtualmachine/missing-vm/eth0"))
+		queue.AssertExpectations(t)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +26,8 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -55,7 +58,7 @@ func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.O
 				"error occurred while processing the IPAddressAllocation CR. Error: %v",
 				err,
 			),
-			Reason:             "IPAddressAllocationNotReady",
+			Reason:             nsxutil.ErrorReason(err, "IPAddressAllocationNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -172,20 +175,36 @@ func (r *IPAddressAllocationReconciler) setupWithManager(mgr ctrl.Manager) error
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
+		WatchesRawSource(common.NewStoreDriftSource(servicecommon.ResourceTypeIPAddressAllocation, ipAddressAllocationDriftMapFunc)).
 		Complete(r)
 }
 
-func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("IPAddressAllocation garbage collector started")
+// ipAddressAllocationDriftMapFunc maps the drifted NSX VpcIpAddressAllocation to the IPAddressAllocation CR it is
+// created for, the NSX VpcIpAddressAllocations created for other resources, e.g. the Services, are skipped.
+func ipAddressAllocationDriftMapFunc(obj interface{}) client.Object {
+	allocation, ok := obj.(*model.VpcIpAddressAllocation)
+	if !ok {
+		return nil
+	}
+	name := nsxutil.FindTag(allocation.Tags, servicecommon.TagScopeIPAddressAllocationCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(allocation.Tags, servicecommon.TagScopeNamespace)
+	return &v1alpha1.IPAddressAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+// listStaleIPAddressAllocationIDs returns the CR UIDs of the NSX IPAddressAllocations whose CRs have been deleted.
+func (r *IPAddressAllocationReconciler) listStaleIPAddressAllocationIDs(ctx context.Context) (sets.Set[string], error) {
 	ipAddressAllocationSet := r.Service.ListIPAddressAllocationID()
 	if len(ipAddressAllocationSet) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	ipAddressAllocationCRList := &v1alpha1.IPAddressAllocationList{}
 	if err := r.Client.List(ctx, ipAddressAllocationCRList); err != nil {
 		log.Error(err, "Failed to list IPAddressAllocation CR")
-		return err
+		return nil, err
 	}
 	CRIPAddressAllocationSet := sets.New[string]()
 	for _, ipa := range ipAddressAllocationCRList.Items {
@@ -194,7 +213,15 @@ func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) erro
 
 	log.Trace("IPAddressAllocation garbage collector", "nsxIPAddressAllocationSet", ipAddressAllocationSet, "CRIPAddressAllocationSet", CRIPAddressAllocationSet)
 
-	diffSet := ipAddressAllocationSet.Difference(CRIPAddressAllocationSet)
+	return ipAddressAllocationSet.Difference(CRIPAddressAllocationSet), nil
+}
+
+func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("IPAddressAllocation garbage collector started")
+	diffSet, err := r.listStaleIPAddressAllocationIDs(ctx)
+	if err != nil {
+		return err
+	}
 	var errList []error
 	for elem := range diffSet {
 		log.Info("GC collected nsx IPAddressAllocation", "UID", elem)
@@ -209,6 +236,32 @@ func (r *IPAddressAllocationReconciler) CollectGarbage(ctx context.Context) erro
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner.
+func (r *IPAddressAllocationReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	diffSet, err := r.listStaleIPAddressAllocationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPAddressAllocation restore list: %w", err)
+	}
+	plan := &util.RestorePlan{}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	for _, elem := range sets.List(diffSet) {
+		nsxIPAddressAllocation, err := r.Service.GetIPAddressAllocationByOwner(&metav1.ObjectMeta{UID: types.UID(elem)})
+		if err != nil {
+			return nil, err
+		}
+		if nsxIPAddressAllocation != nil && nsxIPAddressAllocation.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxIPAddressAllocation.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *IPAddressAllocationReconciler) RestoreReconcile() error {
 	restoreList, err := r.getRestoreList()
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -423,6 +424,51 @@ func TestReconciler_GarbageCollector(t *testing.T) {
 	patch.Reset()
 }
 
+func TestIPAddressAllocationReconciler_RestoreDryRun(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	defer mockCtl.Finish()
+
+	service := &ipaddressallocation.IPAddressAllocationService{}
+	r := &IPAddressAllocationReconciler{
+		Client:  k8sClient,
+		Service: service,
+	}
+	stalePath := "/orgs/default/projects/default/vpcs/vpc-1/ip-address-allocations/ipa-stale"
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "ListIPAddressAllocationID",
+		func(_ *ipaddressallocation.IPAddressAllocationService) sets.Set[string] {
+			return sets.New[string]("ipa-uid-1", "ipa-uid-stale")
+		})
+	patches.ApplyMethod(reflect.TypeOf(service), "GetIPAddressAllocationByOwner",
+		func(_ *ipaddressallocation.IPAddressAllocationService, owner metav1.Object) (*model.VpcIpAddressAllocation, error) {
+			assert.Equal(t, types.UID("ipa-uid-stale"), owner.GetUID())
+			return &model.VpcIpAddressAllocation{Path: &stalePath}, nil
+		})
+	patches.ApplyMethod(reflect.TypeOf(service), "DeleteIPAddressAllocation", func(_ *ipaddressallocation.IPAddressAllocationService, UID interface{}) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	defer patches.Reset()
+	k8sClient.EXPECT().List(gomock.Any(), &v1alpha1.IPAddressAllocationList{}).Return(nil).Times(2).Do(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+		a := list.(*v1alpha1.IPAddressAllocationList)
+		a.Items = []v1alpha1.IPAddressAllocation{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ipa-1", Namespace: "ns-1", UID: "ipa-uid-1"},
+				Status:     v1alpha1.IPAddressAllocationStatus{AllocationIPs: "1.2.3.4/28"},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ipa-2", Namespace: "ns-1", UID: "ipa-uid-2"},
+				Status:     v1alpha1.IPAddressAllocationStatus{AllocationIPs: "5.6.7.8"},
+			},
+		}
+		return nil
+	})
+	plan, err := r.RestoreDryRun(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns-1/ipa-2"}, plan.CRsToRestore)
+	assert.Equal(t, []string{stalePath}, plan.NSXResourcesToDelete)
+}
+
 func TestIPAddressAllocationReconciler_StartController(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().WithObjects().Build()
 	vpcService := &vpc.VPCService{
@@ -472,3 +518,20 @@ func (m *MockManager) Add(runnable manager.Runnable) error {
 func (m *MockManager) Start(context.Context) error {
 	return nil
 }
+
+func TestIPAddressAllocationDriftMapFunc(t *testing.T) {
+	tag := func(scope, value string) model.Tag {
+		return model.Tag{Scope: common.String(scope), Tag: common.String(value)}
+	}
+	owner := ipAddressAllocationDriftMapFunc(&model.VpcIpAddressAllocation{Tags: []model.Tag{
+		tag(common.TagScopeNamespace, "ns-1"), tag(common.TagScopeIPAddressAllocationCRName, "allocation-1"),
+	}})
+	assert.IsType(t, &v1alpha1.IPAddressAllocation{}, owner)
+	assert.Equal(t, types.NamespacedName{Namespace: "ns-1", Name: "allocation-1"}, client.ObjectKeyFromObject(owner))
+
+	// The NSX VpcIpAddressAllocation created for a Service is not mapped.
+	assert.Nil(t, ipAddressAllocationDriftMapFunc(&model.VpcIpAddressAllocation{Tags: []model.Tag{
+		tag(common.TagScopeNamespace, "ns-1"), tag(common.TagScopeServiceName, "svc-1"),
+	}}))
+	assert.Nil(t, ipAddressAllocationDriftMapFunc(&model.Group{}))
+}
@@ -351,6 +351,11 @@ func (r *NamespaceReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. Neither RestoreReconcile nor CollectGarbage changes NSX.
+func (r *NamespaceReconciler) RestoreDryRun(_ context.Context) (*util.RestorePlan, error) {
+	return &util.RestorePlan{}, nil
+}
+
 func (r *NamespaceReconciler) CollectGarbage(_ context.Context) error {
 	return nil
 }
@@ -68,6 +68,7 @@ var (
 	nsMsgVPCGwConnectionGetError  = newNsUnreadyMessage("Error happened to validate system VPC gateway connection readiness: %v", NSReasonVPCNetConfigNotReady)
 	nsMsgVPCConnectionNotReady    = newNsUnreadyMessage("Neither System VPC gateway nor service cluster is ready", NSReasonVPCNetConfigNotReady)
 	nsMsgVPCCreateUpdateError     = newNsUnreadyMessage("Error happened to create or update VPC: %v", NSReasonVPCNotReady)
+	nsMsgVPCRealizing             = newNsUnreadyMessage("VPC is being realized", NSReasonVPCNotReady)
 	nsMsgVPCNsxLBSNotReady        = newNsUnreadyMessage("Error happened to get NSX LBS path in VPC: %v", NSReasonVPCNotReady)
 	nsMsgVPCAviSubnetError        = newNsUnreadyMessage("Error happened to get Avi Load balancer Subnet info: %v", NSReasonVPCNotReady)
 	nsMsgVPCNSXLBSNATIPError      = newNsUnreadyMessage("Error happened to get NSX Load balancer SNAT IP info: %v", NSReasonVPCNotReady)
@@ -91,6 +92,8 @@ func newNsUnreadyMessage(msg string, reason string) *nsUnreadyMessage {
 	}
 }
 
+// getNSNetworkCondition returns the NamespaceNetworkReady condition of the message, the reason is replaced by the
+// reason code of the error in options if the error is classified, and the remediation hint is added to the message.
 func (m *nsUnreadyMessage) getNSNetworkCondition(options ...interface{}) *corev1.NamespaceCondition {
 	cond := &corev1.NamespaceCondition{
 		Type:   NamespaceNetworkReady,
@@ -99,6 +102,12 @@ func (m *nsUnreadyMessage) getNSNetworkCondition(options ...interface{}) *corev1
 	if m.reason != "" {
 		cond.Status = corev1.ConditionFalse
 		cond.Reason = m.reason
+		for i, option := range options {
+			if err, ok := option.(error); ok {
+				cond.Reason = nsxutil.ErrorReason(err, m.reason)
+				options[i] = nsxutil.WithErrorReason(err)
+			}
+		}
 		cond.Message = fmt.Sprintf(m.msg, options...)
 	}
 	return cond
@@ -331,6 +340,13 @@ func (r *NetworkInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return common.ResultRequeue, nil
 	}
 	createdVpc, err := r.Service.CreateOrUpdateVPC(ctx, networkInfoCR, nc, lbProvider, serviceClusterReady, r.restoreMode)
+	if nsxutil.IsRealizationPendingError(err) {
+		// The NetworkInfo CR is requeued by the realization watcher, or after the realization timeout if the
+		// realization event is dropped.
+		log.Info("VPC is being realized", "NetworkInfo", req.NamespacedName)
+		setNSNetworkReadyCondition(ctx, r.Client, req.Namespace, nsMsgVPCRealizing.getNSNetworkCondition())
+		return common.ResultRequeueAfterRealization, nil
+	}
 	if err != nil {
 		r.StatusUpdater.UpdateFail(ctx, networkInfoCR, err, "Failed to create or update VPC", setNetworkInfoVPCStatusWithError, nil)
 		setNSNetworkReadyCondition(ctx, r.Client, req.Namespace, nsMsgVPCCreateUpdateError.getNSNetworkCondition(err))
@@ -525,9 +541,20 @@ func (r *NetworkInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	additionalStates := make([]v1alpha1.VPCState, 0, len(nc.Spec.AdditionalVPCs))
+	for _, additionalVPC := range nc.Spec.AdditionalVPCs {
+		additionalState, err := r.getAdditionalVPCState(nc, additionalVPC, lbProvider)
+		if err != nil {
+			log.Error(err, "Failed to read additional VPC", "VPC", additionalVPC.VPC, "NetworkInfo", req.NamespacedName)
+			r.StatusUpdater.UpdateFail(ctx, networkInfoCR, err, fmt.Sprintf("Failed to read additional VPC %s", additionalVPC.Name), setNetworkInfoVPCStatusWithError, state)
+			return common.ResultRequeueAfter10sec, err
+		}
+		additionalStates = append(additionalStates, *additionalState)
+	}
+
 	// AKO needs to know the AVI subnet path created by NSX
 	setVPCNetworkConfigurationStatusWithLBS(ctx, r.Client, ncName, state.Name, aviSubnetPath, nsxLBSPath, *createdVpc.Path)
-	r.StatusUpdater.UpdateSuccess(ctx, networkInfoCR, setNetworkInfoVPCStatus, state, allowedDNSDomains)
+	r.StatusUpdater.UpdateSuccess(ctx, networkInfoCR, setNetworkInfoVPCStatus, state, allowedDNSDomains, additionalStates)
 
 	if retryWithSystemVPC {
 		setNSNetworkReadyCondition(ctx, r.Client, req.Namespace, systemNSCondition)
@@ -538,6 +565,73 @@ func (r *NetworkInfoReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return common.ResultNormal, nil
 }
 
+// getAdditionalVPCState reads the VPC state of an additional VPC in the VPCNetworkConfiguration.
+// The additional VPCs are pre-created on NSX, so the state is read from NSX the same way as a
+// pre-created primary VPC.
+func (r *NetworkInfoReconciler) getAdditionalVPCState(nc *v1alpha1.VPCNetworkConfiguration, additionalVPC v1alpha1.AdditionalVPC, lbProvider vpc.LBProvider) (*v1alpha1.VPCState, error) {
+	vpcInfo, err := commonservice.ParseVPCResourcePath(additionalVPC.VPC)
+	if err != nil {
+		return nil, err
+	}
+	additionalVpc, err := r.Service.GetVPCFromNSXByPath(additionalVPC.VPC)
+	if err != nil {
+		return nil, err
+	}
+	networkStack, err := r.Service.GetNetworkStackFromVPCPath(additionalVPC.VPC)
+	if err != nil {
+		return nil, err
+	}
+	state := &v1alpha1.VPCState{
+		Name:         additionalVPC.Name,
+		PrivateIPs:   additionalVpc.PrivateIps,
+		NetworkStack: networkStack,
+	}
+	vpcConnectivityProfilePath, err := r.Service.GetVpcConnectivityProfilePathByVpcPath(additionalVPC.VPC)
+	if err != nil {
+		return nil, err
+	}
+	if len(vpcConnectivityProfilePath) == 0 {
+		return state, nil
+	}
+
+	// The additional VPC may belong to a different NSX project from the primary VPC.
+	additionalNC := nc.DeepCopy()
+	additionalNC.Spec.NSXProject = fmt.Sprintf("/orgs/%s/projects/%s", vpcInfo.OrgID, vpcInfo.ProjectID)
+	vpcConnectivityProfile, err := r.Service.GetVpcConnectivityProfile(additionalNC, vpcConnectivityProfilePath)
+	if err != nil {
+		return nil, err
+	}
+	if vpc.IsEnableAutoSNAT(vpcConnectivityProfile) {
+		if state.DefaultSNATIP, err = r.Service.GetDefaultSNATIP(*additionalVpc); err != nil {
+			return nil, err
+		}
+	}
+
+	var lbIPs []string
+	if lbProvider == vpc.AVILB && additionalVpc.LoadBalancerVpcEndpoint != nil && additionalVpc.LoadBalancerVpcEndpoint.Enabled != nil && *additionalVpc.LoadBalancerVpcEndpoint.Enabled {
+		if _, lbIPs, err = r.Service.GetAVISubnetInfo(*additionalVpc); err != nil {
+			return nil, err
+		}
+	} else if lbProvider == vpc.NSXLB {
+		nsxLBSPath, err := r.Service.GetLBSsFromNSXByVPC(additionalVPC.VPC)
+		if err != nil {
+			return nil, err
+		}
+		if len(nsxLBSPath) > 0 {
+			connectionStatus, err := r.Service.ValidateConnectionStatus(additionalNC, vpcConnectivityProfilePath)
+			if err != nil {
+				return nil, err
+			}
+			if lbIPs, err = r.getNSXLBSNATIPByConnection(additionalVpc, connectionStatus.GatewayConnectionReady, connectionStatus.ServiceClusterReady, networkStack == v1alpha1.VLANBackedVPC); err != nil {
+				return nil, err
+			}
+		}
+	}
+	state.LoadBalancerIPAddresses = primaryLBIP(lbIPs)
+	state.LoadBalancerBackendIPs = lbIPs
+	return state, nil
+}
+
 func (r *NetworkInfoReconciler) getNSXLBSNATIP(nc *v1alpha1.VPCNetworkConfiguration, createdVpc *model.Vpc, vpcConnectivityProfilePath string, gatewayConnectionReady, serviceClusterReady bool, tepLess bool) ([]string, error) {
 	checkGatewayConnection := gatewayConnectionReady
 	checkServiceCluster := serviceClusterReady
@@ -551,6 +645,10 @@ func (r *NetworkInfoReconciler) getNSXLBSNATIP(nc *v1alpha1.VPCNetworkConfigurat
 		checkGatewayConnection = connectionStatus.GatewayConnectionReady
 		checkServiceCluster = connectionStatus.ServiceClusterReady
 	}
+	return r.getNSXLBSNATIPByConnection(createdVpc, checkGatewayConnection, checkServiceCluster, tepLess)
+}
+
+func (r *NetworkInfoReconciler) getNSXLBSNATIPByConnection(createdVpc *model.Vpc, checkGatewayConnection, checkServiceCluster bool, tepLess bool) ([]string, error) {
 	if checkGatewayConnection {
 		// CTGW is used for NSX LB
 		return r.Service.GetNSXLBSNATIP(*createdVpc, "gateway-interface", tepLess)
@@ -608,9 +706,32 @@ func (r *NetworkInfoReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&v1alpha1.SubnetSet{},
 			&SubnetSetHandler{Client: mgr.GetClient()},
 			builder.WithPredicates(PredicateFuncsSubnetSet)).
+		WatchesRawSource(common.NewStoreDriftSourceForTypes([]string{commonservice.ResourceTypeVpc, commonservice.ResourceTypeLBService}, vpcDriftMapFunc)).
+		WatchesRawSource(common.NewRealizationSource(commonservice.ResourceTypeVpc, func() client.Object {
+			return &v1alpha1.NetworkInfo{}
+		})).
 		Complete(r)
 }
 
+// vpcDriftMapFunc maps the drifted NSX VPC or LBS to the NetworkInfo CR it is created for, which has the same name as
+// the Namespace. The pre-created VPCs are not tagged with the Namespace and are skipped.
+func vpcDriftMapFunc(obj interface{}) client.Object {
+	var tags []model.Tag
+	switch o := obj.(type) {
+	case *model.Vpc:
+		tags = o.Tags
+	case *model.LBService:
+		tags = o.Tags
+	default:
+		return nil
+	}
+	namespace := nsxutil.FindTag(tags, commonservice.TagScopeNamespace)
+	if namespace == "" {
+		return nil
+	}
+	return &v1alpha1.NetworkInfo{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: namespace}}
+}
+
 // Start setup manager and launch GC
 func (r *NetworkInfoReconciler) Start(mgr ctrl.Manager) error {
 	err := r.setupWithManager(mgr)
@@ -643,6 +764,32 @@ func (r *NetworkInfoReconciler) listNamespaceCRsNameIDSet(ctx context.Context) (
 	return nsSet, idSet, nil
 }
 
+// listStaleVPCs returns the NSX VPCs whose Namespaces have been deleted.
+func (r *NetworkInfoReconciler) listStaleVPCs(ctx context.Context) ([]model.Vpc, error) {
+	// read all NSX VPC from VPC store
+	nsxVPCList := r.Service.ListVPC()
+	if len(nsxVPCList) == 0 {
+		log.Info("No NSX VPCs found in the store, skipping garbage collection")
+		return nil, nil
+	}
+
+	_, idSet, err := r.listNamespaceCRsNameIDSet(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list Kubernetes Namespaces for VPC garbage collection")
+		return nil, err
+	}
+
+	var staleVPCs []model.Vpc
+	for i := range nsxVPCList {
+		nsxVPCNamespaceID := filterTagFromNSXVPC(&nsxVPCList[i], commonservice.TagScopeNamespaceUID)
+		if idSet.Has(nsxVPCNamespaceID) {
+			continue
+		}
+		staleVPCs = append(staleVPCs, nsxVPCList[i])
+	}
+	return staleVPCs, nil
+}
+
 // CollectGarbage logic for NSX VPC is that:
 // 1. list all current existing namespace in kubernetes
 // 2. list all the NSX VPC in vpcStore
@@ -655,26 +802,14 @@ func (r *NetworkInfoReconciler) CollectGarbage(ctx context.Context) error {
 		log.Info("VPC garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
 	}()
 
-	// read all NSX VPC from VPC store
-	nsxVPCList := r.Service.ListVPC()
-	if len(nsxVPCList) == 0 {
-		log.Info("No NSX VPCs found in the store, skipping garbage collection")
-		return nil
-	}
-
-	_, idSet, err := r.listNamespaceCRsNameIDSet(ctx)
+	staleVPCs, err := r.listStaleVPCs(ctx)
 	if err != nil {
-		log.Error(err, "Failed to list Kubernetes Namespaces for VPC garbage collection")
 		return err
 	}
 
 	var errList []error
-	for i, nsxVPC := range nsxVPCList {
-		nsxVPCNamespaceName := filterTagFromNSXVPC(&nsxVPCList[i], commonservice.TagScopeNamespace)
-		nsxVPCNamespaceID := filterTagFromNSXVPC(&nsxVPCList[i], commonservice.TagScopeNamespaceUID)
-		if idSet.Has(nsxVPCNamespaceID) {
-			continue
-		}
+	for i, nsxVPC := range staleVPCs {
+		nsxVPCNamespaceName := filterTagFromNSXVPC(&staleVPCs[i], commonservice.TagScopeNamespace)
 		log.Info("Garbage collecting NSX VPC object", "VPC", nsxVPC.Id, "Namespace", nsxVPCNamespaceName)
 		r.StatusUpdater.IncreaseDeleteTotal()
 
@@ -843,7 +978,7 @@ func (r *NetworkInfoReconciler) RestoreReconcile() error {
 		log.Info("NetworkInfo restore is not supported")
 		return nil
 	}
-	restoreList, err := r.getRestoreList()
+	restoreList, err := r.getRestoreList(false)
 	if err != nil {
 		err = fmt.Errorf("failed to get NetworkInfo restore list: %w", err)
 		return err
@@ -862,7 +997,35 @@ func (r *NetworkInfoReconciler) RestoreReconcile() error {
 	return nil
 }
 
-func (r *NetworkInfoReconciler) getRestoreList() ([]types.NamespacedName, error) {
+// RestoreDryRun implements the interface RestoreDryRunner.
+func (r *NetworkInfoReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	plan := &util.RestorePlan{}
+	if !r.Service.NSXClient.NSXCheckVersion(nsx.VPCPreferredDefaultSNATIP) {
+		log.Info("NetworkInfo restore is not supported")
+		return plan, nil
+	}
+	restoreList, err := r.getRestoreList(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NetworkInfo restore list: %w", err)
+	}
+	staleVPCs, err := r.listStaleVPCs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	for _, nsxVPC := range staleVPCs {
+		if nsxVPC.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxVPC.Path)
+		}
+	}
+	return plan, nil
+}
+
+// getRestoreList returns the NetworkInfo CRs to be restored, the Namespace status is updated for the pre-created VPCs
+// which do not exist in NSX unless dryRun is true.
+func (r *NetworkInfoReconciler) getRestoreList(dryRun bool) ([]types.NamespacedName, error) {
 	restoreList := []types.NamespacedName{}
 	networkInfos := &v1alpha1.NetworkInfoList{}
 	err := r.Client.List(context.TODO(), networkInfos)
@@ -887,6 +1050,9 @@ func (r *NetworkInfoReconciler) getRestoreList() ([]types.NamespacedName, error)
 				if nsxErr, ok := err.(*nsxutil.NSXApiError); ok {
 					if nsxErr.Type() == stderrors.ErrorType_NOT_FOUND {
 						log.Warn("Precreated VPC does not exist in NSX", "VPC", precreatedVPCPath)
+						if dryRun {
+							continue
+						}
 						// update namespace status
 						setNSNetworkReadyCondition(context.TODO(), r.Client, networkInfo.Namespace, nsMsgVPCCreateUpdateError.getNSNetworkCondition(fmt.Errorf("pre-created VPC is not found in NSX: %s", precreatedVPCPath)))
 						continue
@@ -168,6 +168,19 @@ func createNetworkInfoReconciler(objs []client.Object) *NetworkInfoReconciler {
 	return r
 }
 
+func TestNsUnreadyMessage_getNSNetworkCondition(t *testing.T) {
+	// The reason is kept for the errors not classified.
+	cond := nsMsgVPCCreateUpdateError.getNSNetworkCondition(fmt.Errorf("failed to connect to NSX"))
+	assert.Equal(t, NSReasonVPCNotReady, cond.Reason)
+	assert.Equal(t, "Error happened to create or update VPC: failed to connect to NSX", cond.Message)
+
+	// The reason code and the hint of the classified errors are reported.
+	cond = nsMsgVPCCreateUpdateError.getNSNetworkCondition(nsxutil.IPBlockAllExhaustedError{Desc: "no CIDR is available"})
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, nsxutil.ErrorReasonIPBlockExhausted, cond.Reason)
+	assert.Contains(t, cond.Message, "no CIDR is available. All the IP blocks are exhausted")
+}
+
 func TestNetworkInfoReconciler_Reconcile(t *testing.T) {
 	type args struct {
 		req controllerruntime.Request
@@ -194,6 +207,50 @@ func TestNetworkInfoReconciler_Reconcile(t *testing.T) {
 			want:    common.ResultNormal,
 			wantErr: false,
 		},
+		{
+			name: "VPCBeingRealized",
+			prepareFunc: func(t *testing.T, r *NetworkInfoReconciler, ctx context.Context) (patches *gomonkey.Patches) {
+				assert.NoError(t, r.Client.Create(ctx, &v1alpha1.NetworkInfo{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: requestArgs.req.Namespace,
+						Name:      requestArgs.req.Name,
+					},
+				}))
+				assert.NoError(t, r.Client.Create(ctx, &v1alpha1.VPCNetworkConfiguration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "system",
+					},
+				}))
+				patches = gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "GetNetworkconfigNameFromNS", func(_ *vpc.VPCService, _ context.Context, _ string) (string, error) {
+					return servicecommon.SystemVPCNetworkConfigurationName, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(&vpc.VPCService{}), "GetVPCNetworkConfig", func(_ *vpc.VPCService, _ string) (*v1alpha1.VPCNetworkConfiguration, bool, error) {
+					return &v1alpha1.VPCNetworkConfiguration{
+						ObjectMeta: metav1.ObjectMeta{Name: servicecommon.SystemVPCNetworkConfigurationName},
+						Spec: v1alpha1.VPCNetworkConfigurationSpec{
+							NSXProject: "/orgs/default/projects/project-quality",
+						},
+					}, true, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "ValidateConnectionStatus", func(_ *vpc.VPCService, _ *v1alpha1.VPCNetworkConfiguration, _ string) (*servicecommon.VPCConnectionStatus, error) {
+					return &servicecommon.VPCConnectionStatus{GatewayConnectionReady: true}, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "GetLBProvider", func(_ *vpc.VPCService) (vpc.LBProvider, error) {
+					return vpc.NSXLB, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.Service), "CreateOrUpdateVPC", func(_ *vpc.VPCService, ctx context.Context, _ *v1alpha1.NetworkInfo, _ *v1alpha1.VPCNetworkConfiguration, _ vpc.LBProvider, _ bool, _ bool) (*model.Vpc, error) {
+					return nil, nsxutil.NewRealizationPendingError("being realized")
+				})
+				patches.ApplyFunc(setNSNetworkReadyCondition,
+					func(ctx context.Context, client client.Client, nsName string, condition *corev1.NamespaceCondition) {
+						require.True(t, nsConditionEquals(*condition, *nsMsgVPCRealizing.getNSNetworkCondition()))
+					})
+				return patches
+			},
+			args:    requestArgs,
+			want:    common.ResultRequeueAfterRealization,
+			wantErr: false,
+		},
 		{
 			name: "GatewayConnectionReadyInSystemVPC",
 			prepareFunc: func(t *testing.T, r *NetworkInfoReconciler, ctx context.Context) (patches *gomonkey.Patches) {
@@ -2442,6 +2499,50 @@ func TestNetworkInfoReconciler_RestoreReconcile(t *testing.T) {
 	}
 }
 
+func TestNetworkInfoReconciler_RestoreDryRun(t *testing.T) {
+	networkInfos := []client.Object{
+		&v1alpha1.NetworkInfo{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "networkinfo1"},
+			VPCs:       []v1alpha1.VPCState{{Name: "vpc1"}},
+		},
+		&v1alpha1.NetworkInfo{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "networkinfo2"},
+			VPCs:       []v1alpha1.VPCState{{Name: "vpc2"}},
+		},
+	}
+	r := createNetworkInfoReconciler(networkInfos)
+	v1alpha1.AddToScheme(r.Scheme)
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetNamespacesWithPreCreatedVPCs", func(_ *vpc.VPCService) (map[string]string, error) {
+		return map[string]string{"ns1": "vpc1"}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetVPCFromNSXByPath", func(_ *vpc.VPCService, _ string) (*model.Vpc, error) {
+		return nil, nsxutil.NewNSXApiError(&model.ApiError{}, stderrors.ErrorType_NOT_FOUND)
+	})
+	patches.ApplyFunc(setNSNetworkReadyCondition, func(_ context.Context, _ client.Client, _ string, _ *corev1.NamespaceCondition) {
+		assert.FailNow(t, "Namespace status should not be updated in the dry run")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetCurrentVPCsByNamespace", func(_ *vpc.VPCService, _ context.Context, _ string) []*model.Vpc {
+		return []*model.Vpc{}
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "listStaleVPCs", func(_ *NetworkInfoReconciler, _ context.Context) ([]model.Vpc, error) {
+		return []model.Vpc{{Id: servicecommon.String("vpc3"), Path: servicecommon.String("/orgs/default/projects/p1/vpcs/vpc3")}}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteVPC", func(_ *vpc.VPCService, _ string) error {
+		assert.FailNow(t, "DeleteVPC should not be called in the dry run")
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns2/networkinfo2"}, plan.CRsToRestore)
+	assert.Equal(t, []string{"/orgs/default/projects/p1/vpcs/vpc3"}, plan.NSXResourcesToDelete)
+}
+
 func TestNetworkInfoReconciler_ComputeSubnetSetIPAddressType(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -2730,3 +2831,53 @@ type mockDNSZoneSyncer struct {
 func (s *mockDNSZoneSyncer) SyncDNSZonesByVpcNetworkConfig(_ *v1alpha1.VPCNetworkConfiguration) (map[string]string, error) {
 	return s.dnsZoneConfigurations, s.syncErr
 }
+
+func TestNetworkInfoReconciler_getAdditionalVPCState(t *testing.T) {
+	r := createNetworkInfoReconciler(nil)
+	nc := &v1alpha1.VPCNetworkConfiguration{
+		Spec: v1alpha1.VPCNetworkConfigurationSpec{NSXProject: "/orgs/default/projects/project-quality"},
+	}
+	additionalVPC := v1alpha1.AdditionalVPC{Name: "data", VPC: "/orgs/default/projects/project-data/vpcs/data-vpc"}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "GetVPCFromNSXByPath", func(_ *vpc.VPCService, vpcPath string) (*model.Vpc, error) {
+		assert.Equal(t, additionalVPC.VPC, vpcPath)
+		return &model.Vpc{Id: servicecommon.String("data-vpc"), Path: servicecommon.String(vpcPath), PrivateIps: []string{"10.0.0.0/24"}}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetNetworkStackFromVPCPath", func(_ *vpc.VPCService, _ string) (v1alpha1.NetworkStackType, error) {
+		return v1alpha1.FullStackVPC, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetVpcConnectivityProfilePathByVpcPath", func(_ *vpc.VPCService, _ string) (string, error) {
+		return "/orgs/default/projects/project-data/vpc-connectivity-profiles/default", nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetLBSsFromNSXByVPC", func(_ *vpc.VPCService, _ string) (string, error) {
+		return "/orgs/default/projects/project-data/vpcs/data-vpc/vpc-lbs/default", nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "ValidateConnectionStatus", func(_ *vpc.VPCService, nc *v1alpha1.VPCNetworkConfiguration, _ string) (*servicecommon.VPCConnectionStatus, error) {
+		// The connection status is read from the project of the additional VPC.
+		assert.Equal(t, "/orgs/default/projects/project-data", nc.Spec.NSXProject)
+		return &servicecommon.VPCConnectionStatus{GatewayConnectionReady: true}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetNSXLBSNATIP", func(_ *vpc.VPCService, _ model.Vpc, interfaceID string, _ bool) ([]string, error) {
+		assert.Equal(t, "gateway-interface", interfaceID)
+		return []string{"100.64.0.1"}, nil
+	})
+	defer patches.Reset()
+
+	state, err := r.getAdditionalVPCState(nc, additionalVPC, vpc.NSXLB)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.VPCState{
+		Name:                    "data",
+		PrivateIPs:              []string{"10.0.0.0/24"},
+		NetworkStack:            v1alpha1.FullStackVPC,
+		LoadBalancerIPAddresses: "100.64.0.1",
+		LoadBalancerBackendIPs:  []string{"100.64.0.1"},
+	}, state)
+	// The VPCNetworkConfiguration of the Namespace is not changed.
+	assert.Equal(t, "/orgs/default/projects/project-quality", nc.Spec.NSXProject)
+
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "GetVPCFromNSXByPath", func(_ *vpc.VPCService, _ string) (*model.Vpc, error) {
+		return nil, errors.New("VPC not found")
+	})
+	_, err = r.getAdditionalVPCState(nc, additionalVPC, vpc.NSXLB)
+	assert.ErrorContains(t, err, "VPC not found")
+}
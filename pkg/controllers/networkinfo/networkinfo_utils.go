@@ -27,8 +27,8 @@ func setNetworkInfoVPCStatus(client client.Client, ctx context.Context, obj clie
 	}
 	networkInfo := obj.(*v1alpha1.NetworkInfo)
 	if args[0] == nil {
-		// Not clear the existing VPC in NetworkInfo as
-		// currently one Namespace only maps to one VPC
+		// Not clear the existing VPC in NetworkInfo as the
+		// primary VPC of the Namespace is always reported first
 		return
 	}
 	createdVPC := args[0].(*v1alpha1.VPCState)
@@ -42,14 +42,33 @@ func setNetworkInfoVPCStatus(client client.Client, ctx context.Context, obj clie
 		}
 	}
 
+	// The additional VPCs of the Namespace follow the primary VPC, they are kept
+	// unchanged if not provided.
+	var existingAdditionalVPCs []v1alpha1.VPCState
+	if len(networkInfo.VPCs) > 1 {
+		existingAdditionalVPCs = slices.Clone(networkInfo.VPCs[1:])
+	}
+	additionalVPCs := existingAdditionalVPCs
+	additionalVPCsEqual := true
+	if len(args) >= 3 {
+		if a, ok := args[2].([]v1alpha1.VPCState); ok {
+			additionalVPCs = a
+			for i := range existingAdditionalVPCs {
+				sortVPCStateIPs(&existingAdditionalVPCs[i])
+			}
+			for i := range additionalVPCs {
+				sortVPCStateIPs(&additionalVPCs[i])
+			}
+			additionalVPCsEqual = (len(existingAdditionalVPCs) == 0 && len(additionalVPCs) == 0) || reflect.DeepEqual(existingAdditionalVPCs, additionalVPCs)
+		}
+	}
+
 	existingVPC := &v1alpha1.VPCState{}
 	if len(networkInfo.VPCs) > 0 {
 		existingVPC = &networkInfo.VPCs[0]
 	}
-	slices.Sort(existingVPC.PrivateIPs)
-	slices.Sort(createdVPC.PrivateIPs)
-	slices.Sort(existingVPC.LoadBalancerBackendIPs)
-	slices.Sort(createdVPC.LoadBalancerBackendIPs)
+	sortVPCStateIPs(existingVPC)
+	sortVPCStateIPs(createdVPC)
 
 	domainsEqual := true
 	if updateAllowedDomains {
@@ -59,10 +78,10 @@ func setNetworkInfoVPCStatus(client client.Client, ctx context.Context, obj clie
 		domainsEqual = slices.Equal(currentDomains, allowedDNSDomains)
 	}
 
-	if reflect.DeepEqual(*existingVPC, *createdVPC) && domainsEqual {
+	if reflect.DeepEqual(*existingVPC, *createdVPC) && domainsEqual && additionalVPCsEqual {
 		return
 	}
-	networkInfo.VPCs = []v1alpha1.VPCState{*createdVPC}
+	networkInfo.VPCs = append([]v1alpha1.VPCState{*createdVPC}, additionalVPCs...)
 	if updateAllowedDomains {
 		networkInfo.AllowedDNSDomains = allowedDNSDomains
 	}
@@ -71,6 +90,11 @@ func setNetworkInfoVPCStatus(client client.Client, ctx context.Context, obj clie
 	}
 }
 
+func sortVPCStateIPs(state *v1alpha1.VPCState) {
+	slices.Sort(state.PrivateIPs)
+	slices.Sort(state.LoadBalancerBackendIPs)
+}
+
 func setVPCNetworkConfigurationStatusWithLBS(ctx context.Context, client client.Client, ncName, vpcName, aviSubnetPath, nsxLBSPath, vpcPath string) {
 	// read v1alpha1.VPCNetworkConfiguration by ncName
 	nc := &v1alpha1.VPCNetworkConfiguration{}
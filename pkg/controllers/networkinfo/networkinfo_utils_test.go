@@ -514,3 +514,37 @@ func TestSetNetworkInfoVPCStatus_AllowedDNSDomains(t *testing.T) {
 	require.NoError(t, kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns1", Name: "ni1"}, networkInfoCR))
 	assert.Nil(t, networkInfoCR.AllowedDNSDomains)
 }
+
+func TestSetNetworkInfoVPCStatus_AdditionalVPCs(t *testing.T) {
+	ctx := context.TODO()
+	scheme := clientgoscheme.Scheme
+	v1alpha1.AddToScheme(scheme)
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.NetworkInfo{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ni1"},
+	}).Build()
+
+	networkInfoCR := &v1alpha1.NetworkInfo{}
+	require.NoError(t, kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns1", Name: "ni1"}, networkInfoCR))
+
+	state := &v1alpha1.VPCState{Name: "vpc-a"}
+	additionalStates := []v1alpha1.VPCState{{Name: "data", PrivateIPs: []string{"10.0.1.0/24", "10.0.0.0/24"}}}
+	setNetworkInfoVPCStatus(kubeClient, ctx, networkInfoCR, metav1.Now(), state, []string(nil), additionalStates)
+
+	require.NoError(t, kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns1", Name: "ni1"}, networkInfoCR))
+	require.Len(t, networkInfoCR.VPCs, 2)
+	assert.Equal(t, "vpc-a", networkInfoCR.VPCs[0].Name)
+	assert.Equal(t, "data", networkInfoCR.VPCs[1].Name)
+	assert.Equal(t, []string{"10.0.0.0/24", "10.0.1.0/24"}, networkInfoCR.VPCs[1].PrivateIPs)
+
+	// The additional VPCs are kept if they are not provided.
+	setNetworkInfoVPCStatus(kubeClient, ctx, networkInfoCR, metav1.Now(), &v1alpha1.VPCState{Name: "vpc-a", DefaultSNATIP: "1.1.1.1"})
+	require.NoError(t, kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns1", Name: "ni1"}, networkInfoCR))
+	require.Len(t, networkInfoCR.VPCs, 2)
+	assert.Equal(t, "1.1.1.1", networkInfoCR.VPCs[0].DefaultSNATIP)
+	assert.Equal(t, "data", networkInfoCR.VPCs[1].Name)
+
+	// The additional VPCs are removed once they are removed from the VPCNetworkConfiguration.
+	setNetworkInfoVPCStatus(kubeClient, ctx, networkInfoCR, metav1.Now(), &v1alpha1.VPCState{Name: "vpc-a", DefaultSNATIP: "1.1.1.1"}, []string(nil), []v1alpha1.VPCState{})
+	require.NoError(t, kubeClient.Get(ctx, apitypes.NamespacedName{Namespace: "ns1", Name: "ni1"}, networkInfoCR))
+	require.Len(t, networkInfoCR.VPCs, 1)
+}
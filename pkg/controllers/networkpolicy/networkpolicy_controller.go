@@ -34,6 +34,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/securitypolicy"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -112,6 +113,12 @@ func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		log.Info("Reconciling CR to create or update networkPolicy", "networkPolicy", req.NamespacedName)
 
 		if err := r.Service.CreateOrUpdateSecurityPolicy(networkPolicy); err != nil {
+			if nsxutil.IsRealizationPendingError(err) {
+				// The NetworkPolicy is requeued by the realization watcher, or after the realization timeout if the
+				// realization event is dropped.
+				log.Info("NetworkPolicy is being realized", "networkPolicy", req.NamespacedName)
+				return common.ResultRequeueAfterRealization, nil
+			}
 			if errors.As(err, &nsxutil.RestrictionError{}) {
 				setNetworkPolicyErrorAnnotation(ctx, networkPolicy, r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, networkPolicy, err, "", nil)
@@ -158,6 +165,9 @@ func (r *NetworkPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(PredicateFuncsNs),
 		).
+		WatchesRawSource(common.NewRealizationSource(servicecommon.ResourceTypeNetworkPolicy, func() client.Object {
+			return &networkingv1.NetworkPolicy{}
+		})).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
@@ -174,22 +184,30 @@ func (r *NetworkPolicyReconciler) Start(mgr ctrl.Manager) error {
 	return nil
 }
 
-// CollectGarbage  collect networkpolicy which has been removed from K8s.
-// it implements the interface GarbageCollector method.
-func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("NetworkPolicy garbage collector started")
+// listStaleNetworkPolicyIDs returns the IDs of the NSX SecurityPolicies whose NetworkPolicies have been removed from K8s.
+func (r *NetworkPolicyReconciler) listStaleNetworkPolicyIDs() (sets.Set[string], error) {
 	nsxPolicySet := r.Service.ListNetworkPolicyID()
 	if len(nsxPolicySet) == 0 {
-		return nil
+		return nsxPolicySet, nil
 	}
 
 	CRPolicySet, err := r.listNetworkPolicyCRIDs()
+	if err != nil {
+		return nil, err
+	}
+	return nsxPolicySet.Difference(CRPolicySet), nil
+}
+
+// CollectGarbage  collect networkpolicy which has been removed from K8s.
+// it implements the interface GarbageCollector method.
+func (r *NetworkPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("NetworkPolicy garbage collector started")
+	diffSet, err := r.listStaleNetworkPolicyIDs()
 	if err != nil {
 		return err
 	}
 
 	var errList []error
-	diffSet := nsxPolicySet.Difference(CRPolicySet)
 	for elem := range diffSet {
 		log.Debug("GC collected NetworkPolicy", "ID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
@@ -241,6 +259,20 @@ func (r *NetworkPolicyReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. No NetworkPolicy is restored, only the NSX resources of the
+// deleted NetworkPolicies are reported.
+func (r *NetworkPolicyReconciler) RestoreDryRun(_ context.Context) (*util.RestorePlan, error) {
+	diffSet, err := r.listStaleNetworkPolicyIDs()
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, id := range sets.List(diffSet) {
+		plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, r.Service.ListSecurityPolicyResourcePaths(servicecommon.TagScopeNetworkPolicyUID, id)...)
+	}
+	return plan, nil
+}
+
 func (r *NetworkPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "Failed to create controller", "controller", "NetworkPolicy")
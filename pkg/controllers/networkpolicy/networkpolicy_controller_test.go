@@ -462,6 +462,30 @@ func TestNetworkPolicyReconciler_GarbageCollector(t *testing.T) {
 	}
 }
 
+func TestNetworkPolicyReconciler_RestoreDryRun(t *testing.T) {
+	r := createFakeNetworkPolicyReconciler([]client.Object{
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "np-1", Namespace: "default", UID: types.UID("1234")},
+		},
+	})
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.Service), "ListNetworkPolicyID", func(_ *securitypolicy.SecurityPolicyService) sets.Set[string] {
+		return sets.New[string]("1234_allow", "2345_isolation", "2345_allow")
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "ListSecurityPolicyResourcePaths", func(_ *securitypolicy.SecurityPolicyService, _ string, id string) []string {
+		return []string{"/security-policies/" + id}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.Service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ interface{}, _ bool, _ string) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, plan.CRsToRestore)
+	assert.Equal(t, []string{"/security-policies/2345_allow", "/security-policies/2345_isolation"}, plan.NSXResourcesToDelete)
+}
+
 func TestNetworkPolicyReconciler_listNetworkPolciyCRIDs(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	defer mockCtl.Finish()
@@ -23,6 +23,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/node"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -117,6 +118,12 @@ func (r *NodeReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. The Nodes are only reconciled to get the Node IDs for the
+// Pod restore, no NSX resource is changed.
+func (r *NodeReconciler) RestoreDryRun(_ context.Context) (*util.RestorePlan, error) {
+	return &util.RestorePlan{}, nil
+}
+
 func (r *NodeReconciler) CollectGarbage(_ context.Context) error {
 	return nil
 }
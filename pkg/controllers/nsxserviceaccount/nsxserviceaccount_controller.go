@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,9 +32,12 @@ import (
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 const proxyLabelKey = "mgmt-proxy.antrea-nsx.vmware.com"
@@ -124,7 +128,10 @@ var (
 //
 // GarbageCollector will check and make all Secrets' CA up-to-date on first GC run
 //
-// GarbageCollector will check and rotate client cert if needed on every GCValidationInterval*GCInterval since NSXT 4.1.3
+// GarbageCollector will check and rotate client cert if needed on every GCValidationInterval*GCInterval since NSXT 4.1.3,
+// or on every GCInterval if the client cert in the status expires within the rotation lead time
+//
+// client cert will be rotated immediately if the NSXServiceAccount has annotation "nsx.vmware.com/rotate-cert"
 type NSXServiceAccountReconciler struct {
 	client.Client
 	Scheme        *apimachineryruntime.Scheme
@@ -138,6 +145,9 @@ type NSXServiceAccountReconciler struct {
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.13.0/pkg/reconcile
+//
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=create
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 func (r *NSXServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	obj := &nsxvmwarecomv1alpha1.NSXServiceAccount{}
 	log.Info("reconciling CR", "nsxserviceaccount", req.NamespacedName)
@@ -178,11 +188,31 @@ func (r *NSXServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Re
 					return ResultRequeue, err
 				}
 			}
+			// recreate the token Secret if it is deleted or expires.
+			if nsxserviceaccount.IsTokenCredentialMode(obj) {
+				if err := r.Service.RefreshServiceAccountToken(ctx, obj); err != nil {
+					r.StatusUpdater.UpdateFail(ctx, obj, err, "failed to refresh ServiceAccount token", nil)
+					return ResultRequeue, err
+				}
+			}
 			// update ProxyEndpoints if it has changed.
 			if err := r.Service.UpdateProxyEndpointsIfNeeded(ctx, obj); err != nil {
 				r.StatusUpdater.UpdateFail(ctx, obj, err, "", updateNSXServiceAccountStatuswithError)
 				return ResultRequeue, err
 			}
+			if _, rotateCert := obj.Annotations[servicecommon.AnnotationRotateCert]; rotateCert {
+				log.Info("Rotating client cert on demand", "nsxserviceaccount", req.NamespacedName)
+				// The current client cert is still valid, so the NSXServiceAccount is not marked as failed.
+				if err := r.Service.RotateNSXServiceAccountCert(ctx, obj); err != nil {
+					r.StatusUpdater.UpdateFail(ctx, obj, err, "failed to rotate client cert", nil)
+					return ResultRequeue, err
+				}
+				if err := util.UpdateK8sResourceAnnotation(r.Client, ctx, obj, map[string]string{servicecommon.AnnotationRotateCert: ""}); err != nil {
+					log.Error(err, "Failed to remove the rotate-cert annotation from NSXServiceAccount", "nsxserviceaccount", req.NamespacedName)
+					return ResultRequeue, err
+				}
+			}
+			r.updateCertExpiryMetric(obj)
 			r.StatusUpdater.UpdateSuccess(ctx, obj, updateNSXServiceAccountStatus)
 			return ResultNormal, nil
 		}
@@ -190,6 +220,7 @@ func (r *NSXServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			r.StatusUpdater.UpdateFail(ctx, obj, err, "", updateNSXServiceAccountStatuswithError)
 			return ResultRequeue, err
 		}
+		r.updateCertExpiryMetric(obj)
 		r.StatusUpdater.UpdateSuccess(ctx, obj, updateNSXServiceAccountStatus)
 	} else {
 		if controllerutil.ContainsFinalizer(obj, servicecommon.NSXServiceAccountFinalizerName) {
@@ -207,6 +238,7 @@ func (r *NSXServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Re
 				r.StatusUpdater.DeleteFail(req.NamespacedName, obj, err)
 				return ResultRequeue, err
 			}
+			metrics.GaugeDelete(r.Service.NSXConfig, metrics.NSXServiceAccountCertExpiryDays, obj.Namespace, obj.Name)
 			r.StatusUpdater.DeleteSuccess(req.NamespacedName, nil)
 		} else {
 			// only print a message because it's not a normal case
@@ -304,6 +336,31 @@ func (r *NSXServiceAccountReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. The realized NSXServiceAccounts are restored by Reconcile if
+// their PI/CCP are missing, the PI/CCP of the deleted CRs are collected as garbage.
+func (r *NSXServiceAccountReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	nsxServiceAccountList := &nsxvmwarecomv1alpha1.NSXServiceAccountList{}
+	if err := r.Client.List(ctx, nsxServiceAccountList); err != nil {
+		log.Error(err, "failed to list NSXServiceAccount CR")
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	crUIDSet := sets.New[string]()
+	isRestoreSupported := r.Service.NSXClient.NSXCheckVersion(nsx.ServiceAccountRestore)
+	for i := range nsxServiceAccountList.Items {
+		nsxServiceAccount := &nsxServiceAccountList.Items[i]
+		crUIDSet.Insert(string(nsxServiceAccount.UID))
+		if isRestoreSupported && nsxServiceAccount.DeletionTimestamp.IsZero() && nsxserviceaccount.IsNSXServiceAccountRealized(&nsxServiceAccount.Status) &&
+			r.Service.IsRealizedNSXServiceAccountMissing(nsxServiceAccount) {
+			plan.CRsToRestore = append(plan.CRsToRestore, types.NamespacedName{Namespace: nsxServiceAccount.Namespace, Name: nsxServiceAccount.Name}.String())
+		}
+	}
+	for _, uid := range sets.List(r.Service.ListNSXServiceAccountRealization().Difference(crUIDSet)) {
+		plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, r.Service.ListNSXServiceAccountResourcePaths(uid)...)
+	}
+	return plan, nil
+}
+
 func (r *NSXServiceAccountReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	log.Info("Starting NSXServiceAccountController")
 	if err := r.Start(mgr); err != nil {
@@ -352,9 +409,29 @@ func (r *NSXServiceAccountReconciler) validateRealized(count uint16, ca []byte,
 					log.Error(err, "Failed to update realized NSXServiceAccount", "namespace", nsxServiceAccount.Namespace, "name", nsxServiceAccount.Name)
 					setCountToZero = true
 				}
+				r.updateCertExpiryMetric(&nsxServiceAccount)
 			}
 		}
 		ca = nil
+	} else if nsxServiceAccountList != nil {
+		// Validate client cert in advance if it expires within the rotation lead time
+		for _, account := range nsxServiceAccountList.Items {
+			nsxServiceAccount := account
+			if !nsxserviceaccount.IsNSXServiceAccountRealized(&nsxServiceAccount.Status) {
+				continue
+			}
+			if nsxserviceaccount.IsTokenCredentialMode(&nsxServiceAccount) {
+				// Refresh the ServiceAccount token in advance if it expires within the refresh lead time
+				if err := r.Service.RefreshServiceAccountToken(context.TODO(), &nsxServiceAccount); err != nil {
+					log.Error(err, "Failed to refresh ServiceAccount token of NSXServiceAccount", "namespace", nsxServiceAccount.Namespace, "name", nsxServiceAccount.Name)
+				}
+			} else if r.Service.NeedCertRotation(&nsxServiceAccount) {
+				if err := r.Service.ValidateAndUpdateRealizedNSXServiceAccount(context.TODO(), &nsxServiceAccount, nil, nil); err != nil {
+					log.Error(err, "Failed to rotate client cert of NSXServiceAccount", "namespace", nsxServiceAccount.Namespace, "name", nsxServiceAccount.Name)
+				}
+			}
+			r.updateCertExpiryMetric(&nsxServiceAccount)
+		}
 	}
 	count++
 	if count == servicecommon.GCValidationInterval || setCountToZero {
@@ -388,6 +465,7 @@ func (r *NSXServiceAccountReconciler) garbageCollector(nsxServiceAccountUIDSet s
 			gcErrorCount++
 			r.StatusUpdater.IncreaseDeleteFailTotal()
 		} else {
+			metrics.GaugeDelete(r.Service.NSXConfig, metrics.NSXServiceAccountCertExpiryDays, namespacedName.Namespace, namespacedName.Name)
 			gcSuccessCount++
 			r.StatusUpdater.IncreaseDeleteSuccessTotal()
 		}
@@ -395,6 +473,14 @@ func (r *NSXServiceAccountReconciler) garbageCollector(nsxServiceAccountUIDSet s
 	return
 }
 
+// updateCertExpiryMetric sets the days until the client cert expires to the metric.
+func (r *NSXServiceAccountReconciler) updateCertExpiryMetric(obj *nsxvmwarecomv1alpha1.NSXServiceAccount) {
+	if obj.Status.CertNotAfter == nil {
+		return
+	}
+	metrics.GaugeSet(r.Service.NSXConfig, metrics.NSXServiceAccountCertExpiryDays, time.Until(obj.Status.CertNotAfter.Time).Hours()/24, obj.Namespace, obj.Name)
+}
+
 func updateNSXServiceAccountStatus(client client.Client, ctx context.Context, obj client.Object, _ metav1.Time, _ ...interface{}) {
 	nsa := obj.(*nsxvmwarecomv1alpha1.NSXServiceAccount)
 	err := client.Status().Update(ctx, obj)
@@ -412,7 +498,7 @@ func updateNSXServiceAccountStatuswithError(client client.Client, ctx context.Co
 		nsa = obj.DeepCopy()
 		nsa.Status.Phase = nsxvmwarecomv1alpha1.NSXServiceAccountPhaseFailed
 		nsa.Status.Reason = fmt.Sprintf("Error: %v", e)
-		nsa.Status.Conditions = nsxserviceaccount.GenerateNSXServiceAccountConditions(nsa.Status.Conditions, nsa.Generation, metav1.ConditionFalse, nsxvmwarecomv1alpha1.ConditionReasonRealizationError, fmt.Sprintf("Error: %v", e))
+		nsa.Status.Conditions = nsxserviceaccount.GenerateNSXServiceAccountConditions(nsa.Status.Conditions, nsa.Generation, metav1.ConditionFalse, nsxutil.ErrorReason(e, nsxvmwarecomv1alpha1.ConditionReasonRealizationError), fmt.Sprintf("Error: %v", e))
 	}
 	err := client.Status().Update(ctx, nsa)
 	if err != nil {
@@ -36,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/nsxserviceaccount"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 type fakeRecorder struct {
@@ -267,6 +268,95 @@ func TestNSXServiceAccountReconciler_Reconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "RotateCert",
+			prepareFunc: func(t *testing.T, r *NSXServiceAccountReconciler, ctx context.Context) (patches *gomonkey.Patches) {
+				assert.NoError(t, r.Client.Create(ctx, &nsxvmwarecomv1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   requestArgs.req.Namespace,
+						Name:        requestArgs.req.Name,
+						Annotations: map[string]string{servicecommon.AnnotationRotateCert: "true"},
+					},
+					Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+						Phase: nsxvmwarecomv1alpha1.NSXServiceAccountPhaseRealized,
+					},
+				}))
+				cluster := &nsx.Cluster{}
+				patches = gomonkey.ApplyMethod(reflect.TypeOf(cluster), "GetVersion", func(_ *nsx.Cluster) (*nsx.NsxVersion, error) {
+					nsxVersion := &nsx.NsxVersion{NodeVersion: "4.0.1"}
+					return nsxVersion, nil
+				})
+				patches.ApplyMethodSeq(r.Service, "UpdateProxyEndpointsIfNeeded", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{nil},
+					Times:  1,
+				}})
+				patches.ApplyMethodSeq(r.Service, "RotateNSXServiceAccountCert", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{nil},
+					Times:  1,
+				}})
+				return patches
+			},
+			args:    requestArgs,
+			want:    ResultNormal,
+			wantErr: false,
+			expectedCR: &nsxvmwarecomv1alpha1.NSXServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       requestArgs.req.Namespace,
+					Name:            requestArgs.req.Name,
+					Finalizers:      []string{servicecommon.NSXServiceAccountFinalizerName},
+					ResourceVersion: "4",
+				},
+				Spec: nsxvmwarecomv1alpha1.NSXServiceAccountSpec{},
+				Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+					Phase: nsxvmwarecomv1alpha1.NSXServiceAccountPhaseRealized,
+				},
+			},
+		},
+		{
+			name: "RotateCertFail",
+			prepareFunc: func(t *testing.T, r *NSXServiceAccountReconciler, ctx context.Context) (patches *gomonkey.Patches) {
+				assert.NoError(t, r.Client.Create(ctx, &nsxvmwarecomv1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   requestArgs.req.Namespace,
+						Name:        requestArgs.req.Name,
+						Annotations: map[string]string{servicecommon.AnnotationRotateCert: "true"},
+					},
+					Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+						Phase: nsxvmwarecomv1alpha1.NSXServiceAccountPhaseRealized,
+					},
+				}))
+				cluster := &nsx.Cluster{}
+				patches = gomonkey.ApplyMethod(reflect.TypeOf(cluster), "GetVersion", func(_ *nsx.Cluster) (*nsx.NsxVersion, error) {
+					nsxVersion := &nsx.NsxVersion{NodeVersion: "4.0.1"}
+					return nsxVersion, nil
+				})
+				patches.ApplyMethodSeq(r.Service, "UpdateProxyEndpointsIfNeeded", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{nil},
+					Times:  1,
+				}})
+				patches.ApplyMethodSeq(r.Service, "RotateNSXServiceAccountCert", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{fmt.Errorf("mock error")},
+					Times:  1,
+				}})
+				return patches
+			},
+			args:    requestArgs,
+			want:    ResultRequeue,
+			wantErr: true,
+			expectedCR: &nsxvmwarecomv1alpha1.NSXServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       requestArgs.req.Namespace,
+					Name:            requestArgs.req.Name,
+					Annotations:     map[string]string{servicecommon.AnnotationRotateCert: "true"},
+					Finalizers:      []string{servicecommon.NSXServiceAccountFinalizerName},
+					ResourceVersion: "2",
+				},
+				Spec: nsxvmwarecomv1alpha1.NSXServiceAccountSpec{},
+				Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+					Phase: nsxvmwarecomv1alpha1.NSXServiceAccountPhaseRealized,
+				},
+			},
+		},
 		{
 			name: "RestoreFail",
 			prepareFunc: func(t *testing.T, r *NSXServiceAccountReconciler, ctx context.Context) (patches *gomonkey.Patches) {
@@ -783,6 +873,69 @@ func TestNSXServiceAccountReconciler_updateNSXServiceAccountStatuswithError(t *t
 				},
 			},
 		},
+		{
+			name: "classified error",
+			initial: args{
+				o: &nsxvmwarecomv1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "name1",
+						Namespace: "ns1",
+					},
+				},
+			},
+			args: args{
+				ctx: ctx,
+				o: &nsxvmwarecomv1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "name1",
+						Namespace:       "ns1",
+						ResourceVersion: "1",
+					},
+					Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+						VPCPath:        "testVPCPath",
+						NSXManagers:    []string{"dummyHost:443"},
+						ProxyEndpoints: nsxvmwarecomv1alpha1.NSXProxyEndpoint{},
+						ClusterID:      "testClusterID",
+						ClusterName:    "testClusterName",
+						Secrets: []nsxvmwarecomv1alpha1.NSXSecret{{
+							Name:      "testSecret",
+							Namespace: "ns1",
+						}},
+					},
+				},
+				e: nsxutil.ExceedTagsError{Desc: "too many tags"},
+			},
+			expected: args{
+				o: &nsxvmwarecomv1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "name1",
+						Namespace:       "ns1",
+						ResourceVersion: "2",
+					},
+					Status: nsxvmwarecomv1alpha1.NSXServiceAccountStatus{
+						Phase:  nsxvmwarecomv1alpha1.NSXServiceAccountPhaseFailed,
+						Reason: "Error: too many tags",
+						Conditions: []metav1.Condition{
+							{
+								Type:    nsxvmwarecomv1alpha1.ConditionTypeRealized,
+								Status:  metav1.ConditionFalse,
+								Reason:  nsxutil.ErrorReasonTagLimitExceeded,
+								Message: "Error: too many tags",
+							},
+						},
+						VPCPath:        "testVPCPath",
+						NSXManagers:    []string{"dummyHost:443"},
+						ProxyEndpoints: nsxvmwarecomv1alpha1.NSXProxyEndpoint{},
+						ClusterID:      "testClusterID",
+						ClusterName:    "testClusterName",
+						Secrets: []nsxvmwarecomv1alpha1.NSXSecret{{
+							Name:      "testSecret",
+							Namespace: "ns1",
+						}},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1062,6 +1215,42 @@ func TestNSXServiceAccountReconciler_validateRealized(t *testing.T) {
 	}
 }
 
+func TestNSXServiceAccountReconciler_validateRealizedCertRotation(t *testing.T) {
+	r := &NSXServiceAccountReconciler{
+		Service: &nsxserviceaccount.NSXServiceAccountService{
+			Service: servicecommon.Service{
+				NSXConfig: &config.NSXOperatorConfig{
+					NsxConfig: &config.NsxConfig{CertRotationLeadDays: 7},
+				},
+			},
+		},
+	}
+	validated := []string{}
+	patches := gomonkey.ApplyMethod(r.Service, "ValidateAndUpdateRealizedNSXServiceAccount", func(_ *nsxserviceaccount.NSXServiceAccountService, _ context.Context, obj *nsxvmwarecomv1alpha1.NSXServiceAccount, ca []byte, _ *nsxvmwarecomv1alpha1.NSXRestoreStatus) error {
+		assert.Nil(t, ca)
+		validated = append(validated, obj.Name)
+		return nil
+	})
+	defer patches.Reset()
+
+	realized := nsxvmwarecomv1alpha1.NSXServiceAccountStatus{Phase: nsxvmwarecomv1alpha1.NSXServiceAccountPhaseRealized}
+	expiring := realized
+	expiring.CertNotAfter = &metav1.Time{Time: time.Now().AddDate(0, 0, 3)}
+	notExpiring := realized
+	notExpiring.CertNotAfter = &metav1.Time{Time: time.Now().AddDate(0, 0, 30)}
+	list := &nsxvmwarecomv1alpha1.NSXServiceAccountList{
+		Items: []nsxvmwarecomv1alpha1.NSXServiceAccount{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "expiring"}, Spec: nsxvmwarecomv1alpha1.NSXServiceAccountSpec{EnableCertRotation: true}, Status: expiring},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "not-expiring"}, Spec: nsxvmwarecomv1alpha1.NSXServiceAccountSpec{EnableCertRotation: true}, Status: notExpiring},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "rotation-disabled"}, Status: expiring},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "unknown-expiry"}, Spec: nsxvmwarecomv1alpha1.NSXServiceAccountSpec{EnableCertRotation: true}, Status: realized},
+		},
+	}
+	got, _ := r.validateRealized(1, nil, list)
+	assert.Equal(t, uint16(2), got)
+	assert.Equal(t, []string{"expiring"}, validated)
+}
+
 func TestNSXServiceAccountReconciler_serviceMapFunc(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
@@ -5,6 +5,7 @@ package pod
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -32,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
@@ -89,9 +92,20 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		if subnetSetLock != nil {
 			defer common.RUnlockSubnetSet(*subnetSetUID, subnetSetLock)
 		}
+		if nsxutil.IsRealizationPendingError(err) {
+			// The Pod is requeued when the Subnet created for the SubnetSet is realized, or after the realization
+			// timeout if the realization event is dropped.
+			log.Info("Waiting for the Subnet of the SubnetSet to be realized", "Pod", req.NamespacedName)
+			return common.ResultRequeueAfterRealization, nil
+		}
 		if err != nil {
 			log.Error(err, "Failed to get NSX resource path from Subnet", "pod.Name", pod.Name, "pod.UID", pod.UID)
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
+			// No need to retry until the pinned addresses in the Pod annotations are fixed by the user.
+			var validationErr *nsxutil.ValidationError
+			if errors.As(err, &validationErr) {
+				return common.ResultNormal, nil
+			}
 			return common.ResultRequeue, err
 		}
 		if !isExisting {
@@ -116,8 +130,17 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 			return common.ResultRequeue, err
 		}
 		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(pod, nsxSubnet, contextID, &pod.ObjectMeta.Labels, false, r.restoreMode, interfaceIPType)
+		if nsxutil.IsRealizationPendingError(err) {
+			// The Pod is requeued by the realization watcher, or after the realization timeout if the realization
+			// event is dropped.
+			return common.ResultRequeueAfterRealization, nil
+		}
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, pod, err, "", nil)
+			var validationErr *nsxutil.ValidationError
+			if errors.As(err, &validationErr) {
+				return common.ResultNormal, nil
+			}
 			return common.ResultRequeue, err
 		}
 		// appplatform webhook may be down if cpvm network is not restored, which blocks annotation update
@@ -150,6 +173,11 @@ func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 					"pod", subnetPort.DisplayName, "statefulset-uid", r.getStsUID(subnetPort))
 				return common.ResultNormal, nil
 			}
+			if isPinnedSubnetPort(subnetPort) {
+				log.Info("Retaining subnet port with pinned addresses for Pod", "pod", subnetPort.DisplayName)
+				r.StatusUpdater.DeleteSuccess(req.NamespacedName, pod)
+				return common.ResultNormal, nil
+			}
 			if err := r.SubnetPortService.DeleteSubnetPort(subnetPort); err != nil {
 				r.StatusUpdater.DeleteFail(req.NamespacedName, pod, err)
 				return common.ResultRequeue, err
@@ -272,9 +300,36 @@ func (r *PodReconciler) setupWithManager(mgr ctrl.Manager) error {
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			}).
+		WatchesRawSource(common.NewRealizationSource(realizestate.RealizationKindPod, func() client.Object {
+			return &v1.Pod{}
+		})).
+		WatchesRawSource(common.NewRealizationSourceWithMapFunc(realizestate.RealizationKindSubnetSet, func() client.Object {
+			return &v1alpha1.SubnetSet{}
+		}, r.subnetSetRealizationMapFunc)).
 		Complete(r)
 }
 
+// subnetSetRealizationMapFunc maps the SubnetSet whose Subnet is realized to the Pods waiting for it, they are the
+// scheduled Pods in the Namespace without the MAC annotation.
+func (r *PodReconciler) subnetSetRealizationMapFunc(ctx context.Context, subnetSet client.Object) []reconcile.Request {
+	podList := &v1.PodList{}
+	if err := r.Client.List(ctx, podList, client.InNamespace(subnetSet.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list Pods waiting for SubnetSet", "SubnetSet", client.ObjectKeyFromObject(subnetSet))
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, pod := range podList.Items {
+		if pod.Spec.HostNetwork || pod.Spec.NodeName == "" || common.PodIsDeleted(&pod) {
+			continue
+		}
+		if _, ok := pod.Annotations[servicecommon.AnnotationPodMAC]; ok {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}})
+	}
+	return requests
+}
+
 func (r *PodReconciler) RestoreReconcile() error {
 	restoreList, err := r.getRestoreList()
 	if err != nil {
@@ -312,6 +367,28 @@ func (r *PodReconciler) getRestoreList() ([]types.NamespacedName, error) {
 	return restoreList, nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner.
+func (r *PodReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pod restore list: %w", err)
+	}
+	diffSet, err := r.listStaleSubnetPortIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	for _, id := range sets.List(diffSet) {
+		if nsxSubnetPort := r.SubnetPortService.SubnetPortStore.GetByKey(id); nsxSubnetPort != nil && nsxSubnetPort.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxSubnetPort.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *PodReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "failed to create controller", "controller", "Pod")
@@ -345,22 +422,26 @@ func (r *PodReconciler) Start(mgr ctrl.Manager) error {
 	return nil
 }
 
-// CollectGarbage  collect Pod which has been removed from crd.
-func (r *PodReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("pod garbage collector started")
+// listStaleSubnetPortIDs returns the IDs of the NSX SubnetPorts whose Pods have been deleted, the StatefulSet Pod
+// SubnetPorts and the SubnetPorts with the addresses still pinned by a Pod are excluded.
+func (r *PodReconciler) listStaleSubnetPortIDs(ctx context.Context) (sets.Set[string], error) {
 	nsxSubnetPortSet := r.SubnetPortService.ListNSXSubnetPortIDForPod()
 	if len(nsxSubnetPortSet) == 0 {
-		return nil
+		return nsxSubnetPortSet, nil
 	}
 	podList := &v1.PodList{}
 	err := r.Client.List(ctx, podList)
 	if err != nil {
 		log.Error(err, "failed to list Pod")
-		return err
+		return nil, err
 	}
 
 	PodSet := sets.New[string]()
+	staticAddressSet := sets.New[string]()
 	for _, pod := range podList.Items {
+		if key := subnetport.GetPodStaticAddressKey(&pod); key != "" {
+			staticAddressSet.Insert(pod.Namespace + "/" + key)
+		}
 		subnetPort, err := r.SubnetPortService.SubnetPortStore.GetVpcSubnetPortByUID(pod.GetUID())
 		if err != nil || subnetPort == nil {
 			log.Info("Not found existing VpcSubnetPort for Pod", "POD UID", pod.GetUID())
@@ -369,21 +450,45 @@ func (r *PodReconciler) CollectGarbage(ctx context.Context) error {
 		PodSet.Insert(*subnetPort.Id)
 	}
 
-	var errList []error
 	diffSet := nsxSubnetPortSet.Difference(PodSet)
 	for elem := range diffSet {
 		// StatefulSet pod ports are keyed in the pod-UID index but may briefly outlive the old Pod
 		// object (crash/replace window). STS SubnetPort lifecycle is owned by the StatefulSet
 		// reconciler when the feature is enabled; do not delete here (same as deleteSubnetPortByPodName).
 		store := r.SubnetPortService.SubnetPortStore
-		if store != nil && store.Indexer != nil {
-			if nsxSubnetPort := store.GetByKey(elem); nsxSubnetPort != nil &&
-				r.SubnetPortService.NSXClient != nil &&
-				nsx.StatefulSetPodSubnetPortFeatureEnabled(r.SubnetPortService.NSXClient, r.SubnetPortService.NSXConfig) && r.isStatefulSetSubnetPort(nsxSubnetPort) {
-				log.Info("Skipping pod GC for StatefulSet pod subnet port", "NSXSubnetPortID", elem, "statefulset-uid", r.getStsUID(nsxSubnetPort))
-				continue
-			}
+		if store == nil || store.Indexer == nil {
+			continue
 		}
+		nsxSubnetPort := store.GetByKey(elem)
+		if nsxSubnetPort == nil {
+			continue
+		}
+		if r.SubnetPortService.NSXClient != nil &&
+			nsx.StatefulSetPodSubnetPortFeatureEnabled(r.SubnetPortService.NSXClient, r.SubnetPortService.NSXConfig) && r.isStatefulSetSubnetPort(nsxSubnetPort) {
+			log.Info("Skipping pod GC for StatefulSet pod subnet port", "NSXSubnetPortID", elem, "statefulset-uid", r.getStsUID(nsxSubnetPort))
+			diffSet.Delete(elem)
+			continue
+		}
+		// The SubnetPort with pinned addresses is kept for the Pod which replaces the deleted one.
+		if isPinnedSubnetPort(nsxSubnetPort) && staticAddressSet.Has(nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopeNamespace)+"/"+
+			nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopePodStaticAddress)) {
+			log.Info("Skipping pod GC for subnet port with pinned addresses", "NSXSubnetPortID", elem)
+			diffSet.Delete(elem)
+		}
+	}
+	return diffSet, nil
+}
+
+// CollectGarbage  collect Pod which has been removed from crd.
+func (r *PodReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("pod garbage collector started")
+	diffSet, err := r.listStaleSubnetPortIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errList []error
+	for elem := range diffSet {
 		log.Debug("GC collected Pod", "NSXSubnetPortID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
 		err = r.SubnetPortService.DeleteSubnetPortById(elem)
@@ -408,6 +513,60 @@ func (r *PodReconciler) getSubnetByPod(pod *v1.Pod, subnetSet *v1alpha1.SubnetSe
 	return common.GetSubnetByIP(subnets, net.ParseIP(pod.Status.PodIP))
 }
 
+// getSubnetByStaticIPs returns the Subnet in the SubnetSet which contains all the pinned IP addresses of the Pod,
+// and allocates a port from it. The SubnetSet is locked in the same way as common.AllocateSubnetFromSubnetSet, the
+// read lock of the pre-created SubnetSet is returned and released by the caller after the SubnetPort is created.
+func (r *PodReconciler) getSubnetByStaticIPs(subnetSet *v1alpha1.SubnetSet, staticIPs []string, interfaceIPType v1alpha1.IPAddressType) (string, *types.UID, *sync.RWMutex, error) {
+	var subnetSetUID *types.UID
+	var subnetSetLock *sync.RWMutex
+	if subnetSet.Spec.SubnetNames != nil {
+		subnetSetLock = common.RLockSubnetSet(subnetSet.UID)
+		subnetSetUID = &subnetSet.UID
+		// Retrieve the SubnetSet again to avoid it being updated before acquiring the lock
+		if err := r.APIReader.Get(context.Background(), types.NamespacedName{Namespace: subnetSet.Namespace, Name: subnetSet.Name}, subnetSet); err != nil {
+			return "", subnetSetUID, subnetSetLock, err
+		}
+	} else {
+		lock := common.WLockSubnetSet(subnetSet.GetUID())
+		defer common.WUnlockSubnetSet(subnetSet.GetUID(), lock)
+	}
+	subnets, err := common.GetNSXSubnetsForSubnetSet(r.Client, subnetSet, r.SubnetService)
+	if err != nil {
+		return "", subnetSetUID, subnetSetLock, err
+	}
+	for _, nsxSubnet := range subnets {
+		if nsxSubnet.Path == nil || !subnetContainsIPs(nsxSubnet, staticIPs) {
+			continue
+		}
+		canAllocate, err := r.SubnetPortService.AllocatePortFromSubnet(nsxSubnet, false, interfaceIPType)
+		if err != nil {
+			return "", subnetSetUID, subnetSetLock, err
+		}
+		if !canAllocate {
+			return "", subnetSetUID, subnetSetLock, nsxutil.SubnetExhaustedError{Desc: fmt.Sprintf("no available port in Subnet %s for pinned IP addresses %v", *nsxSubnet.Path, staticIPs)}
+		}
+		return *nsxSubnet.Path, subnetSetUID, subnetSetLock, nil
+	}
+	return "", subnetSetUID, subnetSetLock, fmt.Errorf("failed to find Subnet matching pinned IP addresses %v", staticIPs)
+}
+
+func subnetContainsIPs(nsxSubnet *model.VpcSubnet, ips []string) bool {
+	for _, ipStr := range ips {
+		ip := net.ParseIP(ipStr)
+		contained := false
+		for _, cidr := range nsxSubnet.IpAddresses {
+			if _, ipnet, err := net.ParseCIDR(cidr); err == nil && ipnet.Contains(ip) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (bool, string, *types.UID, *sync.RWMutex, v1alpha1.IPAddressType, error) {
 	var subnetSetLock *sync.RWMutex
 	var subnetSetUID *types.UID
@@ -438,6 +597,19 @@ func (r *PodReconciler) GetSubnetPathForPod(ctx context.Context, pod *v1.Pod) (b
 		return false, "", subnetSetUID, subnetSetLock, "", fmt.Errorf("default Pod SubnetSet IPAddressType is under calculation")
 	}
 	interfacetype := subnetport.GetDefaultInterfaceIPType(subnetSet.Spec.IPAddressType, subnetSet.Spec.IPAddressType)
+	_, staticIPs, err := subnetport.GetPodStaticAddresses(pod)
+	if err != nil {
+		return false, "", subnetSetUID, subnetSetLock, interfacetype, err
+	}
+	if len(staticIPs) > 0 {
+		// The Pod with pinned IP addresses can only be created on the Subnet containing the IP addresses
+		subnetPath, subnetSetUID, subnetSetLock, err = r.getSubnetByStaticIPs(subnetSet, staticIPs, interfacetype)
+		if err != nil {
+			return false, "", subnetSetUID, subnetSetLock, interfacetype, err
+		}
+		log.Info("Allocated NSX Subnet for Pod with pinned IP addresses", "nsxSubnetPath", subnetPath, "staticIPs", staticIPs, "pod.Name", pod.Name, "pod.UID", pod.UID)
+		return false, subnetPath, subnetSetUID, subnetSetLock, interfacetype, nil
+	}
 	subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfacetype)
 	if err != nil {
 		return false, subnetPath, subnetSetUID, subnetSetLock, interfacetype, err
@@ -458,6 +630,10 @@ func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string
 				"pod", name, "statefulset-uid", r.getStsUID(nsxSubnetPort))
 			continue // Skip deletion
 		}
+		if isPinnedSubnetPort(nsxSubnetPort) {
+			log.Info("Retaining subnet port with pinned addresses for Pod", "pod", name)
+			continue
+		}
 
 		// Normal pod: delete the subnet port
 		if err := r.SubnetPortService.DeleteSubnetPort(nsxSubnetPort); err != nil {
@@ -468,6 +644,12 @@ func (r *PodReconciler) deleteSubnetPortByPodName(ctx context.Context, ns string
 	return nil
 }
 
+// isPinnedSubnetPort returns true if the SubnetPort holds the addresses pinned by the Pod annotations, it is retained
+// after the Pod is deleted until no Pod in the Namespace pins the same addresses.
+func isPinnedSubnetPort(nsxSubnetPort *model.VpcSubnetPort) bool {
+	return nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopePodStaticAddress) != ""
+}
+
 func (r *PodReconciler) isStatefulSetSubnetPort(nsxSubnetPort *model.VpcSubnetPort) bool {
 	return r.getStsUID(nsxSubnetPort) != ""
 }
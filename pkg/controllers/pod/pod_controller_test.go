@@ -10,6 +10,7 @@ import (
 
 	gomonkey "github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 type fakeRecorder struct{}
@@ -437,6 +440,62 @@ func TestPodReconciler_getSubnetByPod(t *testing.T) {
 	assert.Equal(t, "/subnet-2", subnetPath)
 }
 
+func TestPodReconciler_getSubnetByStaticIPs(t *testing.T) {
+	r := &PodReconciler{
+		SubnetService:     &subnet.SubnetService{},
+		SubnetPortService: &subnetport.SubnetPortService{},
+	}
+
+	patches := gomonkey.ApplyFunc(common.GetNSXSubnetsForSubnetSet, func(client client.Client, subnetSet *v1alpha1.SubnetSet, subnetService servicecommon.SubnetServiceProvider) ([]*model.VpcSubnet, error) {
+		return []*model.VpcSubnet{
+			{
+				Path:        servicecommon.String("/subnet-1"),
+				IpAddresses: []string{"10.0.0.0/28"},
+			},
+			{
+				Path:        servicecommon.String("/subnet-2"),
+				IpAddresses: []string{"10.0.0.16/28", "2001:db8::/64"},
+			},
+		}, nil
+	})
+	defer patches.Reset()
+	canAllocate := true
+	patches.ApplyFunc((*subnetport.SubnetPortService).AllocatePortFromSubnet, func(s *subnetport.SubnetPortService, nsxSubnet *model.VpcSubnet, sharedSubnet bool, interfaceIPType v1alpha1.IPAddressType) (bool, error) {
+		assert.Equal(t, "/subnet-2", *nsxSubnet.Path)
+		return canAllocate, nil
+	})
+
+	subnetPath, subnetSetUID, subnetSetLock, err := r.getSubnetByStaticIPs(&v1alpha1.SubnetSet{}, []string{"10.0.0.20", "2001:db8::5"}, v1alpha1.IPAddressTypeIPv4IPv6)
+	assert.Nil(t, err)
+	assert.Equal(t, "/subnet-2", subnetPath)
+	assert.Nil(t, subnetSetUID)
+	assert.Nil(t, subnetSetLock)
+
+	_, _, _, err = r.getSubnetByStaticIPs(&v1alpha1.SubnetSet{}, []string{"10.0.0.5", "2001:db8::5"}, v1alpha1.IPAddressTypeIPv4IPv6)
+	assert.ErrorContains(t, err, "failed to find Subnet matching pinned IP addresses")
+
+	canAllocate = false
+	_, _, _, err = r.getSubnetByStaticIPs(&v1alpha1.SubnetSet{}, []string{"10.0.0.20"}, v1alpha1.IPAddressTypeIPv4)
+	assert.ErrorContains(t, err, "no available port in Subnet /subnet-2")
+	assert.Equal(t, nsxutil.ErrorReasonSubnetIPExhausted, nsxutil.ErrorReason(err, ""))
+
+	// The read lock of the pre-created SubnetSet is returned to the caller.
+	canAllocate = true
+	subnetSet := &v1alpha1.SubnetSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-default", Namespace: "ns1", UID: "subnetset-uid"},
+		Spec:       v1alpha1.SubnetSetSpec{SubnetNames: &[]string{"subnet-2"}},
+	}
+	scheme := runtime.NewScheme()
+	v1alpha1.AddToScheme(scheme)
+	r.APIReader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(subnetSet.DeepCopy()).Build()
+	subnetPath, subnetSetUID, subnetSetLock, err = r.getSubnetByStaticIPs(subnetSet, []string{"10.0.0.20"}, v1alpha1.IPAddressTypeIPv4)
+	assert.Nil(t, err)
+	assert.Equal(t, "/subnet-2", subnetPath)
+	assert.Equal(t, subnetSet.UID, *subnetSetUID)
+	assert.NotNil(t, subnetSetLock)
+	common.RUnlockSubnetSet(*subnetSetUID, subnetSetLock)
+}
+
 func TestPodReconciler_CollectGarbage(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
@@ -487,6 +546,51 @@ func TestPodReconciler_CollectGarbage(t *testing.T) {
 	r.CollectGarbage(context.TODO())
 }
 
+func TestPodReconciler_listStaleSubnetPortIDs(t *testing.T) {
+	pinnedPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "pod-2", UID: "pod-2-uid",
+		Annotations: map[string]string{servicecommon.AnnotationPodStaticMAC: "04:50:56:00:fa:01"}}}
+	r := &PodReconciler{
+		Client: fake.NewClientBuilder().WithObjects(pinnedPod).Build(),
+		SubnetPortService: &subnetport.SubnetPortService{
+			SubnetPortStore: &subnetport.SubnetPortStore{ResourceStore: servicecommon.ResourceStore{
+				Indexer: cache.NewIndexer(cache.MetaNamespaceKeyFunc, nil),
+			}},
+		},
+	}
+	ports := map[string]*model.VpcSubnetPort{
+		// The Pod is deleted and the addresses are pinned by the Pod which replaces it.
+		"port-1": {Id: servicecommon.String("port-1"), Tags: []model.Tag{
+			{Scope: servicecommon.String(servicecommon.TagScopeNamespace), Tag: servicecommon.String("ns-1")},
+			{Scope: servicecommon.String(servicecommon.TagScopePodStaticAddress), Tag: servicecommon.String("04:50:56:00:fa:01")},
+		}},
+		// The addresses are pinned by no Pod.
+		"port-2": {Id: servicecommon.String("port-2"), Tags: []model.Tag{
+			{Scope: servicecommon.String(servicecommon.TagScopeNamespace), Tag: servicecommon.String("ns-1")},
+			{Scope: servicecommon.String(servicecommon.TagScopePodStaticAddress), Tag: servicecommon.String("04:50:56:00:fa:02")},
+		}},
+		// The addresses are pinned by a Pod in another Namespace.
+		"port-3": {Id: servicecommon.String("port-3"), Tags: []model.Tag{
+			{Scope: servicecommon.String(servicecommon.TagScopeNamespace), Tag: servicecommon.String("ns-2")},
+			{Scope: servicecommon.String(servicecommon.TagScopePodStaticAddress), Tag: servicecommon.String("04:50:56:00:fa:01")},
+		}},
+		"port-4": {Id: servicecommon.String("port-4")},
+	}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "ListNSXSubnetPortIDForPod", func(_ *subnetport.SubnetPortService) sets.Set[string] {
+		return sets.New[string]("port-1", "port-2", "port-3", "port-4")
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService.SubnetPortStore), "GetVpcSubnetPortByUID", func(_ *subnetport.SubnetPortStore, uid types.UID) (*model.VpcSubnetPort, error) {
+		return nil, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService.SubnetPortStore), "GetByKey", func(_ *subnetport.SubnetPortStore, key string) *model.VpcSubnetPort {
+		return ports[key]
+	})
+
+	diffSet, err := r.listStaleSubnetPortIDs(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"port-2", "port-3", "port-4"}, sets.List(diffSet))
+}
+
 func TestPodReconciler_GetNodeByName(t *testing.T) {
 	r := &PodReconciler{
 		NodeServiceReader: &node.NodeService{},
@@ -810,6 +914,27 @@ func TestPodReconciler_deleteSubnetPortByPodName(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPodReconciler_deleteSubnetPortByPodNameRetainsPinnedPort(t *testing.T) {
+	r := &PodReconciler{
+		SubnetPortService: &subnetport.SubnetPortService{},
+	}
+	pinnedPort := &model.VpcSubnetPort{Id: servicecommon.String("subnetport-1"), Tags: []model.Tag{
+		{Scope: servicecommon.String(servicecommon.TagScopePodStaticAddress), Tag: servicecommon.String("04:50:56:00:fa:01")},
+	}}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "ListSubnetPortByPodName", func(_ *subnetport.SubnetPortService, _ string, _ string) []*model.VpcSubnetPort {
+		return []*model.VpcSubnetPort{pinnedPort}
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(nsx.StatefulSetPodSubnetPortFeatureEnabled, func(_ *nsx.Client, _ *config.NSXOperatorConfig) bool {
+		return false
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeleteSubnetPort", func(_ *subnetport.SubnetPortService, _ *model.VpcSubnetPort) error {
+		t.Fatal("DeleteSubnetPort should not be called for the SubnetPort with pinned addresses")
+		return nil
+	})
+	assert.NoError(t, r.deleteSubnetPortByPodName(context.TODO(), "ns", "pod-1"))
+}
+
 func TestPodReconciler_StartController(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().WithObjects().Build()
 	vpcService := &vpc.VPCService{
@@ -901,6 +1026,33 @@ func TestPodReconciler_RestoreReconcile(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestPodReconciler_RestoreDryRun(t *testing.T) {
+	r := &PodReconciler{
+		SubnetPortService: &subnetport.SubnetPortService{
+			SubnetPortStore: &subnetport.SubnetPortStore{},
+		},
+	}
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "getRestoreList", func(_ *PodReconciler) ([]types.NamespacedName, error) {
+		return []types.NamespacedName{{Namespace: "ns-1", Name: "pod-2"}}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "listStaleSubnetPortIDs", func(_ *PodReconciler, _ context.Context) (sets.Set[string], error) {
+		return sets.New[string]("port-2", "port-1"), nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService.SubnetPortStore), "GetByKey", func(_ *subnetport.SubnetPortStore, key string) *model.VpcSubnetPort {
+		return &model.VpcSubnetPort{Id: servicecommon.String(key), Path: servicecommon.String("/ports/" + key)}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeleteSubnetPortById", func(_ *subnetport.SubnetPortService, _ string) error {
+		t.Fatal("DeleteSubnetPortById should not be called in the dry run")
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/pod-2"}, plan.CRsToRestore)
+	assert.Equal(t, []string{"/ports/port-1", "/ports/port-2"}, plan.NSXResourcesToDelete)
+}
+
 type MockManager struct {
 	ctrl.Manager
 	client client.Client
@@ -10,6 +10,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -173,6 +174,12 @@ func (r *SecurityPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 		log.Info("Reconciling CR to create or update securitypolicy", "securitypolicy", req.NamespacedName)
 		if err := r.Service.CreateOrUpdateSecurityPolicy(realObj); err != nil {
+			if nsxutil.IsRealizationPendingError(err) {
+				// The SecurityPolicy CR is requeued by the realization watcher, or after the realization timeout if the
+				// realization event is dropped.
+				log.Info("SecurityPolicy is being realized", "securitypolicy", req.NamespacedName)
+				return common.ResultRequeueAfterRealization, nil
+			}
 			if errors.As(err, &nsxutil.RestrictionError{}) {
 				setSecurityPolicyErrorAnnotation(ctx, realObj, securitypolicy.IsVPCEnabled(r.Service), r.Client, common.ErrorNoDFWLicense)
 				r.StatusUpdater.UpdateFail(ctx, realObj, err, "", setSecurityPolicyReadyStatusFalse, r.Service)
@@ -246,7 +253,7 @@ func setSecurityPolicyReadyStatusFalse(client client.Client, ctx context.Context
 				"error occurred while processing the SecurityPolicy CR. Error: %v",
 				err,
 			),
-			Reason:             "SecurityPolicyNotReady",
+			Reason:             nsxutil.ErrorReason(err, "SecurityPolicyNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -314,7 +321,11 @@ func isConditionSemanticEqual(matchedCondition, newCondition *v1alpha1.Condition
 func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 	var blr *builder.Builder
 	if securitypolicy.IsVPCEnabled(r.Service) {
-		blr = ctrl.NewControllerManagedBy(mgr).For(&crdv1alpha1.SecurityPolicy{})
+		// Only the SecurityPolicies in VPC are checked with the realization watcher.
+		blr = ctrl.NewControllerManagedBy(mgr).For(&crdv1alpha1.SecurityPolicy{}).
+			WatchesRawSource(common.NewRealizationSource(servicecommon.ResourceTypeSecurityPolicy, func() client.Object {
+				return &crdv1alpha1.SecurityPolicy{}
+			}))
 	} else {
 		blr = ctrl.NewControllerManagedBy(mgr).For(&v1alpha1.SecurityPolicy{})
 	}
@@ -333,9 +344,36 @@ func (r *SecurityPolicyReconciler) setupWithManager(mgr ctrl.Manager) error {
 			&EnqueueRequestForPod{Client: k8sClient(mgr), SecurityPolicyReconciler: r},
 			builder.WithPredicates(PredicateFuncsPod),
 		).
+		WatchesRawSource(common.NewStoreDriftSourceForTypes([]string{servicecommon.ResourceTypeSecurityPolicy, servicecommon.ResourceTypeRule,
+			servicecommon.ResourceTypeGroup}, r.securityPolicyDriftMapFunc)).
 		Complete(r)
 }
 
+// securityPolicyDriftMapFunc maps the drifted NSX SecurityPolicy, Rule or Group to the SecurityPolicy CR it is created
+// for, the NSX resources created for the NetworkPolicies are skipped.
+func (r *SecurityPolicyReconciler) securityPolicyDriftMapFunc(obj interface{}) client.Object {
+	var tags []model.Tag
+	switch o := obj.(type) {
+	case *model.SecurityPolicy:
+		tags = o.Tags
+	case *model.Rule:
+		tags = o.Tags
+	case *model.Group:
+		tags = o.Tags
+	default:
+		return nil
+	}
+	name := nsxutil.FindTag(tags, servicecommon.TagValueScopeSecurityPolicyName)
+	if name == "" {
+		return nil
+	}
+	objectMeta := metav1.ObjectMeta{Namespace: nsxutil.FindTag(tags, servicecommon.TagScopeNamespace), Name: name}
+	if securitypolicy.IsVPCEnabled(r.Service) {
+		return &crdv1alpha1.SecurityPolicy{ObjectMeta: objectMeta}
+	}
+	return &v1alpha1.SecurityPolicy{ObjectMeta: objectMeta}
+}
+
 // Start setup manager and launch GC
 func (r *SecurityPolicyReconciler) Start(mgr ctrl.Manager) error {
 	err := r.setupWithManager(mgr)
@@ -345,22 +383,31 @@ func (r *SecurityPolicyReconciler) Start(mgr ctrl.Manager) error {
 	return nil
 }
 
-// CollectGarbage collect securitypolicy which has been removed from k8s,
-// it implements the interface GarbageCollector method.
-func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("SecurityPolicy garbage collector started")
+// listStaleSecurityPolicyIDs returns the UIDs of the SecurityPolicy CRs which have been removed from k8s but still have
+// NSX resources.
+func (r *SecurityPolicyReconciler) listStaleSecurityPolicyIDs() (sets.Set[string], error) {
 	nsxPolicySet := r.Service.ListSecurityPolicyID()
 	if len(nsxPolicySet) == 0 {
-		return nil
+		return nsxPolicySet, nil
 	}
 
 	CRPolicySet, err := r.listSecurityPolicyCRIDs()
+	if err != nil {
+		return nil, err
+	}
+	return nsxPolicySet.Difference(CRPolicySet), nil
+}
+
+// CollectGarbage collect securitypolicy which has been removed from k8s,
+// it implements the interface GarbageCollector method.
+func (r *SecurityPolicyReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("SecurityPolicy garbage collector started")
+	diffSet, err := r.listStaleSecurityPolicyIDs()
 	if err != nil {
 		return err
 	}
 
 	var errList []error
-	diffSet := nsxPolicySet.Difference(CRPolicySet)
 	for elem := range diffSet {
 		log.Debug("GC collected SecurityPolicy CR", "securityPolicyUID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
@@ -482,6 +529,20 @@ func (r *SecurityPolicyReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. No SecurityPolicy CR is restored, only the NSX resources of
+// the deleted CRs are reported.
+func (r *SecurityPolicyReconciler) RestoreDryRun(_ context.Context) (*util.RestorePlan, error) {
+	diffSet, err := r.listStaleSecurityPolicyIDs()
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, uid := range sets.List(diffSet) {
+		plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, r.Service.ListSecurityPolicyResourcePaths(servicecommon.TagValueScopeSecurityPolicyUID, uid)...)
+	}
+	return plan, nil
+}
+
 func (r *SecurityPolicyReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "Failed to create controller", "controller", "SecurityPolicy")
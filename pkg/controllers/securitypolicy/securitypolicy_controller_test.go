@@ -526,6 +526,48 @@ func TestSecurityPolicyReconciler_GarbageCollector(t *testing.T) {
 	patch.Reset()
 }
 
+func TestSecurityPolicyReconciler_RestoreDryRun(t *testing.T) {
+	service := &securitypolicy.SecurityPolicyService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				NsxConfig: &config.NsxConfig{},
+				CoeConfig: &config.CoeConfig{
+					EnableVPCNetwork: false,
+				},
+			},
+		},
+	}
+	mockCtl := gomock.NewController(t)
+	defer mockCtl.Finish()
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	r := &SecurityPolicyReconciler{
+		Client:  k8sClient,
+		Service: service,
+	}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(service), "ListSecurityPolicyID", func(_ *securitypolicy.SecurityPolicyService) sets.Set[string] {
+		return sets.New[string]("1234", "2345")
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(service), "ListSecurityPolicyResourcePaths", func(_ *securitypolicy.SecurityPolicyService, _ string, uid string) []string {
+		return []string{"/security-policies/" + uid, "/groups/" + uid}
+	})
+	patches.ApplyMethod(reflect.TypeOf(service), "DeleteSecurityPolicy", func(_ *securitypolicy.SecurityPolicyService, _ types.UID, _ bool, _ string) error {
+		assert.FailNow(t, "DeleteSecurityPolicy should not be called in the dry run")
+		return nil
+	})
+	k8sClient.EXPECT().List(gomock.Any(), &v1alpha1.SecurityPolicyList{}).Return(nil).Do(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+		a := list.(*v1alpha1.SecurityPolicyList)
+		a.Items = append(a.Items, v1alpha1.SecurityPolicy{ObjectMeta: metav1.ObjectMeta{UID: "1234"}})
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, plan.CRsToRestore)
+	assert.Equal(t, []string{"/security-policies/2345", "/groups/2345"}, plan.NSXResourcesToDelete)
+}
+
 func TestReconcileSecurityPolicy(t *testing.T) {
 	rule := v1alpha1.SecurityPolicyRule{
 		Name: "rule-with-pod-selector",
@@ -5,13 +5,18 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/version"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -32,6 +37,9 @@ import (
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/dns"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/servicelb"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -48,6 +56,9 @@ type ServiceLbReconciler struct {
 	Service  *servicecommon.Service
 	DNS      dns.DNSRecordProvider
 	Recorder record.EventRecorder
+	// LBService realizes the LoadBalancer Services with the NSX VPC load balancer, it is nil if the
+	// realization is not enabled.
+	LBService *servicelb.ServiceLBService
 }
 
 func updateSuccess(r *ServiceLbReconciler, c context.Context, lbService *v1.Service) error {
@@ -80,6 +91,9 @@ func (r *ServiceLbReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	if err := r.Client.Get(ctx, req.NamespacedName, service); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("Not found LB service", "req", req.NamespacedName)
+			if err := r.deleteLoadBalancer(req.NamespacedName, ""); err != nil {
+				return common.ResultRequeueAfter10sec, nil
+			}
 			if err := r.deleteDNSForService(ctx, req.Namespace, req.Name, "deleted Service"); err != nil {
 				return common.ResultRequeueAfter10sec, nil
 			}
@@ -90,6 +104,9 @@ func (r *ServiceLbReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	if service.Spec.Type != v1.ServiceTypeLoadBalancer || !service.ObjectMeta.DeletionTimestamp.IsZero() {
+		if err := r.deleteLoadBalancer(req.NamespacedName, service.UID); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
 		// Try to delete DNS records for Service when it is not a LoadBalancer or is marked for deletion
 		if err := r.clearDNSAndConditionForService(ctx, req.NamespacedName, "non-LB or terminating Service"); err != nil {
 			return common.ResultRequeueAfter10sec, nil
@@ -101,6 +118,27 @@ func (r *ServiceLbReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	log.Debug("Reconciling LB Service", "name", service.Name, "version", service.ResourceVersion, "status", service.Status)
 	metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerSyncTotal, MetricResType)
 
+	if r.LBService != nil {
+		if isServiceLBRealizedByNSX(service) {
+			if err := r.realizeLoadBalancer(ctx, service); err != nil {
+				var validationErr *nsxutil.ValidationError
+				if errors.As(err, &validationErr) {
+					// The invalid settings can only be fixed by updating the Service, so it is not requeued.
+					log.Error(err, "Invalid NSX load balancer settings for Service", "Name", service.Name, "Namespace", service.Namespace)
+					r.Recorder.Event(service, v1.EventTypeWarning, common.ReasonFailUpdate, fmt.Sprintf("Invalid NSX load balancer settings: %v", err))
+					metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerUpdateFailTotal, MetricResType)
+					return ResultNormal, nil
+				}
+				log.Error(err, "Failed to realize NSX load balancer for Service", "Name", service.Name, "Namespace", service.Namespace)
+				r.Recorder.Event(service, v1.EventTypeWarning, common.ReasonFailUpdate, fmt.Sprintf("Failed to realize NSX load balancer: %v", err))
+				metrics.CounterInc(r.Service.NSXConfig, metrics.ControllerUpdateFailTotal, MetricResType)
+				return common.ResultRequeueAfter10sec, nil
+			}
+		} else if err := r.deleteLoadBalancer(req.NamespacedName, service.UID); err != nil {
+			return common.ResultRequeueAfter10sec, nil
+		}
+	}
+
 	var dnsErr error
 	if err := r.reconcileLoadBalancerServiceDNS(ctx, service); err != nil {
 		log.Error(err, "Failed to reconcile DNS for LoadBalancer Service", "Name", service.Name, "Namespace", service.Namespace)
@@ -162,6 +200,30 @@ func (r *ServiceLbReconciler) setServiceLbStatus(ctx context.Context, lbService
 	})
 }
 
+// serviceLBDriftMapFunc maps the drifted NSX LB virtual server, LB pool, VpcIpAddressAllocation or Group to the Service
+// it is created for.
+func serviceLBDriftMapFunc(obj interface{}) client.Object {
+	var tags []model.Tag
+	switch o := obj.(type) {
+	case *model.LBVirtualServer:
+		tags = o.Tags
+	case *model.LBPool:
+		tags = o.Tags
+	case *model.VpcIpAddressAllocation:
+		tags = o.Tags
+	case *model.Group:
+		tags = o.Tags
+	default:
+		return nil
+	}
+	name := nsxutil.FindTag(tags, servicecommon.TagScopeServiceName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(tags, servicecommon.TagScopeNamespace)
+	return &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
 	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Service{}).
@@ -170,10 +232,16 @@ func (r *ServiceLbReconciler) setupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.enqueueLBServiceRequestsFromNetworkInfo),
 			builder.WithPredicates(predicateNetworkInfoAllowedDNSDomainsChanged()),
 		).
+		WatchesRawSource(common.NewStoreDriftSourceForTypes([]string{servicecommon.ResourceTypeLBVirtualServer, servicecommon.ResourceTypeLBPool,
+			servicecommon.ResourceTypeIPAddressAllocation, servicecommon.ResourceTypeGroup}, serviceLBDriftMapFunc)).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
 			})
+	if r.LBService != nil {
+		// The LB pool members are refreshed with the EndpointSlices of the Service.
+		b = b.Watches(&discoveryv1.EndpointSlice{}, handler.EnqueueRequestsFromMapFunc(r.enqueueServiceFromEndpointSlice))
+	}
 	return b.Complete(r)
 }
 
@@ -216,6 +284,35 @@ func (r *ServiceLbReconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. No Service is restored, only the NSX load balancer
+// resources and the DNS records of the stale Services are reported.
+func (r *ServiceLbReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	plan := &util.RestorePlan{}
+	if r.LBService != nil {
+		staleUIDs, err := r.listStaleLBServiceUIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range sets.List(staleUIDs) {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, r.LBService.ListLoadBalancerResourcePaths(types.UID(uid))...)
+		}
+	}
+	if r.DNS != nil {
+		staleServices, err := r.listStaleDNSServices(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, nn := range staleServices.UnsortedList() {
+			paths, err := r.DNS.ListRecordPathsToDeleteByOwnerNN(dns.ResourceKindService, nn.Namespace, nn.Name)
+			if err != nil {
+				return nil, err
+			}
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, paths...)
+		}
+	}
+	return plan, nil
+}
+
 func (r *ServiceLbReconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "Failed to create controller", "controller", "ServiceLb")
@@ -238,20 +335,25 @@ func (r *ServiceLbReconciler) StartController(mgr ctrl.Manager, _ webhook.Server
 }
 
 func (r *ServiceLbReconciler) CollectGarbage(ctx context.Context) error {
-	return r.collectDNSGarbage(ctx)
+	lbErr := r.collectLBGarbage(ctx)
+	if err := r.collectDNSGarbage(ctx); err != nil {
+		return err
+	}
+	return lbErr
 }
 
-func NewServiceLbReconciler(mgr ctrl.Manager, commonService servicecommon.Service, dnsRecordService *dns.DNSRecordService) *ServiceLbReconciler {
+func NewServiceLbReconciler(mgr ctrl.Manager, commonService servicecommon.Service, dnsRecordService *dns.DNSRecordService, lbService *servicelb.ServiceLBService) *ServiceLbReconciler {
 	if isServiceLbStatusIpModeSupported(mgr.GetConfig()) {
 		var dnsProv dns.DNSRecordProvider
 		if dnsRecordService != nil {
 			dnsProv = dnsRecordService
 		}
 		serviceLbReconciler := &ServiceLbReconciler{
-			Client:   mgr.GetClient(),
-			Scheme:   mgr.GetScheme(),
-			DNS:      dnsProv,
-			Recorder: mgr.GetEventRecorderFor("serviceLb-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+			Client:    mgr.GetClient(),
+			Scheme:    mgr.GetScheme(),
+			DNS:       dnsProv,
+			Recorder:  mgr.GetEventRecorderFor("serviceLb-controller"), //nolint:staticcheck // record.EventRecorder; StatusUpdater not on events.EventRecorder yet
+			LBService: lbService,
 		}
 		serviceLbReconciler.Service = &commonService
 		return serviceLbReconciler
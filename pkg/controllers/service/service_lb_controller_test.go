@@ -12,6 +12,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -612,7 +613,7 @@ func TestNewServiceLbReconciler_whenIpModeSupported(t *testing.T) {
 	patches := gomonkey.ApplyFunc(isServiceLbStatusIpModeSupported, func(c *rest.Config) bool { return true })
 	defer patches.Reset()
 
-	r := NewServiceLbReconciler(mockMgr, commonService, nil)
+	r := NewServiceLbReconciler(mockMgr, commonService, nil, nil)
 	require.NotNil(t, r)
 }
 
@@ -706,3 +707,20 @@ func TestEnqueueLBServiceRequestsFromNetworkInfo_skipAnnotation(t *testing.T) {
 func (c *failingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
 	return fmt.Errorf("list error")
 }
+
+func TestServiceLBDriftMapFunc(t *testing.T) {
+	tags := []model.Tag{
+		{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")},
+		{Scope: common.String(common.TagScopeServiceName), Tag: common.String("svc-1")},
+	}
+	for _, obj := range []interface{}{&model.LBVirtualServer{Tags: tags}, &model.LBPool{Tags: tags}, &model.VpcIpAddressAllocation{Tags: tags}, &model.Group{Tags: tags}} {
+		owner := serviceLBDriftMapFunc(obj)
+		assert.IsType(t, &v1.Service{}, owner)
+		assert.Equal(t, types.NamespacedName{Namespace: "ns-1", Name: "svc-1"}, client.ObjectKeyFromObject(owner))
+	}
+	// The NSX Group created for a SecurityPolicy is not mapped.
+	assert.Nil(t, serviceLBDriftMapFunc(&model.Group{Tags: []model.Tag{
+		{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")},
+		{Scope: common.String(common.TagScopeSecurityPolicyCRName), Tag: common.String("sp-1")},
+	}}))
+}
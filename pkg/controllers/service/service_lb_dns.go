@@ -268,22 +268,12 @@ func (r *ServiceLbReconciler) collectDNSGarbage(ctx context.Context) error {
 	if r.DNS == nil {
 		return nil
 	}
-	apiSet := sets.New[types.NamespacedName]()
-	svcs, err := getLoadBalancerServicesWithDNS(ctx, r.Client)
+	staleServices, err := r.listStaleDNSServices(ctx)
 	if err != nil {
-		log.Error(err, "Service LB GC: failed to list Services")
 		return err
 	}
-	for _, svc := range svcs {
-		apiSet.Insert(types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name})
-	}
-	ownersByKind := r.DNS.ListRecordOwnerResource()
-	cachedServices := ownersByKind[dns.ResourceKindService]
 	var errs []error
-	for nn := range cachedServices {
-		if apiSet.Has(nn) {
-			continue
-		}
+	for nn := range staleServices {
 		if err := r.clearDNSAndConditionForService(ctx, nn, "GC: missing or ineligible Service owner"); err != nil {
 			errs = append(errs, err)
 		}
@@ -294,6 +284,21 @@ func (r *ServiceLbReconciler) collectDNSGarbage(ctx context.Context) error {
 	return nil
 }
 
+// listStaleDNSServices returns the Services which own DNS records but are missing or no longer eligible for DNS.
+func (r *ServiceLbReconciler) listStaleDNSServices(ctx context.Context) (sets.Set[types.NamespacedName], error) {
+	apiSet := sets.New[types.NamespacedName]()
+	svcs, err := getLoadBalancerServicesWithDNS(ctx, r.Client)
+	if err != nil {
+		log.Error(err, "Service LB GC: failed to list Services")
+		return nil, err
+	}
+	for _, svc := range svcs {
+		apiSet.Insert(types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name})
+	}
+	ownersByKind := r.DNS.ListRecordOwnerResource()
+	return ownersByKind[dns.ResourceKindService].Difference(apiSet), nil
+}
+
 func (r *ServiceLbReconciler) clearDNSAndConditionForService(ctx context.Context, reqNN types.NamespacedName, op string) error {
 	if delErr := r.deleteDNSForService(ctx, reqNN.Namespace, reqNN.Name, op); delErr != nil {
 		return delErr
@@ -0,0 +1,140 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// isServiceLBRealizedByNSX returns true if the LoadBalancer Service should be realized with the NSX VPC load balancer.
+// The Services with a loadBalancerClass are implemented by other load balancer controllers.
+func isServiceLBRealizedByNSX(svc *v1.Service) bool {
+	return svc.Spec.Type == v1.ServiceTypeLoadBalancer && svc.Spec.LoadBalancerClass == nil
+}
+
+// realizeLoadBalancer creates or updates the NSX VPC load balancer resources of the Service, and populates the VIPs
+// into the Service status.loadBalancer.
+func (r *ServiceLbReconciler) realizeLoadBalancer(ctx context.Context, svc *v1.Service) error {
+	endpointSlices := &discoveryv1.EndpointSliceList{}
+	if err := r.Client.List(ctx, endpointSlices, client.InNamespace(svc.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: svc.Name}); err != nil {
+		return fmt.Errorf("listing EndpointSlices: %w", err)
+	}
+	vips, err := r.LBService.CreateOrUpdateLoadBalancer(svc, endpointSlices.Items)
+	if err != nil {
+		return err
+	}
+	return r.setServiceLbIngress(ctx, svc, vips)
+}
+
+// setServiceLbIngress sets the Service status.loadBalancer.ingress to the given VIPs. The ipMode of the ingress is
+// kept and updated by setServiceLbStatus later.
+func (r *ServiceLbReconciler) setServiceLbIngress(ctx context.Context, lbService *v1.Service, vips []string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		svc := &v1.Service{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: lbService.Name, Namespace: lbService.Namespace}, svc); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		currentIPs := make([]string, 0, len(svc.Status.LoadBalancer.Ingress))
+		for _, ing := range svc.Status.LoadBalancer.Ingress {
+			currentIPs = append(currentIPs, ing.IP)
+		}
+		if slices.Equal(currentIPs, vips) {
+			lbService.Status.LoadBalancer = svc.Status.LoadBalancer
+			return nil
+		}
+		ingress := make([]v1.LoadBalancerIngress, 0, len(vips))
+		for _, vip := range vips {
+			ingress = append(ingress, v1.LoadBalancerIngress{IP: vip})
+		}
+		svc.Status.LoadBalancer.Ingress = ingress
+		if err := r.Client.Status().Update(ctx, svc); err != nil {
+			log.Error(err, "Failed to update LB service status ingress", "Name", svc.Name, "Namespace", svc.Namespace, "VIPs", vips)
+			return err
+		}
+		// The DNS records are published with the ingress of the Service.
+		lbService.Status.LoadBalancer = svc.Status.LoadBalancer
+		log.Info("Updated LB service status ingress", "Name", svc.Name, "Namespace", svc.Namespace, "VIPs", vips)
+		return nil
+	})
+}
+
+// deleteLoadBalancer deletes the NSX VPC load balancer resources of the Service. The resources are searched by the
+// Service NamespacedName if uid is empty.
+func (r *ServiceLbReconciler) deleteLoadBalancer(nn types.NamespacedName, uid types.UID) error {
+	if r.LBService == nil {
+		return nil
+	}
+	var err error
+	if uid == "" {
+		err = r.LBService.DeleteLoadBalancerByNamespacedName(nn)
+	} else {
+		err = r.LBService.DeleteLoadBalancer(uid)
+	}
+	if err != nil {
+		log.Error(err, "Failed to delete NSX load balancer for Service", "Service", nn)
+		return fmt.Errorf("deleting NSX load balancer: %w", err)
+	}
+	return nil
+}
+
+// enqueueServiceFromEndpointSlice enqueues the Service owning the EndpointSlice to refresh the LB pool members.
+func (r *ServiceLbReconciler) enqueueServiceFromEndpointSlice(_ context.Context, obj client.Object) []reconcile.Request {
+	serviceName, ok := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if !ok || serviceName == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: serviceName}}}
+}
+
+// collectLBGarbage deletes the NSX VPC load balancer resources whose Services don't exist or are not realized
+// with the NSX VPC load balancer any more.
+func (r *ServiceLbReconciler) collectLBGarbage(ctx context.Context) error {
+	if r.LBService == nil {
+		return nil
+	}
+	staleUIDs, err := r.listStaleLBServiceUIDs(ctx)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for uid := range staleUIDs {
+		log.Info("Service LB GC: deleting stale NSX load balancer", "ServiceUID", uid)
+		if err := r.LBService.DeleteLoadBalancer(types.UID(uid)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("service LB garbage collection encountered %d error(s): %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
+
+// listStaleLBServiceUIDs returns the UIDs of the Services which have NSX load balancer resources created but are no
+// longer realized with the NSX VPC load balancer.
+func (r *ServiceLbReconciler) listStaleLBServiceUIDs(ctx context.Context) (sets.Set[string], error) {
+	svcList := &v1.ServiceList{}
+	if err := r.Client.List(ctx, svcList); err != nil {
+		log.Error(err, "Service LB GC: failed to list Services")
+		return nil, err
+	}
+	uidSet := sets.New[string]()
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		if isServiceLBRealizedByNSX(svc) && svc.DeletionTimestamp.IsZero() {
+			uidSet.Insert(string(svc.UID))
+		}
+	}
+	return r.LBService.ListServiceUIDs().Difference(uidSet), nil
+}
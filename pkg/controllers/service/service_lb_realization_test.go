@@ -0,0 +1,233 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ctrlcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/servicelb"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func serviceLbRealizationTestScheme(t *testing.T) *runtime.Scheme {
+	s := serviceLbTestScheme(t)
+	require.NoError(t, discoveryv1.AddToScheme(s))
+	return s
+}
+
+func newServiceLbRealizationReconciler(t *testing.T, objs ...client.Object) *ServiceLbReconciler {
+	scheme := serviceLbRealizationTestScheme(t)
+	return &ServiceLbReconciler{
+		Client:    serviceLbFakeClient(scheme, true, objs...),
+		Scheme:    scheme,
+		Service:   testNSXServiceForLb(),
+		DNS:       emptyDNSRecordService(),
+		Recorder:  fakeRecorder{},
+		LBService: &servicelb.ServiceLBService{},
+	}
+}
+
+func TestIsServiceLBRealizedByNSX(t *testing.T) {
+	lbClass := "example.com/lb"
+	assert.True(t, isServiceLBRealizedByNSX(&v1.Service{Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer}}))
+	assert.False(t, isServiceLBRealizedByNSX(&v1.Service{Spec: v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, LoadBalancerClass: &lbClass}}))
+	assert.False(t, isServiceLBRealizedByNSX(&v1.Service{Spec: v1.ServiceSpec{Type: v1.ServiceTypeClusterIP}}))
+}
+
+func TestServiceLbReconciler_Reconcile_LBRealization(t *testing.T) {
+	ctx := context.Background()
+	lbService := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", UID: "lb-uid", ResourceVersion: "1"},
+		Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, Ports: []v1.ServicePort{{Name: "http", Port: 80}}},
+	}
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta:  metav1.ObjectMeta{Namespace: "ns", Name: "lb-abcde", Labels: map[string]string{discoveryv1.LabelServiceName: "lb"}},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+
+	t.Run("realize_and_set_ingress", func(t *testing.T) {
+		r := newServiceLbRealizationReconciler(t, lbService.DeepCopy(), endpointSlice.DeepCopy())
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "CreateOrUpdateLoadBalancer", func(_ *servicelb.ServiceLBService, svc *v1.Service, endpointSlices []discoveryv1.EndpointSlice) ([]string, error) {
+			assert.Len(t, endpointSlices, 1)
+			return []string{"192.168.0.10"}, nil
+		})
+		defer patches.Reset()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ResultNormal, res)
+		svc := &v1.Service{}
+		require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "lb"}, svc))
+		require.Len(t, svc.Status.LoadBalancer.Ingress, 1)
+		assert.Equal(t, "192.168.0.10", svc.Status.LoadBalancer.Ingress[0].IP)
+		require.NotNil(t, svc.Status.LoadBalancer.Ingress[0].IPMode)
+		assert.Equal(t, v1.LoadBalancerIPModeProxy, *svc.Status.LoadBalancer.Ingress[0].IPMode)
+	})
+
+	t.Run("realize_failure", func(t *testing.T) {
+		r := newServiceLbRealizationReconciler(t, lbService.DeepCopy())
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "CreateOrUpdateLoadBalancer", func(_ *servicelb.ServiceLBService, svc *v1.Service, endpointSlices []discoveryv1.EndpointSlice) ([]string, error) {
+			return nil, errors.New("mock err")
+		})
+		defer patches.Reset()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ctrlcommon.ResultRequeueAfter10sec, res)
+	})
+
+	t.Run("realize_validation_failure", func(t *testing.T) {
+		r := newServiceLbRealizationReconciler(t, lbService.DeepCopy())
+		recorder := record.NewFakeRecorder(1)
+		r.Recorder = recorder
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "CreateOrUpdateLoadBalancer", func(_ *servicelb.ServiceLBService, svc *v1.Service, endpointSlices []discoveryv1.EndpointSlice) ([]string, error) {
+			return nil, &nsxutil.ValidationError{Desc: "invalid load balancer IP \"1.1.1\""}
+		})
+		defer patches.Reset()
+
+		// The Service is not requeued since it can only be fixed by the user.
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ResultNormal, res)
+		require.Len(t, recorder.Events, 1)
+		assert.Equal(t, "Warning FailUpdate Invalid NSX load balancer settings: invalid load balancer IP \"1.1.1\"", <-recorder.Events)
+	})
+
+	t.Run("lb_class_deletes_lb", func(t *testing.T) {
+		lbClass := "example.com/lb"
+		svc := lbService.DeepCopy()
+		svc.Spec.LoadBalancerClass = &lbClass
+		r := newServiceLbRealizationReconciler(t, svc)
+		deleted := false
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "DeleteLoadBalancer", func(_ *servicelb.ServiceLBService, uid types.UID) error {
+			assert.Equal(t, types.UID("lb-uid"), uid)
+			deleted = true
+			return nil
+		})
+		defer patches.Reset()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ResultNormal, res)
+		assert.True(t, deleted)
+	})
+
+	t.Run("non_lb_deletes_lb_error", func(t *testing.T) {
+		svc := lbService.DeepCopy()
+		svc.Spec.Type = v1.ServiceTypeClusterIP
+		r := newServiceLbRealizationReconciler(t, svc)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "DeleteLoadBalancer", func(_ *servicelb.ServiceLBService, uid types.UID) error {
+			return errors.New("mock err")
+		})
+		defer patches.Reset()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ctrlcommon.ResultRequeueAfter10sec, res)
+	})
+
+	t.Run("not_found_deletes_lb", func(t *testing.T) {
+		r := newServiceLbRealizationReconciler(t)
+		deleted := false
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "DeleteLoadBalancerByNamespacedName", func(_ *servicelb.ServiceLBService, nn types.NamespacedName) error {
+			assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: "lb"}, nn)
+			deleted = true
+			return nil
+		})
+		defer patches.Reset()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}})
+		require.NoError(t, err)
+		assert.Equal(t, ResultNormal, res)
+		assert.True(t, deleted)
+	})
+}
+
+func TestServiceLbReconciler_setServiceLbIngress(t *testing.T) {
+	ctx := context.Background()
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", ResourceVersion: "1"},
+		Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+		Status: v1.ServiceStatus{
+			LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "192.168.0.9"}}},
+		},
+	}
+	r := newServiceLbRealizationReconciler(t, svc.DeepCopy())
+
+	require.NoError(t, r.setServiceLbIngress(ctx, svc, []string{"192.168.0.10"}))
+	assert.Equal(t, "192.168.0.10", svc.Status.LoadBalancer.Ingress[0].IP)
+	got := &v1.Service{}
+	require.NoError(t, r.Client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "lb"}, got))
+	assert.Equal(t, []v1.LoadBalancerIngress{{IP: "192.168.0.10"}}, got.Status.LoadBalancer.Ingress)
+
+	// The ingress is not updated if the VIPs are not changed.
+	require.NoError(t, r.setServiceLbIngress(ctx, svc, []string{"192.168.0.10"}))
+	// The Service is deleted.
+	require.NoError(t, r.setServiceLbIngress(ctx, &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "missing"}}, []string{"192.168.0.10"}))
+}
+
+func TestServiceLbReconciler_enqueueServiceFromEndpointSlice(t *testing.T) {
+	r := newServiceLbRealizationReconciler(t)
+	reqs := r.enqueueServiceFromEndpointSlice(context.Background(), &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb-abcde", Labels: map[string]string{discoveryv1.LabelServiceName: "lb"}},
+	})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "lb"}}}, reqs)
+
+	reqs = r.enqueueServiceFromEndpointSlice(context.Background(), &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "custom"},
+	})
+	assert.Empty(t, reqs)
+}
+
+func TestServiceLbReconciler_collectLBGarbage(t *testing.T) {
+	ctx := context.Background()
+	objs := []client.Object{
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", UID: "lb-uid"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cluster-ip", UID: "cluster-ip-uid"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP},
+		},
+	}
+	r := newServiceLbRealizationReconciler(t, objs...)
+	var deleted []types.UID
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.LBService), "ListServiceUIDs", func(_ *servicelb.ServiceLBService) sets.Set[string] {
+		return sets.New[string]("lb-uid", "cluster-ip-uid", "stale-uid")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.LBService), "DeleteLoadBalancer", func(_ *servicelb.ServiceLBService, uid types.UID) error {
+		deleted = append(deleted, uid)
+		if uid == "stale-uid" {
+			return errors.New("mock err")
+		}
+		return nil
+	})
+	defer patches.Reset()
+
+	err := r.collectLBGarbage(ctx)
+	assert.ErrorContains(t, err, "mock err")
+	assert.ElementsMatch(t, []types.UID{"cluster-ip-uid", "stale-uid"}, deleted)
+
+	r.LBService = nil
+	assert.NoError(t, r.collectLBGarbage(ctx))
+}
@@ -31,6 +31,7 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	subnetportservice "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	pkgutil "github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -280,14 +281,36 @@ func (r *StatefulSetReconciler) CollectGarbage(ctx context.Context) error {
 		return nil
 	}
 	log.Info("StatefulSet garbage collector started")
+	stalePorts, err := r.listStaleSubnetPorts(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errList []error
+	for _, port := range stalePorts {
+		stsID := util.FindTag(port.Tags, servicecommon.TagScopeStatefulSetUID)
+		if err := r.SubnetPortService.DeleteSubnetPort(port); err != nil {
+			log.Error(err, "GC: failed to delete stale subnet port", "port", *port.Id, "stsUID", stsID)
+			errList = append(errList, err)
+		}
+	}
+	if len(errList) > 0 {
+		return fmt.Errorf("StatefulSet GC: %d delete error(s): %v", len(errList), errList)
+	}
+	return nil
+}
+
+// listStaleSubnetPorts returns the StatefulSet subnet ports out of the ordinal range of the StatefulSet, and the ones
+// of the deleted StatefulSets. The ports whose Pods still exist are skipped.
+func (r *StatefulSetReconciler) listStaleSubnetPorts(ctx context.Context) ([]*model.VpcSubnetPort, error) {
 	statefulSetList := &appsv1.StatefulSetList{}
 	err := r.Client.List(ctx, statefulSetList)
 	if err != nil {
 		log.Error(err, "Failed to list StatefulSet")
-		return err
+		return nil, err
 	}
 
-	var errList []error
+	var stalePorts []*model.VpcSubnetPort
 	statefulSetUIDs := sets.New[string]()
 	for _, sts := range statefulSetList.Items {
 		existingPorts := r.SubnetPortService.SubnetPortStore.GetByIndex(servicecommon.TagScopeStatefulSetUID, string(sts.UID))
@@ -312,10 +335,7 @@ func (r *StatefulSetReconciler) CollectGarbage(ctx context.Context) error {
 					}
 				}
 				log.Info("StatefulSet garbage collector: found out-of-range port", "index", idx, "stsUID", sts.UID, "start", start, "end", end, "namespace", sts.Namespace)
-				if err := r.SubnetPortService.DeleteSubnetPort(port); err != nil {
-					log.Error(err, "GC: failed to delete out-of-range subnet port", "port", *port.Id, "stsUID", sts.UID, "namespace", sts.Namespace)
-					errList = append(errList, err)
-				}
+				stalePorts = append(stalePorts, port)
 			}
 		}
 		statefulSetUIDs.Insert(string(sts.UID))
@@ -337,16 +357,29 @@ func (r *StatefulSetReconciler) CollectGarbage(ctx context.Context) error {
 				}
 			}
 			log.Debug("Found orphaned subnet port for deleted StatefulSet", "port", *port.Id, "stsUID", stsID)
-			if err := r.SubnetPortService.DeleteSubnetPort(port); err != nil {
-				log.Error(err, "GC: failed to delete orphaned subnet port", "port", *port.Id, "stsUID", stsID)
-				errList = append(errList, err)
-			}
+			stalePorts = append(stalePorts, port)
 		}
 	}
-	if len(errList) > 0 {
-		return fmt.Errorf("StatefulSet GC: %d delete error(s): %v", len(errList), errList)
+	return stalePorts, nil
+}
+
+// RestoreDryRun implements the interface RestoreDryRunner. No StatefulSet is restored, only the stale subnet ports
+// are reported.
+func (r *StatefulSetReconciler) RestoreDryRun(ctx context.Context) (*pkgutil.RestorePlan, error) {
+	plan := &pkgutil.RestorePlan{}
+	if !r.StatefulSetPodFeatureEnabled() {
+		return plan, nil
 	}
-	return nil
+	stalePorts, err := r.listStaleSubnetPorts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, port := range stalePorts {
+		if port.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *port.Path)
+		}
+	}
+	return plan, nil
 }
 
 func isPodBelongToStatefulSet(pod *corev1.Pod, stsID types.UID) bool {
@@ -9,6 +9,7 @@ import (
 	"os"
 	"reflect"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,11 +34,12 @@ import (
 )
 
 var (
-	log                      = logger.Log
-	ResultNormal             = common.ResultNormal
-	ResultRequeue            = common.ResultRequeue
-	ResultRequeueAfter5mins  = common.ResultRequeueAfter5mins
-	MetricResTypeStaticRoute = common.MetricResTypeStaticRoute
+	log                           = logger.Log
+	ResultNormal                  = common.ResultNormal
+	ResultRequeue                 = common.ResultRequeue
+	ResultRequeueAfter5mins       = common.ResultRequeueAfter5mins
+	ResultRequeueAfterRealization = common.ResultRequeueAfterRealization
+	MetricResTypeStaticRoute      = common.MetricResTypeStaticRoute
 )
 
 // StaticRouteReconciler StaticRouteReconcile reconciles a StaticRoute object
@@ -83,6 +85,12 @@ func (r *StaticRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if obj.ObjectMeta.DeletionTimestamp.IsZero() {
 		r.StatusUpdater.IncreaseUpdateTotal()
 		if err := r.Service.CreateOrUpdateStaticRoute(ctx, req.Namespace, obj); err != nil {
+			if util.IsRealizationPendingError(err) {
+				// The StaticRoute CR is requeued by the realization watcher, or after the realization timeout if the
+				// realization event is dropped.
+				setStaticRouteRealizingStatus(r.Client, ctx, obj, metav1.Now())
+				return ResultRequeueAfterRealization, nil
+			}
 			r.StatusUpdater.UpdateFail(ctx, obj, err, "", setStaticRouteReadyStatusFalse)
 			// TODO: if error is not retriable, not requeue
 			apierror, errortype := util.DumpAPIError(err)
@@ -117,6 +125,19 @@ func setStaticRouteReadyStatusTrue(client client.Client, ctx context.Context, ob
 	updateStaticRouteStatusConditions(client, ctx, staticRoute, newConditions)
 }
 
+func setStaticRouteRealizingStatus(client client.Client, ctx context.Context, staticRoute *v1alpha1.StaticRoute, transitionTime metav1.Time) {
+	newConditions := []v1alpha1.StaticRouteCondition{
+		{
+			Type:               v1alpha1.Ready,
+			Status:             v1.ConditionFalse,
+			Message:            "NSX Static Route is being realized",
+			Reason:             "StaticRouteRealizing",
+			LastTransitionTime: transitionTime,
+		},
+	}
+	updateStaticRouteStatusConditions(client, ctx, staticRoute, newConditions)
+}
+
 func setStaticRouteReadyStatusFalse(client client.Client, ctx context.Context, obj client.Object, transitionTime metav1.Time, err error, _ ...interface{}) {
 	staticRoute := obj.(*v1alpha1.StaticRoute)
 	newConditions := []v1alpha1.StaticRouteCondition{
@@ -124,7 +145,7 @@ func setStaticRouteReadyStatusFalse(client client.Client, ctx context.Context, o
 			Type:               v1alpha1.Ready,
 			Status:             v1.ConditionFalse,
 			Message:            fmt.Sprintf("Error occurred while processing the Static Route CR. Please check the config and try again. Error: %v", err),
-			Reason:             "StaticRouteNotReady",
+			Reason:             util.ErrorReason(err, "StaticRouteNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -180,6 +201,10 @@ func isConditionSemanticEqual(matchedCondition, newCondition *v1alpha1.StaticRou
 func (r *StaticRouteReconciler) setupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.StaticRoute{}).
+		WatchesRawSource(common.NewStoreDriftSource(commonservice.ResourceTypeStaticRoutes, staticRouteDriftMapFunc)).
+		WatchesRawSource(common.NewRealizationSource(commonservice.ResourceTypeStaticRoutes, func() client.Object {
+			return &v1alpha1.StaticRoute{}
+		})).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: common.NumReconcile(),
@@ -187,6 +212,20 @@ func (r *StaticRouteReconciler) setupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
+// staticRouteDriftMapFunc maps the drifted NSX StaticRoutes to the StaticRoute CR it is created for.
+func staticRouteDriftMapFunc(obj interface{}) client.Object {
+	staticRoute, ok := obj.(*model.StaticRoutes)
+	if !ok {
+		return nil
+	}
+	name := util.FindTag(staticRoute.Tags, commonservice.TagScopeStaticRouteCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := util.FindTag(staticRoute.Tags, commonservice.TagScopeNamespace)
+	return &v1alpha1.StaticRoute{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 // Start setup manager and launch GC
 func (r *StaticRouteReconciler) Start(mgr ctrl.Manager, hookServer webhook.Server) error {
 	err := r.setupWithManager(mgr)
@@ -205,20 +244,18 @@ func (r *StaticRouteReconciler) Start(mgr ctrl.Manager, hookServer webhook.Serve
 	return nil
 }
 
-// CollectGarbage collect staticroute which has been removed from crd.
-// it implements the interface GarbageCollector method.
-func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("static route garbage collector started")
+// listStaleStaticRoutes returns the NSX static routes whose CRs have been removed.
+func (r *StaticRouteReconciler) listStaleStaticRoutes(ctx context.Context) ([]*model.StaticRoutes, error) {
 	nsxStaticRouteList := r.Service.ListStaticRoute()
 	if len(nsxStaticRouteList) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	crdStaticRouteList := &v1alpha1.StaticRouteList{}
 	err := r.Client.List(ctx, crdStaticRouteList)
 	if err != nil {
 		log.Error(err, "failed to list static route CR")
-		return err
+		return nil, err
 	}
 
 	crdStaticRouteSet := sets.NewString()
@@ -226,9 +263,8 @@ func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
 		crdStaticRouteSet.Insert(string(sr.UID))
 	}
 
-	var errList []error
-	for _, e := range nsxStaticRouteList {
-		elem := e
+	var staleStaticRoutes []*model.StaticRoutes
+	for _, elem := range nsxStaticRouteList {
 		UID := r.Service.GetUID(elem)
 		if UID == nil {
 			continue
@@ -236,7 +272,22 @@ func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
 		if crdStaticRouteSet.Has(*UID) {
 			continue
 		}
+		staleStaticRoutes = append(staleStaticRoutes, elem)
+	}
+	return staleStaticRoutes, nil
+}
+
+// CollectGarbage collect staticroute which has been removed from crd.
+// it implements the interface GarbageCollector method.
+func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("static route garbage collector started")
+	staleStaticRoutes, err := r.listStaleStaticRoutes(ctx)
+	if err != nil {
+		return err
+	}
 
+	var errList []error
+	for _, elem := range staleStaticRoutes {
 		log.Debug("GC collected StaticRoute CR", "UID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
 		err = r.Service.DeleteStaticRoute(elem)
@@ -253,6 +304,22 @@ func (r *StaticRouteReconciler) CollectGarbage(ctx context.Context) error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. The static routes are not re-realized in the restore,
+// only the ones collected by the garbage collection are reported.
+func (r *StaticRouteReconciler) RestoreDryRun(ctx context.Context) (*pkgUtil.RestorePlan, error) {
+	staleStaticRoutes, err := r.listStaleStaticRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &pkgUtil.RestorePlan{}
+	for _, staticRoute := range staleStaticRoutes {
+		if staticRoute.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *staticRoute.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *StaticRouteReconciler) RestoreReconcile() error {
 	return nil
 }
@@ -37,6 +37,7 @@ import (
 	_ "github.com/vmware-tanzu/nsx-operator/pkg/nsx/ratelimiter"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/staticroute"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 func NewFakeStaticRouteReconciler() *StaticRouteReconciler {
@@ -239,6 +240,22 @@ func TestStaticRouteReconciler_Reconcile(t *testing.T) {
 	assert.NotEqual(t, ret, nil)
 	patch.Reset()
 
+	//  DeletionTimestamp.IsZero = true,  CreateorUpdateStaticRoute pending on realization
+	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil).Do(func(_ context.Context, _ client.ObjectKey, obj client.Object, option ...client.GetOption) error {
+		v1sp := obj.(*v1alpha1.StaticRoute)
+		v1sp.ObjectMeta.DeletionTimestamp = nil
+		return nil
+	})
+
+	patch = gomonkey.ApplyMethod(reflect.TypeOf(service), "CreateOrUpdateStaticRoute", func(_ *staticroute.StaticRouteService, ctx context.Context, namespace string, obj *v1alpha1.StaticRoute) error {
+		return nsxutil.NewRealizationPendingError("being realized")
+	})
+	k8sClient.EXPECT().Status().Times(1).Return(fakewriter)
+	result, ret := r.Reconcile(ctx, req)
+	assert.Nil(t, ret)
+	assert.Equal(t, ResultRequeueAfterRealization, result)
+	patch.Reset()
+
 	//  DeletionTimestamp.IsZero = true,  CreateorUpdateStaticRoute succ
 	k8sClient.EXPECT().Get(ctx, gomock.Any(), sp).Return(nil).Do(func(_ context.Context, _ client.ObjectKey, obj client.Object, option ...client.GetOption) error {
 		v1sp := obj.(*v1alpha1.StaticRoute)
@@ -335,6 +352,39 @@ func TestStaticRouteReconciler_GarbageCollector(t *testing.T) {
 	r.CollectGarbage(ctx)
 }
 
+func TestStaticRouteReconciler_RestoreDryRun(t *testing.T) {
+	service := &staticroute.StaticRouteService{}
+	stalePath := "/orgs/org123/projects/pro123/vpcs/vpc123/static-routes/stale"
+	path := "/orgs/org123/projects/pro123/vpcs/vpc123/static-routes/123"
+	patch := gomonkey.ApplyMethod(reflect.TypeOf(service), "ListStaticRoute", func(_ *staticroute.StaticRouteService) []*model.StaticRoutes {
+		return []*model.StaticRoutes{
+			{Id: util.Ptr("stale"), Path: &stalePath, Tags: []model.Tag{{Scope: util.Ptr(common.TagScopeStaticRouteCRUID), Tag: util.Ptr("2345")}}},
+			{Id: util.Ptr("123"), Path: &path, Tags: []model.Tag{{Scope: util.Ptr(common.TagScopeStaticRouteCRUID), Tag: util.Ptr("1234")}}},
+		}
+	})
+	patch.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *staticroute.StaticRouteService, nsxStaticRoute *model.StaticRoutes) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	defer patch.Reset()
+	mockCtl := gomock.NewController(t)
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	r := &StaticRouteReconciler{
+		Client:  k8sClient,
+		Service: service,
+	}
+	ctx := context.Background()
+	k8sClient.EXPECT().List(ctx, &v1alpha1.StaticRouteList{}).Return(nil).Do(func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+		a := list.(*v1alpha1.StaticRouteList)
+		a.Items = []v1alpha1.StaticRoute{{ObjectMeta: metav1.ObjectMeta{UID: "1234"}}}
+		return nil
+	})
+	plan, err := r.RestoreDryRun(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, plan.CRsToRestore)
+	assert.Equal(t, []string{stalePath}, plan.NSXResourcesToDelete)
+}
+
 func TestStaticRouteReconciler_Start(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mock_client.NewMockClient(mockCtl)
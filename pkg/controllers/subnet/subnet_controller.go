@@ -42,6 +42,8 @@ var (
 	ResultRequeue           = common.ResultRequeue
 	ResultRequeueAfter10sec = common.ResultRequeueAfter10sec
 	MetricResTypeSubnet     = common.MetricResTypeSubnet
+
+	ResultRequeueAfterRealization = common.ResultRequeueAfterRealization
 )
 
 // SubnetReconciler reconciles a SubnetSet object
@@ -55,6 +57,8 @@ type SubnetReconciler struct {
 	Recorder          record.EventRecorder
 	StatusUpdater     common.StatusUpdater
 	queue             workqueue.TypedRateLimitingInterface[reconcile.Request]
+	// sharedSubnetPollState tracks the refresh of shared Subnets from NSX
+	sharedSubnetPollState sharedSubnetPollState
 }
 
 func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -150,13 +154,21 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		specChanged = true
 	}
 
+	// Select the VPC by spec.vpcName, which is the name of an additional VPC when set by users. spec.vpcName is
+	// immutable once set, so it is only filled with the primary VPC full ID when it is empty, and the resolved
+	// VPC full ID is recorded in status.
+	vpcInfo, err := servicecommon.GetVPCInfoByName(r.VPCService, req.Namespace, subnetCR.Spec.VPCName)
+	if err != nil {
+		r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Failed to find VPC", setSubnetReadyStatusFalse)
+		return ResultRequeueAfter10sec, nil
+	}
+	// Get VPC full ID
+	vpcFullID, err := servicecommon.GetVPCFullID(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, r.VPCService)
+	if err != nil {
+		log.Error(err, "Failed to get VPC full ID", "Namespace", subnetCR.Namespace)
+		return ResultRequeue, nil
+	}
 	if subnetCR.Spec.VPCName == "" {
-		// Get VPC full ID
-		vpcFullID, err := servicecommon.GetVPCFullID(vpcInfoList[0].OrgID, vpcInfoList[0].ProjectID, vpcInfoList[0].VPCID, r.VPCService)
-		if err != nil {
-			log.Error(err, "Failed to get VPC full ID", "Namespace", subnetCR.Namespace)
-			return ResultRequeue, nil
-		}
 		subnetCR.Spec.VPCName = vpcFullID
 		specChanged = true
 	}
@@ -189,6 +201,7 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 		log.Info("Updated Subnet CR", "Subnet", req.NamespacedName)
 	}
+	subnetCR.Status.VPCFullID = vpcFullID
 
 	tags := r.SubnetService.GenerateSubnetNSTags(subnetCR)
 	if tags == nil {
@@ -197,11 +210,22 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	// Create or update the subnet in NSX
-	if _, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, vpcInfoList[0], tags); err != nil {
+	if _, err := r.SubnetService.CreateOrUpdateSubnet(subnetCR, *vpcInfo, tags); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			// The Subnet CR is requeued by the realization watcher, or after the realization timeout if the
+			// realization event is dropped.
+			setSubnetRealizingStatus(r.Client, ctx, subnetCR, metav1.Now())
+			return ResultRequeueAfterRealization, nil
+		}
 		if errors.As(err, &nsxutil.ExceedTagsError{}) {
 			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Tags limit exceeded", setSubnetReadyStatusFalse)
 			return ResultNormal, nil
 		}
+		var validationErr *nsxutil.ValidationError
+		if errors.As(err, &validationErr) {
+			r.StatusUpdater.UpdateFail(ctx, subnetCR, err, "Invalid segment profiles", setSubnetReadyStatusFalse)
+			return ResultNormal, nil
+		}
 		var nsxErr *nsxutil.NSXApiError
 		if errors.As(err, &nsxErr) && nsxErr.ApiError != nil && nsxErr.ApiError.ErrorCode != nil {
 			code := *(nsxErr.ApiError.ErrorCode)
@@ -317,13 +341,18 @@ func (r *SubnetReconciler) updateSubnetStatus(obj *v1alpha1.Subnet) error {
 
 // handleSharedSubnet manages a shared subnet annotated with an associated resource.
 // It first tries to get the NSX subnet from the cache.
-// If not found, it retrieves the subnet from the NSX API.
+// If not found, or the Subnet CR has the refresh annotation, it retrieves the subnet from the NSX API.
 // The function updates the Subnet CR status and adds the subnet to the polling queue for regular updates.
 func (r *SubnetReconciler) handleSharedSubnet(ctx context.Context, subnetCR *v1alpha1.Subnet, namespacedName client.ObjectKey, associatedResource string) (ctrl.Result, error) {
 	log.Info("Subnet has associated-resource annotation, skipping NSX Subnet creation", "Subnet", namespacedName, "AssociatedResource", associatedResource)
 
-	// Get NSX subnet from cache or API
-	nsxSubnet, err := r.SubnetService.GetNSXSubnetFromCacheOrAPI(associatedResource, false)
+	_, forceRefresh := subnetCR.Annotations[servicecommon.AnnotationRefreshSharedSubnet]
+	if forceRefresh {
+		log.Info("Refreshing shared Subnet from NSX on demand", "Subnet", namespacedName, "AssociatedResource", associatedResource)
+	}
+
+	// Get NSX subnet from cache or API, the cached status list is reset when getting from API
+	nsxSubnet, err := r.SubnetService.GetNSXSubnetFromCacheOrAPI(associatedResource, forceRefresh)
 	if err != nil {
 		r.updateSharedSubnetWithError(ctx, namespacedName, err, "Failed to get NSX Subnet for associated resource")
 		return ResultRequeue, err
@@ -332,7 +361,7 @@ func (r *SubnetReconciler) handleSharedSubnet(ctx context.Context, subnetCR *v1a
 	// Get subnet status from cache or API
 	statusList, err := r.SubnetService.GetSubnetStatusFromCacheOrAPI(nsxSubnet, associatedResource)
 	if err != nil {
-		// Use updateSharedSubnetWithError for consistency with pollAllSharedSubnets
+		// Use updateSharedSubnetWithError for consistency with refreshSharedSubnets
 		r.updateSharedSubnetWithError(ctx, namespacedName, err, "NSX subnet status")
 		return ResultRequeue, err
 	}
@@ -343,6 +372,14 @@ func (r *SubnetReconciler) handleSharedSubnet(ctx context.Context, subnetCR *v1a
 		return ResultRequeue, err
 	}
 
+	if forceRefresh {
+		r.scheduleSharedSubnetRefresh(associatedResource, time.Now())
+		if err := util.UpdateK8sResourceAnnotation(r.Client, ctx, subnetCR, map[string]string{servicecommon.AnnotationRefreshSharedSubnet: ""}); err != nil {
+			log.Error(err, "Failed to remove the refresh annotation from Subnet", "Subnet", namespacedName)
+			return ResultRequeue, err
+		}
+	}
+
 	r.StatusUpdater.UpdateSuccess(ctx, subnetCR, setSubnetReadyStatusTrue)
 	return ResultNormal, nil
 }
@@ -398,7 +435,7 @@ func setSubnetReadyStatusFalse(client client.Client, ctx context.Context, obj cl
 			Type:               v1alpha1.Ready,
 			Status:             v1.ConditionFalse,
 			Message:            "NSX Subnet could not be created/updated",
-			Reason:             "SubnetNotReady",
+			Reason:             nsxutil.ErrorReason(err, "SubnetNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -410,6 +447,19 @@ func setSubnetReadyStatusFalse(client client.Client, ctx context.Context, obj cl
 	updateSubnetStatusConditions(client, ctx, subnetCR, newConditions)
 }
 
+func setSubnetRealizingStatus(client client.Client, ctx context.Context, subnetCR *v1alpha1.Subnet, transitionTime metav1.Time) {
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.Ready,
+			Status:             v1.ConditionFalse,
+			Message:            "NSX Subnet is being realized",
+			Reason:             "SubnetRealizing",
+			LastTransitionTime: transitionTime,
+		},
+	}
+	updateSubnetStatusConditions(client, ctx, subnetCR, newConditions)
+}
+
 func (r *SubnetReconciler) setSubnetDeletionFailedStatus(ctx context.Context, subnet *v1alpha1.Subnet, transitionTime metav1.Time, msg string, reason string) {
 	newConditions := []v1alpha1.Condition{
 		{
@@ -474,7 +524,7 @@ func getExistingConditionOfType(conditionType v1alpha1.ConditionType, existingCo
 }
 
 func (r *SubnetReconciler) RestoreReconcile() error {
-	restoreList, err := r.getRestoreList()
+	restoreList, err := r.getRestoreList(false)
 	if err != nil {
 		err = fmt.Errorf("failed to get Subnet restore list: %w", err)
 		return err
@@ -492,7 +542,9 @@ func (r *SubnetReconciler) RestoreReconcile() error {
 	return nil
 }
 
-func (r *SubnetReconciler) getRestoreList() ([]types.NamespacedName, error) {
+// getRestoreList returns the Subnet CRs to be restored, the status of the shared Subnets whose NSX Subnets do not exist
+// is updated unless dryRun is true.
+func (r *SubnetReconciler) getRestoreList(dryRun bool) ([]types.NamespacedName, error) {
 	restoreList := []types.NamespacedName{}
 	subnetList := &v1alpha1.SubnetList{}
 	if err := r.Client.List(context.TODO(), subnetList); err != nil {
@@ -511,6 +563,9 @@ func (r *SubnetReconciler) getRestoreList() ([]types.NamespacedName, error) {
 				if nsxErr, ok := err.(*nsxutil.NSXApiError); ok {
 					if nsxErr.Type() == stderrors.ErrorType_NOT_FOUND {
 						log.Warn("Precreated Subnet does not exist in NSX", "associatedResource", associatedResource)
+						if dryRun {
+							continue
+						}
 						r.StatusUpdater.UpdateFail(context.TODO(), &subnetCR, err, "NSX Subnet does not exists", setSubnetReadyStatusFalse)
 						continue
 					}
@@ -527,6 +582,30 @@ func (r *SubnetReconciler) getRestoreList() ([]types.NamespacedName, error) {
 	return restoreList, nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner.
+func (r *SubnetReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	restoreList, err := r.getRestoreList(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Subnet restore list: %w", err)
+	}
+	subnetIDsToDelete, err := r.listStaleSubnetIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	for _, subnetID := range sets.List(subnetIDsToDelete) {
+		for _, nsxSubnet := range r.SubnetService.ListSubnetCreatedBySubnet(subnetID) {
+			if nsxSubnet.Path != nil {
+				plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxSubnet.Path)
+			}
+		}
+	}
+	return plan, nil
+}
+
 func (r *SubnetReconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	// Start the controller
 	if err := r.start(mgr, hookServer); err != nil {
@@ -563,9 +642,10 @@ func (r *SubnetReconciler) start(mgr ctrl.Manager, hookServer webhook.Server) er
 		hookServer.Register("/validate-crd-nsx-vmware-com-v1alpha1-subnet",
 			&webhook.Admission{
 				Handler: &SubnetValidator{
-					Client:    mgr.GetClient(),
-					decoder:   admission.NewDecoder(mgr.GetScheme()),
-					nsxClient: r.SubnetService.NSXClient,
+					Client:     mgr.GetClient(),
+					decoder:    admission.NewDecoder(mgr.GetScheme()),
+					nsxClient:  r.SubnetService.NSXClient,
+					vpcService: r.VPCService,
 				},
 			})
 	}
@@ -576,6 +656,21 @@ func (r *SubnetReconciler) start(mgr ctrl.Manager, hookServer webhook.Server) er
 	return nil
 }
 
+// subnetDriftMapFunc maps the drifted NSX Subnet to the Subnet CR it is created for, the NSX Subnets created for
+// SubnetSets are skipped.
+func subnetDriftMapFunc(obj interface{}) client.Object {
+	nsxSubnet, ok := obj.(*model.VpcSubnet)
+	if !ok {
+		return nil
+	}
+	name := nsxutil.FindTag(nsxSubnet.Tags, servicecommon.TagScopeSubnetCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(nsxSubnet.Tags, servicecommon.TagScopeVMNamespace)
+	return &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 // setupWithManager configures the controller to watch Subnet resources
 func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -602,6 +697,10 @@ func (r *SubnetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
+		WatchesRawSource(common.NewStoreDriftSource(servicecommon.ResourceTypeSubnet, subnetDriftMapFunc)).
+		WatchesRawSource(common.NewRealizationSource(servicecommon.ResourceTypeSubnet, func() client.Object {
+			return &v1alpha1.Subnet{}
+		})).
 		Complete(r)
 }
 
@@ -627,6 +726,17 @@ func (r *SubnetReconciler) listSubnetIDsFromCRs(ctx context.Context) ([]string,
 	return crdSubnetIDs, nil
 }
 
+// listStaleSubnetIDs returns the CR UIDs of the NSX Subnets whose Subnet CRs have been deleted.
+func (r *SubnetReconciler) listStaleSubnetIDs(ctx context.Context) (sets.Set[string], error) {
+	crdSubnetIDs, err := r.listSubnetIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list Subnet CRs")
+		return nil, err
+	}
+	subnetUIDs := r.SubnetService.ListSubnetIDsFromNSXSubnets()
+	return subnetUIDs.Difference(sets.New[string](crdSubnetIDs...)), nil
+}
+
 // CollectGarbage implements the interface GarbageCollector method.
 func (r *SubnetReconciler) CollectGarbage(ctx context.Context) error {
 	startTime := time.Now()
@@ -634,16 +744,12 @@ func (r *SubnetReconciler) CollectGarbage(ctx context.Context) error {
 		log.Info("Subnet garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
 	}()
 
-	crdSubnetIDs, err := r.listSubnetIDsFromCRs(ctx)
+	subnetIDsToDelete, err := r.listStaleSubnetIDs(ctx)
 	if err != nil {
-		log.Error(err, "Failed to list Subnet CRs")
 		return err
 	}
-	crdSubnetIDsSet := sets.New[string](crdSubnetIDs...)
 
 	var errList []error
-	subnetUIDs := r.SubnetService.ListSubnetIDsFromNSXSubnets()
-	subnetIDsToDelete := subnetUIDs.Difference(crdSubnetIDsSet)
 	for subnetID := range subnetIDsToDelete {
 		nsxSubnets := r.SubnetService.ListSubnetCreatedBySubnet(subnetID)
 		r.StatusUpdater.IncreaseDeleteTotal()
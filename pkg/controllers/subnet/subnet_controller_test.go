@@ -525,6 +525,119 @@ func TestSubnetReconciler_Reconcile(t *testing.T) {
 			expectErrStr:     "create or update failed",
 			expectRes:        ResultRequeue,
 		},
+		{
+			name: "Create or Update Subnet being realized",
+			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
+			patches: func(r *SubnetReconciler) *gomonkey.Patches {
+				vpcnetworkConfig := &v1alpha1.VPCNetworkConfiguration{Spec: v1alpha1.VPCNetworkConfigurationSpec{DefaultSubnetSize: 16}}
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCService), "GetVPCNetworkConfigByNamespace", func(_ *vpc.VPCService, ns string) (*v1alpha1.VPCNetworkConfiguration, error) {
+					return vpcnetworkConfig, nil
+				})
+				patches.ApplyPrivateMethod(reflect.TypeOf(r), "getSubnetBindingCRsBySubnet", func(_ *SubnetReconciler, _ context.Context, _ *v1alpha1.Subnet) []v1alpha1.SubnetConnectionBindingMap {
+					return []v1alpha1.SubnetConnectionBindingMap{}
+				})
+				tags := []model.Tag{{Scope: common.String(common.TagScopeSubnetCRUID), Tag: common.String("fake-tag")}}
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GenerateSubnetNSTags", func(_ *subnet.SubnetService, obj client.Object) []model.Tag {
+					return tags
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, ns string) []common.VPCResourceInfo {
+					return []common.VPCResourceInfo{
+						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
+					}
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+					return nil, nsxutil.NewRealizationPendingError("being realized")
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
+					return false, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "GetNetworkStackFromNC", func(_ *vpc.VPCService, config *v1alpha1.VPCNetworkConfiguration) (v1alpha1.NetworkStackType, error) {
+					return v1alpha1.FullStackVPC, nil
+				})
+				return patches
+			},
+			existingSubnetCR: createNewSubnet(),
+			expectRes:        ResultRequeueAfterRealization,
+		},
+		{
+			name: "Create or Update Subnet in additional VPC",
+			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
+			patches: func(r *SubnetReconciler) *gomonkey.Patches {
+				vpcnetworkConfig := &v1alpha1.VPCNetworkConfiguration{Spec: v1alpha1.VPCNetworkConfigurationSpec{
+					DefaultSubnetSize: 16,
+					AdditionalVPCs:    []v1alpha1.AdditionalVPC{{Name: "data", VPC: "/orgs/default/projects/project-id/vpcs/data-vpc"}},
+				}}
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCService), "GetVPCNetworkConfigByNamespace", func(_ *vpc.VPCService, ns string) (*v1alpha1.VPCNetworkConfiguration, error) {
+					return vpcnetworkConfig, nil
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "GetNetworkStackFromNC", func(_ *vpc.VPCService, config *v1alpha1.VPCNetworkConfiguration) (v1alpha1.NetworkStackType, error) {
+					return v1alpha1.FullStackVPC, nil
+				})
+				patches.ApplyPrivateMethod(reflect.TypeOf(r), "getSubnetBindingCRsBySubnet", func(_ *SubnetReconciler, _ context.Context, _ *v1alpha1.Subnet) []v1alpha1.SubnetConnectionBindingMap {
+					return []v1alpha1.SubnetConnectionBindingMap{}
+				})
+				tags := []model.Tag{{Scope: common.String(common.TagScopeSubnetCRUID), Tag: common.String("fake-tag")}}
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GenerateSubnetNSTags", func(_ *subnet.SubnetService, obj client.Object) []model.Tag {
+					return tags
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, ns string) []common.VPCResourceInfo {
+					return []common.VPCResourceInfo{
+						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
+					}
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "CreateOrUpdateSubnet", func(_ *subnet.SubnetService, obj client.Object, vpcInfo common.VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error) {
+					return nil, fmt.Errorf("create or update failed in VPC %s", vpcInfo.VPCID)
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
+					return false, nil
+				})
+				return patches
+			},
+			existingSubnetCR: func() *v1alpha1.Subnet {
+				subnetCR := createNewSubnet()
+				subnetCR.Spec.VPCName = "data"
+				return subnetCR
+			}(),
+			expectSubnetCR: &v1alpha1.Subnet{
+				Spec: v1alpha1.SubnetSpec{VPCName: "data", IPv4SubnetSize: 16, AccessMode: "Private",
+					IPAddresses:      []string(nil),
+					SubnetDHCPConfig: v1alpha1.SubnetDHCPConfig{Mode: v1alpha1.DHCPConfigMode(v1alpha1.DHCPConfigModeDeactivated)},
+					AdvancedConfig: v1alpha1.SubnetAdvancedConfig{
+						StaticIPAllocation: v1alpha1.StaticIPAllocation{
+							Enabled: common.Bool(true),
+						},
+					},
+				},
+			},
+			expectErrStr: "create or update failed in VPC data-vpc",
+			expectRes:    ResultRequeue,
+		},
+		{
+			name: "Create or Update Subnet in VPC not associated with Namespace",
+			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
+			patches: func(r *SubnetReconciler) *gomonkey.Patches {
+				vpcnetworkConfig := &v1alpha1.VPCNetworkConfiguration{Spec: v1alpha1.VPCNetworkConfigurationSpec{DefaultSubnetSize: 16}}
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(r.VPCService), "GetVPCNetworkConfigByNamespace", func(_ *vpc.VPCService, ns string) (*v1alpha1.VPCNetworkConfiguration, error) {
+					return vpcnetworkConfig, nil
+				})
+				patches.ApplyPrivateMethod(reflect.TypeOf(r), "getSubnetBindingCRsBySubnet", func(_ *SubnetReconciler, _ context.Context, _ *v1alpha1.Subnet) []v1alpha1.SubnetConnectionBindingMap {
+					return []v1alpha1.SubnetConnectionBindingMap{}
+				})
+				patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, ns string) []common.VPCResourceInfo {
+					return []common.VPCResourceInfo{
+						{OrgID: "org-id", ProjectID: "project-id", VPCID: "vpc-id", ID: "fake-id"},
+					}
+				})
+				return patches
+			},
+			existingSubnetCR: func() *v1alpha1.Subnet {
+				subnetCR := createNewSubnet()
+				subnetCR.Spec.VPCName = "mgmt"
+				subnetCR.Spec.AccessMode = "Private"
+				return subnetCR
+			}(),
+			expectRes: ResultRequeueAfter10sec,
+		},
 		{
 			name: "Update Subnet CR spec success",
 			req:  ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-subnet"}},
@@ -1274,7 +1387,7 @@ func patchSuccessfulReconcileSubnetWorkflow(r *SubnetReconciler, patches *gomonk
 		return []model.Tag{{Scope: common.String("test"), Tag: common.String("subnet")}}
 	})
 	patches.ApplyMethod(reflect.TypeOf(r.VPCService), "ListVPCInfo", func(_ *vpc.VPCService, _ string) []common.VPCResourceInfo {
-		return []common.VPCResourceInfo{{ID: "vpc1"}}
+		return []common.VPCResourceInfo{{ID: "vpc1", ProjectID: "project", VPCID: "test-vpc"}}
 	})
 	patches.ApplyMethod(reflect.TypeOf(r.VPCService), "IsDefaultNSXProject", func(_ *vpc.VPCService, orgID, projectID string) (bool, error) {
 		return false, nil
@@ -1439,6 +1552,46 @@ func TestSubnetReconciler_RestoreReconcile(t *testing.T) {
 	assert.ErrorContains(t, err, "mocked get NSX Subnet error")
 }
 
+func TestSubnetReconciler_RestoreDryRun(t *testing.T) {
+	r := createFakeSubnetReconciler([]client.Object{
+		&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "ns-1", UID: "subnet-1"},
+			Status:     v1alpha1.SubnetStatus{NetworkAddresses: []string{"10.0.0.0/28"}},
+		},
+		&v1alpha1.Subnet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "subnet-2",
+				Namespace:   "ns-1",
+				UID:         "subnet-2",
+				Annotations: map[string]string{common.AnnotationAssociatedResource: ":vpc-1:subnet-2"},
+			},
+			Status: v1alpha1.SubnetStatus{NetworkAddresses: []string{"10.0.0.32/28"}},
+		},
+	})
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService), "ListSubnetIDsFromNSXSubnets", func(_ *subnet.SubnetService) sets.Set[string] {
+		return sets.New[string]("subnet-1", "subnet-stale")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "ListSubnetCreatedBySubnet", func(_ *subnet.SubnetService, id string) []*model.VpcSubnet {
+		return []*model.VpcSubnet{{Id: common.String(id), Path: common.String("/orgs/default/projects/default/vpcs/vpc-1/subnets/" + id)}}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetNSXSubnetFromCacheOrAPI", func(_ *subnet.SubnetService, _ string, _ bool) (*model.VpcSubnet, error) {
+		return nil, nsxutil.NewNSXApiError(&model.ApiError{}, apierrors.ErrorType_NOT_FOUND)
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ model.VpcSubnet) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	patches.ApplyFunc((*common2.StatusUpdater).UpdateFail, func(_ *common2.StatusUpdater, _ context.Context, _ client.Object, _ error, _ string, _ common2.UpdateFailStatusFn, _ ...interface{}) {
+		assert.FailNow(t, "should not be called")
+	})
+	defer patches.Reset()
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/subnet-1"}, plan.CRsToRestore)
+	assert.Equal(t, []string{"/orgs/default/projects/default/vpcs/vpc-1/subnets/subnet-stale"}, plan.NSXResourcesToDelete)
+}
+
 func TestHandleSharedSubnet(t *testing.T) {
 	// Test cases
 	tests := []struct {
@@ -1591,6 +1744,55 @@ func TestHandleSharedSubnet(t *testing.T) {
 	}
 }
 
+func TestHandleSharedSubnetWithRefreshAnnotation(t *testing.T) {
+	associatedResource := "project1:vpc1:subnet1"
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-subnet",
+			Namespace: "default",
+			Annotations: map[string]string{
+				common.AnnotationAssociatedResource:  associatedResource,
+				common.AnnotationRefreshSharedSubnet: "true",
+			},
+		},
+	}
+	r := createFakeSubnetReconciler([]client.Object{subnetCR})
+	nsxSubnet := &model.VpcSubnet{Id: common.String("subnet1")}
+	// The cached NSX Subnet and status are ignored on demand
+	r.SubnetService.UpdateNSXSubnetCache(associatedResource, &model.VpcSubnet{Id: common.String("cached")}, []model.VpcSubnetStatus{{NetworkAddress: common.String("10.0.0.0/24")}})
+
+	getSubnetCalls, getStatusCalls := 0, 0
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetNSXSubnetByAssociatedResource", func(_ *subnet.SubnetService, _ string) (*model.VpcSubnet, error) {
+		getSubnetCalls++
+		return nsxSubnet, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetStatus", func(_ *subnet.SubnetService, s *model.VpcSubnet) ([]model.VpcSubnetStatus, error) {
+		getStatusCalls++
+		assert.Equal(t, nsxSubnet, s)
+		return []model.VpcSubnetStatus{{NetworkAddress: common.String("10.0.1.0/24"), GatewayAddress: common.String("10.0.1.1")}}, nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "updateSubnetIfNeeded",
+		func(_ *SubnetReconciler, _ context.Context, _ *v1alpha1.Subnet, _ *model.VpcSubnet, _ []model.VpcSubnetStatus, _ types.NamespacedName) error {
+			return nil
+		})
+	defer patches.Reset()
+
+	result, err := r.handleSharedSubnet(context.Background(), subnetCR, client.ObjectKeyFromObject(subnetCR), associatedResource)
+	assert.NoError(t, err)
+	assert.Equal(t, ResultNormal, result)
+	assert.Equal(t, 1, getSubnetCalls)
+	assert.Equal(t, 1, getStatusCalls)
+
+	// The refresh annotation is removed and the next periodic refresh is scheduled
+	updatedCR := &v1alpha1.Subnet{}
+	assert.NoError(t, r.Client.Get(context.Background(), client.ObjectKeyFromObject(subnetCR), updatedCR))
+	_, ok := updatedCR.Annotations[common.AnnotationRefreshSharedSubnet]
+	assert.False(t, ok)
+	assert.Equal(t, associatedResource, updatedCR.Annotations[common.AnnotationAssociatedResource])
+	_, ok = r.sharedSubnetPollState.nextRefresh[associatedResource]
+	assert.True(t, ok)
+}
+
 func TestSetDefaultIPv4SubnetSizeValue(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -16,17 +18,47 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
-var ticker = time.NewTicker(10 * time.Minute)
+const (
+	// defaultSharedSubnetPollInterval is used when shared_subnet_poll_interval is not configured
+	defaultSharedSubnetPollInterval = 10 * time.Minute
+	// sharedSubnetCheckPeriod is the period to search NSX for the changed shared Subnets
+	sharedSubnetCheckPeriod = time.Minute
+	// sharedSubnetRefreshJitterFactor spreads the periodic refresh of the shared Subnets
+	sharedSubnetRefreshJitterFactor = 0.2
+	// sharedSubnetSearchSkew tolerates the clock difference between the operator and NSX
+	sharedSubnetSearchSkew = time.Minute
+)
+
+// sharedSubnetPollState records when each shared Subnet was searched and should be refreshed from NSX.
+type sharedSubnetPollState struct {
+	mutex sync.Mutex
+	// nextRefresh is a map of associatedResource -> time of the next periodic refresh
+	nextRefresh map[string]time.Time
+	// lastSearch is the time of the last successful search for changed NSX Subnets
+	lastSearch time.Time
+}
+
+// sharedSubnetPollInterval returns the configured interval to refresh a shared Subnet from NSX.
+func (r *SubnetReconciler) sharedSubnetPollInterval() time.Duration {
+	nsxConfig := r.SubnetService.NSXConfig
+	if nsxConfig == nil || nsxConfig.NsxConfig == nil || nsxConfig.SharedSubnetPollInterval <= 0 {
+		return defaultSharedSubnetPollInterval
+	}
+	return time.Duration(nsxConfig.SharedSubnetPollInterval) * time.Second
+}
 
-// pollSharedSubnets periodically polls NSX for shared subnet status updates
-// It runs in a separate goroutine and polls every 10 minutes.
+// pollSharedSubnets periodically polls NSX for shared subnet status updates.
+// It runs in a separate goroutine. Every check period, the NSX Subnets changed since the
+// last search are refreshed, and every shared Subnet is refreshed at least once in the
+// jittered poll interval.
 // The polling can be stopped by sending a value to the stopCh channel.
 func (r *SubnetReconciler) pollSharedSubnets(stopCh chan bool) {
+	ticker := time.NewTicker(min(sharedSubnetCheckPeriod, r.sharedSubnetPollInterval()))
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ticker.C:
-			r.pollAllSharedSubnets()
+			r.pollChangedSharedSubnets(time.Now())
 		case <-stopCh:
 			log.Info("Stopping shared Subnet polling")
 			return
@@ -34,17 +66,93 @@ func (r *SubnetReconciler) pollSharedSubnets(stopCh chan bool) {
 	}
 }
 
-// pollAllSharedSubnets polls NSX for all shared subnets.
-// It groups subnets by associatedResource to avoid redundant NSX API calls.
-// For each unique associatedResource, it gets the NSX subnet and status only once,
-// then updates all related Subnet CRs with the same information.
-// It also removes subnets from the queue if they no longer exist or are being deleted.
-func (r *SubnetReconciler) pollAllSharedSubnets() {
+// pollChangedSharedSubnets only refreshes the shared Subnets which are modified in NSX since
+// the last search or whose periodic refresh is due.
+func (r *SubnetReconciler) pollChangedSharedSubnets(now time.Time) {
+	dueResources := r.getDueSharedSubnets(now)
+	if dueResources.Len() > 0 {
+		log.Debug("Refreshing shared Subnets", "Count", dueResources.Len())
+		r.refreshSharedSubnets(dueResources)
+	}
+	r.cleanSharedSubnetCache()
+}
+
+// getDueSharedSubnets returns the associatedResources to refresh. A shared Subnet is due if its
+// NSX Subnet has a newer _last_modified_time than the last search, or its refresh time is reached.
+// If the search fails, only the periodic refresh applies and the search is retried from the same time.
+func (r *SubnetReconciler) getDueSharedSubnets(now time.Time) sets.Set[string] {
+	state := &r.sharedSubnetPollState
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if state.nextRefresh == nil {
+		state.nextRefresh = make(map[string]time.Time)
+	}
+
+	sharedResources := r.SubnetService.ListSharedSubnetResources()
+	changedPaths := sets.New[string]()
+	if sharedResources.Len() > 0 && !state.lastSearch.IsZero() {
+		paths, err := r.SubnetService.ListChangedSubnetPaths(state.lastSearch.Add(-sharedSubnetSearchSkew))
+		if err != nil {
+			log.Error(err, "Failed to search changed shared Subnets, only the periodic refresh applies")
+		} else {
+			changedPaths = paths
+			state.lastSearch = now
+		}
+	} else {
+		state.lastSearch = now
+	}
+
+	dueResources := sets.New[string]()
+	for associatedResource := range sharedResources {
+		nextRefresh, ok := state.nextRefresh[associatedResource]
+		if !ok {
+			// The shared Subnet was just fetched by the reconciler, schedule its first periodic refresh
+			state.nextRefresh[associatedResource] = now.Add(wait.Jitter(r.sharedSubnetPollInterval(), sharedSubnetRefreshJitterFactor))
+			continue
+		}
+		if !now.Before(nextRefresh) {
+			dueResources.Insert(associatedResource)
+			continue
+		}
+		subnetPath, err := servicecommon.GetSubnetPathFromAssociatedResource(associatedResource)
+		if err == nil && changedPaths.Has(subnetPath) {
+			log.Info("Shared Subnet is changed in NSX", "AssociatedResource", associatedResource)
+			dueResources.Insert(associatedResource)
+		}
+	}
+	for associatedResource := range state.nextRefresh {
+		if !sharedResources.Has(associatedResource) {
+			delete(state.nextRefresh, associatedResource)
+		}
+	}
+	return dueResources
+}
+
+// scheduleSharedSubnetRefresh sets the next periodic refresh time of a shared Subnet with jitter,
+// so that the shared Subnets are not refreshed from NSX all at once.
+func (r *SubnetReconciler) scheduleSharedSubnetRefresh(associatedResource string, now time.Time) {
+	state := &r.sharedSubnetPollState
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if state.nextRefresh == nil {
+		state.nextRefresh = make(map[string]time.Time)
+	}
+	state.nextRefresh[associatedResource] = now.Add(wait.Jitter(r.sharedSubnetPollInterval(), sharedSubnetRefreshJitterFactor))
+}
+
+// refreshSharedSubnets gets the NSX subnet and status of each associatedResource from NSX,
+// updates the cache and enqueues the related Subnet CRs.
+func (r *SubnetReconciler) refreshSharedSubnets(associatedResources sets.Set[string]) {
 	ctx := context.Background()
 
 	// Process each unique associatedResource
-	for associatedResource, namespacedNames := range r.SubnetService.SharedSubnetResourceMap {
+	for associatedResource := range associatedResources {
+		namespacedNames, ok := r.SubnetService.GetSharedSubnetNamespacedNames(associatedResource)
+		if !ok {
+			continue
+		}
 		log.Debug("Polling shared Subnets", "AssociatedResource", associatedResource, "SubnetCount", len(namespacedNames))
+		r.scheduleSharedSubnetRefresh(associatedResource, time.Now())
 
 		// Update the nsxSubnetCache with the latest NSX subnet data and status list
 		// This is done here to ensure the cache is updated during polling
@@ -77,9 +185,13 @@ func (r *SubnetReconciler) pollAllSharedSubnets() {
 			r.enqueueSubnetForReconciliation(ctx, namespacedName)
 		}
 	}
+}
 
+// cleanSharedSubnetCache removes the cached NSX subnets which are not used by any Subnet CR.
+func (r *SubnetReconciler) cleanSharedSubnetCache() {
+	sharedResources := r.SubnetService.ListSharedSubnetResources()
 	for associatedResource := range r.SubnetService.NSXSubnetCache {
-		if _, ok := r.SubnetService.SharedSubnetResourceMap[associatedResource]; !ok {
+		if !sharedResources.Has(associatedResource) {
 			log.Debug("Remove Subnet from cache", "AssociatedResource", associatedResource)
 			r.SubnetService.RemoveSubnetFromCache(associatedResource, "no valid subnets")
 			subnetPath, err := servicecommon.GetSubnetPathFromAssociatedResource(associatedResource)
@@ -689,7 +689,7 @@ func (f *fakeStatusWriter) Apply(_ context.Context, _ runtime.ApplyConfiguration
 	return nil
 }
 
-func TestPollAllSharedSubnets(t *testing.T) {
+func TestRefreshSharedSubnets(t *testing.T) {
 	tests := []struct {
 		name                    string
 		sharedSubnetsMap        map[string][]types.NamespacedName
@@ -823,7 +823,7 @@ func TestPollAllSharedSubnets(t *testing.T) {
 			patches.ApplyMethod(reflect.TypeOf(subnetportService), "DeletePortCount", func(_ common.SubnetPortServiceProvider, subnetPath string) {})
 
 			// Call the actual function being tested
-			r.pollAllSharedSubnets()
+			r.refreshSharedSubnets(r.SubnetService.ListSharedSubnetResources())
 
 			// Verify the number of unique resources
 			assert.Equal(t, tt.expectedUniqueResources, len(getNSXSubnetCalls),
@@ -990,3 +990,150 @@ func TestHandleNSXSubnetError(t *testing.T) {
 		})
 	}
 }
+
+func TestSharedSubnetPollInterval(t *testing.T) {
+	r := createFakeSubnetReconciler(nil)
+	assert.Equal(t, defaultSharedSubnetPollInterval, r.sharedSubnetPollInterval())
+
+	r.SubnetService.NSXConfig.SharedSubnetPollInterval = 120
+	assert.Equal(t, 2*time.Minute, r.sharedSubnetPollInterval())
+
+	r.SubnetService.NSXConfig.SharedSubnetPollInterval = -1
+	assert.Equal(t, defaultSharedSubnetPollInterval, r.sharedSubnetPollInterval())
+}
+
+func TestGetDueSharedSubnets(t *testing.T) {
+	now := time.Now()
+	subnet1 := types.NamespacedName{Namespace: "default", Name: "subnet-1"}
+	subnet2 := types.NamespacedName{Namespace: "default", Name: "subnet-2"}
+	subnet3 := types.NamespacedName{Namespace: "default", Name: "subnet-3"}
+
+	tests := []struct {
+		name              string
+		nextRefresh       map[string]time.Time
+		lastSearch        time.Time
+		changedPaths      []string
+		searchErr         error
+		expectedSearch    bool
+		expectedDue       []string
+		expectedScheduled []string
+		expectedLastCheck time.Time
+	}{
+		{
+			name:              "First round schedules all shared Subnets without searching",
+			nextRefresh:       map[string]time.Time{},
+			expectedSearch:    false,
+			expectedDue:       []string{},
+			expectedScheduled: []string{"project1:vpc1:subnet1", "project1:vpc1:subnet2", "project1:vpc1:subnet3"},
+			expectedLastCheck: now,
+		},
+		{
+			name: "Changed and expired shared Subnets are due",
+			nextRefresh: map[string]time.Time{
+				"project1:vpc1:subnet1": now.Add(-time.Second),
+				"project1:vpc1:subnet2": now.Add(time.Minute),
+				"project1:vpc1:subnet3": now.Add(time.Minute),
+				"project1:vpc1:stale":   now.Add(time.Minute),
+			},
+			lastSearch:        now.Add(-time.Minute),
+			changedPaths:      []string{"/orgs/default/projects/project1/vpcs/vpc1/subnets/subnet2", "/orgs/default/projects/project1/vpcs/vpc1/subnets/other"},
+			expectedSearch:    true,
+			expectedDue:       []string{"project1:vpc1:subnet1", "project1:vpc1:subnet2"},
+			expectedScheduled: []string{"project1:vpc1:subnet1", "project1:vpc1:subnet2", "project1:vpc1:subnet3"},
+			expectedLastCheck: now,
+		},
+		{
+			name: "Search failure falls back to the periodic refresh",
+			nextRefresh: map[string]time.Time{
+				"project1:vpc1:subnet1": now.Add(-time.Second),
+				"project1:vpc1:subnet2": now.Add(time.Minute),
+				"project1:vpc1:subnet3": now.Add(time.Minute),
+			},
+			lastSearch:        now.Add(-time.Minute),
+			searchErr:         fmt.Errorf("search error"),
+			expectedSearch:    true,
+			expectedDue:       []string{"project1:vpc1:subnet1"},
+			expectedScheduled: []string{"project1:vpc1:subnet1", "project1:vpc1:subnet2", "project1:vpc1:subnet3"},
+			expectedLastCheck: now.Add(-time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := createFakeSubnetReconciler(nil)
+			r.SubnetService.SharedSubnetResourceMap = map[string]sets.Set[types.NamespacedName]{
+				"project1:vpc1:subnet1": sets.New(subnet1),
+				"project1:vpc1:subnet2": sets.New(subnet2),
+				"project1:vpc1:subnet3": sets.New(subnet3),
+			}
+			r.sharedSubnetPollState.nextRefresh = tt.nextRefresh
+			r.sharedSubnetPollState.lastSearch = tt.lastSearch
+
+			searched := false
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService), "ListChangedSubnetPaths",
+				func(_ *subnetservice.SubnetService, since time.Time) (sets.Set[string], error) {
+					searched = true
+					assert.Equal(t, tt.lastSearch.Add(-sharedSubnetSearchSkew), since)
+					if tt.searchErr != nil {
+						return nil, tt.searchErr
+					}
+					return sets.New(tt.changedPaths...), nil
+				})
+			defer patches.Reset()
+
+			due := r.getDueSharedSubnets(now)
+
+			assert.Equal(t, tt.expectedSearch, searched)
+			assert.ElementsMatch(t, tt.expectedDue, due.UnsortedList())
+			assert.ElementsMatch(t, tt.expectedScheduled, sets.KeySet(r.sharedSubnetPollState.nextRefresh).UnsortedList())
+			assert.Equal(t, tt.expectedLastCheck, r.sharedSubnetPollState.lastSearch)
+		})
+	}
+}
+
+func TestPollChangedSharedSubnets(t *testing.T) {
+	now := time.Now()
+	subnetCR := &v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnet-1", Namespace: "default"},
+	}
+	r := createFakeSubnetReconciler([]client.Object{subnetCR})
+	r.SubnetService.SharedSubnetResourceMap = map[string]sets.Set[types.NamespacedName]{
+		"project1:vpc1:subnet1": sets.New(types.NamespacedName{Namespace: "default", Name: "subnet-1"}),
+		"project1:vpc1:subnet2": sets.New(types.NamespacedName{Namespace: "default", Name: "subnet-2"}),
+	}
+	r.sharedSubnetPollState.nextRefresh = map[string]time.Time{
+		"project1:vpc1:subnet1": now.Add(-time.Second),
+		"project1:vpc1:subnet2": now.Add(time.Minute),
+	}
+
+	fetched := sets.New[string]()
+	enqueued := sets.New[types.NamespacedName]()
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "getDueSharedSubnets", func(_ *SubnetReconciler, _ time.Time) sets.Set[string] {
+		return sets.New("project1:vpc1:subnet1")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetNSXSubnetByAssociatedResource",
+		func(_ *subnetservice.SubnetService, associatedResource string) (*model.VpcSubnet, error) {
+			fetched.Insert(associatedResource)
+			return &model.VpcSubnet{Id: common.String("subnet1")}, nil
+		})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "GetSubnetStatus",
+		func(_ *subnetservice.SubnetService, _ *model.VpcSubnet) ([]model.VpcSubnetStatus, error) {
+			return []model.VpcSubnetStatus{}, nil
+		})
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "enqueueSubnetForReconciliation",
+		func(_ *SubnetReconciler, _ context.Context, namespacedName types.NamespacedName) {
+			enqueued.Insert(namespacedName)
+		})
+	defer patches.Reset()
+
+	r.pollChangedSharedSubnets(now)
+
+	assert.Equal(t, []string{"project1:vpc1:subnet1"}, fetched.UnsortedList())
+	assert.Equal(t, []types.NamespacedName{{Namespace: "default", Name: "subnet-1"}}, enqueued.UnsortedList())
+	// The refreshed shared Subnet is rescheduled with jitter
+	nextRefresh := r.sharedSubnetPollState.nextRefresh["project1:vpc1:subnet1"]
+	assert.True(t, nextRefresh.After(now.Add(defaultSharedSubnetPollInterval-time.Second)))
+	assert.True(t, nextRefresh.Before(time.Now().Add(time.Duration(float64(defaultSharedSubnetPollInterval)*(1+sharedSubnetRefreshJitterFactor)))))
+	_, ok := r.SubnetService.NSXSubnetCache["project1:vpc1:subnet1"]
+	assert.True(t, ok)
+}
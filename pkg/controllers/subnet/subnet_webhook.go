@@ -26,9 +26,10 @@ var NSXOperatorSA = "system:serviceaccount:vmware-system-nsx:ncp-svc-account"
 // +kubebuilder:webhook:path=/validate-crd-nsx-vmware-com-v1alpha1-subnet,mutating=false,failurePolicy=fail,sideEffects=None,groups=crd.nsx.vmware.com,resources=subnets,verbs=create;update;delete,versions=v1alpha1,name=subnet.validating.crd.nsx.vmware.com,admissionReviewVersions=v1
 
 type SubnetValidator struct {
-	Client    client.Client
-	decoder   admission.Decoder
-	nsxClient *nsx.Client
+	Client     client.Client
+	decoder    admission.Decoder
+	nsxClient  *nsx.Client
+	vpcService common.VPCServiceProvider
 }
 
 // Handle handles admission requests.
@@ -57,10 +58,12 @@ func (v *SubnetValidator) Handle(ctx context.Context, req admission.Request) adm
 			return admission.Denied(fmt.Sprintf("Shared Subnet %s/%s can only be created by NSX Operator", subnet.Namespace, subnet.Name))
 		}
 
-		// Prevent users from setting spec.vpcName and spec.vlanConnectionName
+		// Prevent users from setting spec.vlanConnectionName, and spec.vpcName other than an additional VPC of the Namespace
 		if req.UserInfo.Username != NSXOperatorSA {
 			if subnet.Spec.VPCName != "" {
-				return admission.Denied(fmt.Sprintf("Subnet %s/%s: spec.vpcName can only be set by NSX Operator", subnet.Namespace, subnet.Name))
+				if _, err := common.GetVPCInfoByName(v.vpcService, subnet.Namespace, subnet.Spec.VPCName); err != nil {
+					return admission.Denied(fmt.Sprintf("Subnet %s/%s: spec.vpcName is invalid: %v", subnet.Namespace, subnet.Name, err))
+				}
 			}
 			if subnet.Spec.VLANConnectionName != "" {
 				return admission.Denied(fmt.Sprintf("Subnet %s/%s: spec.vlanConnectionName can only be set by NSX Operator", subnet.Namespace, subnet.Name))
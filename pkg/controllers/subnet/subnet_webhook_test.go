@@ -10,6 +10,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,8 +20,10 @@ import (
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	controllercommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+	pkg_mock "github.com/vmware-tanzu/nsx-operator/pkg/mock"
 	mockClient "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 )
 
 func TestSubnetValidator_Handle(t *testing.T) {
@@ -35,10 +38,18 @@ func TestSubnetValidator_Handle(t *testing.T) {
 	nsxClient := &nsx.Client{}
 	cluster, _ := nsx.NewCluster(&nsx.Config{})
 	nsxClient.Cluster = cluster
+	vpcService := &pkg_mock.MockVPCServiceProvider{}
+	vpcService.On("ListVPCInfo", mock.Anything).Return([]common.VPCResourceInfo{{OrgID: "default", ProjectID: "project", VPCID: "vpc"}})
+	vpcService.On("GetVPCNetworkConfigByNamespace", mock.Anything).Return(&v1alpha1.VPCNetworkConfiguration{
+		Spec: v1alpha1.VPCNetworkConfigurationSpec{
+			AdditionalVPCs: []v1alpha1.AdditionalVPC{{Name: "data", VPC: "/orgs/default/projects/project/vpcs/data-vpc"}},
+		},
+	}, nil)
 	v := &SubnetValidator{
-		Client:    k8sClient,
-		decoder:   decoder,
-		nsxClient: nsxClient,
+		Client:     k8sClient,
+		decoder:    decoder,
+		nsxClient:  nsxClient,
+		vpcService: vpcService,
 	}
 
 	// Regular subnet
@@ -100,6 +111,18 @@ func TestSubnetValidator_Handle(t *testing.T) {
 		},
 	})
 
+	// Subnet with an additional VPC of the Namespace set in VPCName
+	subnetWithAdditionalVPC, _ := json.Marshal(&v1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-4",
+			Name:      "subnet-with-data-vpc",
+		},
+		Spec: v1alpha1.SubnetSpec{
+			IPv4SubnetSize: 16,
+			VPCName:        "data",
+		},
+	})
+
 	// Subnet with VLANConnectionName set
 	subnetWithVLANExt, _ := json.Marshal(&v1alpha1.Subnet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -367,7 +390,15 @@ func TestSubnetValidator_Handle(t *testing.T) {
 			operation:       admissionv1.Create,
 			object:          subnetWithVPCName,
 			user:            "non-nsx-operator",
-			want:            admission.Denied("Subnet ns-4/subnet-with-vpc: spec.vpcName can only be set by NSX Operator"),
+			want:            admission.Denied("Subnet ns-4/subnet-with-vpc: spec.vpcName is invalid: VPC vpc-1 is not associated with Namespace ns-4"),
+			accessModeCheck: true,
+		},
+		{
+			name:            "Create subnet with additional VPC by non-NSX Operator",
+			operation:       admissionv1.Create,
+			object:          subnetWithAdditionalVPC,
+			user:            "non-nsx-operator",
+			want:            admission.Allowed(""),
 			accessModeCheck: true,
 		},
 		{
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,6 +28,8 @@ import (
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -52,6 +55,22 @@ func (r *Reconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. No SubnetConnectionBindingMap CR is restored, only the NSX
+// SubnetConnectionBindingMaps of the deleted CRs are reported.
+func (r *Reconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	staleBindingMapIDs, err := r.listStaleBindingMapIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, bindingMap := range r.SubnetBindingService.ListSubnetConnectionBindingMapsByCRs(staleBindingMapIDs) {
+		if bindingMap.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *bindingMap.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *Reconciler) StartController(mgr ctrl.Manager, _ webhook.Server) error {
 	// Start the controller
 	if err := r.setupWithManager(mgr); err != nil {
@@ -128,6 +147,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return common.ResultNormal, nil
 }
 
+// listStaleBindingMapIDs returns the UIDs of the SubnetConnectionBindingMap CRs which have been deleted but still have
+// NSX SubnetConnectionBindingMaps.
+func (r *Reconciler) listStaleBindingMapIDs(ctx context.Context) (sets.Set[string], error) {
+	bindingMapIdSetByCRs, err := r.listBindingMapIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list SubnetConnectionBindingMap CRs")
+		return nil, err
+	}
+	bindingMapIdSetInStore := r.SubnetBindingService.ListSubnetConnectionBindingMapCRUIDsInStore()
+	return bindingMapIdSetInStore.Difference(bindingMapIdSetByCRs), nil
+}
+
 // CollectGarbage collects the stale SubnetConnectionBindingMaps and deletes them on NSX which have been removed from K8s.
 // It implements the interface GarbageCollector method.
 func (r *Reconciler) CollectGarbage(ctx context.Context) error {
@@ -136,14 +167,12 @@ func (r *Reconciler) CollectGarbage(ctx context.Context) error {
 		log.Info("SubnetConnectionBindingMap garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
 	}()
 
-	bindingMapIdSetByCRs, err := r.listBindingMapIDsFromCRs(ctx)
+	staleBindingMapIDs, err := r.listStaleBindingMapIDs(ctx)
 	if err != nil {
-		log.Error(err, "Failed to list SubnetConnectionBindingMap CRs")
 		return err
 	}
-	bindingMapIdSetInStore := r.SubnetBindingService.ListSubnetConnectionBindingMapCRUIDsInStore()
 
-	if err = r.SubnetBindingService.DeleteMultiSubnetConnectionBindingMapsByCRs(bindingMapIdSetInStore.Difference(bindingMapIdSetByCRs)); err != nil {
+	if err = r.SubnetBindingService.DeleteMultiSubnetConnectionBindingMapsByCRs(staleBindingMapIDs); err != nil {
 		log.Error(err, "Failed to delete stale SubnetConnectionBindingMaps")
 		return err
 	}
@@ -189,9 +218,25 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 				ResourceType:    "SubnetSet"},
 			builder.WithPredicates(PredicateFuncsForSubnetSets),
 		).
+		WatchesRawSource(common.NewStoreDriftSource(servicecommon.ResourceTypeSubnetConnectionBindingMap, bindingMapDriftMapFunc)).
 		Complete(r)
 }
 
+// bindingMapDriftMapFunc maps the drifted NSX SubnetConnectionBindingMap to the SubnetConnectionBindingMap CR it is
+// created for.
+func bindingMapDriftMapFunc(obj interface{}) client.Object {
+	bindingMap, ok := obj.(*model.SubnetConnectionBindingMap)
+	if !ok {
+		return nil
+	}
+	name := nsxutil.FindTag(bindingMap.Tags, servicecommon.TagScopeSubnetBindingCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(bindingMap.Tags, servicecommon.TagScopeNamespace)
+	return &v1alpha1.SubnetConnectionBindingMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 func (r *Reconciler) listBindingMapIDsFromCRs(ctx context.Context) (sets.Set[string], error) {
 	bmIDs := sets.New[string]()
 	connectionBindingMapList := &v1alpha1.SubnetConnectionBindingMapList{}
@@ -439,9 +484,9 @@ func (r *Reconciler) getSubnetConnectionBindingMapsByChildSubnet(ctx context.Con
 	return bmKeys, nil
 }
 
-func updateBindingMapStatusWithUnreadyCondition(c client.Client, ctx context.Context, obj client.Object, _ metav1.Time, _ error, args ...interface{}) {
+func updateBindingMapStatusWithUnreadyCondition(c client.Client, ctx context.Context, obj client.Object, _ metav1.Time, err error, args ...interface{}) {
 	bindingMap := obj.(*v1alpha1.SubnetConnectionBindingMap)
-	reason := args[0].(string)
+	reason := nsxutil.ErrorReason(err, args[0].(string))
 	msg := args[1].(string)
 	condition := v1alpha1.Condition{
 		Type:    v1alpha1.Ready,
@@ -32,6 +32,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 type fakeRecorder struct{}
@@ -273,6 +274,30 @@ func TestCollectGarbage(t *testing.T) {
 	}
 }
 
+func TestRestoreDryRun(t *testing.T) {
+	r := createFakeReconciler()
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(r), "listBindingMapIDsFromCRs", func(_ *Reconciler, ctx context.Context) (sets.Set[string], error) {
+		return sets.New[string]("uid1"), nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetBindingService), "ListSubnetConnectionBindingMapCRUIDsInStore", func(s *subnetbinding.BindingService) sets.Set[string] {
+		return sets.New[string]("uid1", "uid2")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetBindingService), "ListSubnetConnectionBindingMapsByCRs", func(s *subnetbinding.BindingService, bindingCRs sets.Set[string]) []*model.SubnetConnectionBindingMap {
+		assert.Equal(t, sets.New[string]("uid2"), bindingCRs)
+		return []*model.SubnetConnectionBindingMap{{Path: common.String("/subnets/subnet1/subnet-connection-binding-maps/bm2")}}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetBindingService), "DeleteMultiSubnetConnectionBindingMapsByCRs", func(s *subnetbinding.BindingService, bindingCRs sets.Set[string]) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, plan.CRsToRestore)
+	assert.Equal(t, []string{"/subnets/subnet1/subnet-connection-binding-maps/bm2"}, plan.NSXResourcesToDelete)
+}
+
 func TestValidateDependency(t *testing.T) {
 	name := "binding1"
 	namespace := "default"
@@ -852,6 +877,15 @@ func TestUpdateBindingMapStatusWithConditions(t *testing.T) {
 			assert.Equal(t, corev1.ConditionTrue, cond.Status)
 		})
 	}
+
+	// The reason code of the classified error is set to the condition.
+	fakeClient := fake.NewClientBuilder().WithScheme(newScheme).WithObjects(bindingMap1).WithStatusSubresource(bindingMap1).Build()
+	nsxErr := nsxutil.CreateResourceInUse()
+	updateBindingMapStatusWithUnreadyCondition(fakeClient, context.Background(), bindingMap1, metav1.Now(), nsxErr, "ConfigureFailed", msg)
+	updatedBM := &v1alpha1.SubnetConnectionBindingMap{}
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, updatedBM))
+	require.Equal(t, 1, len(updatedBM.Status.Conditions))
+	assert.Equal(t, nsxutil.ErrorReasonResourceInUse, updatedBM.Status.Conditions[0].Reason)
 }
 
 func TestUpdateBindingMapConditionWithRetry(t *testing.T) {
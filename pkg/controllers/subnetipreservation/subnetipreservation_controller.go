@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +25,8 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetipreservation"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -70,6 +73,38 @@ func (r *Reconciler) RestoreReconcile() error {
 	return nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner.
+func (r *Reconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	plan := &util.RestorePlan{}
+	// The SubnetIPReservation CRs are only restored if Subnet StaticIPReservation is supported
+	if r.IPReservationService.NSXClient.NSXCheckVersion(nsx.StaticIPReservation) {
+		restoreList, err := r.getRestoreList()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get SubnetIPReservation restore list: %w", err)
+		}
+		for _, key := range restoreList {
+			plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+		}
+	}
+	staleIPReservationIDs, err := r.listStaleIPReservationIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, uid := range sets.List(staleIPReservationIDs) {
+		for _, nsxIPReservation := range r.IPReservationService.DynamicIPReservationStore.GetByIndex(servicecommon.TagScopeSubnetIPReservationCRUID, uid) {
+			if nsxIPReservation.Path != nil {
+				plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxIPReservation.Path)
+			}
+		}
+		for _, nsxIPReservation := range r.IPReservationService.StaticIPReservationStore.GetByIndex(servicecommon.TagScopeSubnetIPReservationCRUID, uid) {
+			if nsxIPReservation.Path != nil {
+				plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxIPReservation.Path)
+			}
+		}
+	}
+	return plan, nil
+}
+
 func (r *Reconciler) getRestoreList() ([]types.NamespacedName, error) {
 	restoreList := []types.NamespacedName{}
 	subnetIPReservationList := &v1alpha1.SubnetIPReservationList{}
@@ -165,9 +200,31 @@ func (r *Reconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(PredicateFuncsForSubnets),
 		).
+		WatchesRawSource(common.NewStoreDriftSourceForTypes([]string{subnetipreservation.ResourceTypeDynamicSubnetIPReservation,
+			subnetipreservation.ResourceTypeStaticSubnetIPReservation}, ipReservationDriftMapFunc)).
 		Complete(r)
 }
 
+// ipReservationDriftMapFunc maps the drifted NSX dynamic or static IP reservation to the SubnetIPReservation CR it is
+// created for.
+func ipReservationDriftMapFunc(obj interface{}) client.Object {
+	var tags []model.Tag
+	switch o := obj.(type) {
+	case *model.DynamicIpAddressReservation:
+		tags = o.Tags
+	case *model.StaticIpAddressReservation:
+		tags = o.Tags
+	default:
+		return nil
+	}
+	name := nsxutil.FindTag(tags, servicecommon.TagScopeSubnetIPReservationCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(tags, servicecommon.TagScopeNamespace)
+	return &v1alpha1.SubnetIPReservation{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 func (r *Reconciler) setNotSupported(ctx context.Context, req ctrl.Request) error {
 	// Update CR status to inform users
 	ipReservationCR := &v1alpha1.SubnetIPReservation{}
@@ -322,7 +379,7 @@ func setReadyStatusFalse(client client.Client, ctx context.Context, obj client.O
 			Type:               v1alpha1.Ready,
 			Status:             v1.ConditionFalse,
 			Message:            "NSX SubnetIPReservation could not be created/updated",
-			Reason:             "SubnetIPReservationNotReady",
+			Reason:             nsxutil.ErrorReason(err, "SubnetIPReservationNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -377,6 +434,18 @@ func getExistingConditionOfType(conditionType v1alpha1.ConditionType, existingCo
 	return nil
 }
 
+// listStaleIPReservationIDs returns the UIDs of the SubnetIPReservation CRs which have been deleted but still have NSX
+// IPReservations.
+func (r *Reconciler) listStaleIPReservationIDs(ctx context.Context) (sets.Set[string], error) {
+	ipReservationIdSetByCRs, err := r.listIPReservationIDsFromCRs(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list SubnetIPReservation CRs")
+		return nil, err
+	}
+	ipReservationIdSetInStore := r.IPReservationService.ListSubnetIPReservationCRUIDsInStore()
+	return ipReservationIdSetInStore.Difference(ipReservationIdSetByCRs), nil
+}
+
 // CollectGarbage collects the stale SubnetIPReservations and deletes them on NSX which have been removed from K8s.
 // It implements the interface GarbageCollector method.
 func (r *Reconciler) CollectGarbage(ctx context.Context) error {
@@ -385,15 +454,13 @@ func (r *Reconciler) CollectGarbage(ctx context.Context) error {
 		log.Info("SubnetIPReservation garbage collection completed", "duration(ms)", time.Since(startTime).Milliseconds())
 	}()
 
-	ipReservationIdSetByCRs, err := r.listIPReservationIDsFromCRs(ctx)
+	staleIPReservationIDs, err := r.listStaleIPReservationIDs(ctx)
 	if err != nil {
-		log.Error(err, "Failed to list SubnetIPReservation CRs")
 		return err
 	}
-	ipReservationIdSetInStore := r.IPReservationService.ListSubnetIPReservationCRUIDsInStore()
 
 	var errList []error
-	for uid := range ipReservationIdSetInStore.Difference(ipReservationIdSetByCRs) {
+	for uid := range staleIPReservationIDs {
 		log.Trace("GC collected SubnetIPReservation CR", "UID", uid)
 		r.StatusUpdater.IncreaseDeleteTotal()
 		err = r.IPReservationService.DeleteIPReservationByCRId(uid)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -508,6 +509,40 @@ func TestReconcile_validateSubnet(t *testing.T) {
 	}
 }
 
+func TestReconciler_RestoreDryRun(t *testing.T) {
+	iprWithStatus := &v1alpha1.SubnetIPReservation{
+		ObjectMeta: metav1.ObjectMeta{Name: "ipr-r", Namespace: "ns-1", UID: "ipr-r-uid"},
+		Spec:       v1alpha1.SubnetIPReservationSpec{Subnet: "subnet-1", NumberOfIPs: 2},
+		Status:     v1alpha1.SubnetIPReservationStatus{IPs: []string{"10.0.0.1"}},
+	}
+	r := createFakeReconciler(iprWithStatus)
+	// Store has different IPs so CR is in restore list
+	r.IPReservationService.StaticIPReservationStore.Apply(&model.StaticIpAddressReservation{
+		Id:          servicecommon.String("sid"),
+		Path:        servicecommon.String("/subnets/subnet-1/static-ip-reservations/sid"),
+		ReservedIps: []string{"10.0.0.99"},
+		Tags:        []model.Tag{{Scope: servicecommon.String(servicecommon.TagScopeSubnetIPReservationCRUID), Tag: servicecommon.String("ipr-r-uid")}},
+	})
+	r.IPReservationService.DynamicIPReservationStore.Apply(&model.DynamicIpAddressReservation{
+		Id:   servicecommon.String("did"),
+		Path: servicecommon.String("/subnets/subnet-1/dynamic-ip-reservations/did"),
+		Tags: []model.Tag{{Scope: servicecommon.String(servicecommon.TagScopeSubnetIPReservationCRUID), Tag: servicecommon.String("ipr-stale-uid")}},
+	})
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.IPReservationService.NSXClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+		return true
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(r.IPReservationService), "DeleteIPReservationByCRId", func(_ *subnetipreservation.IPReservationService, _ string) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/ipr-r"}, plan.CRsToRestore)
+	assert.Equal(t, []string{"/subnets/subnet-1/dynamic-ip-reservations/did"}, plan.NSXResourcesToDelete)
+}
+
 func TestReconcile_CollectGarbage(t *testing.T) {
 	ipr1 := &v1alpha1.SubnetIPReservation{
 		ObjectMeta: metav1.ObjectMeta{
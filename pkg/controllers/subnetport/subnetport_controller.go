@@ -43,6 +43,7 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetport"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
@@ -115,6 +116,12 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			return common.ResultNormal, err
 		}
+		if nsxutil.IsRealizationPendingError(err) {
+			// The SubnetPort CR is requeued when the Subnet created for the SubnetSet is realized, or after the
+			// realization timeout if the realization event is dropped.
+			log.Info("Waiting for the Subnet of the SubnetSet to be realized", "SubnetPort", req.NamespacedName)
+			return common.ResultRequeueAfterRealization, nil
+		}
 		if err != nil {
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "Failed to get NSX resource path from Subnet", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			return common.ResultRequeue, err
@@ -171,12 +178,30 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "Failed to create NSX IPAddressAllocation for AddressBinding restore", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			return common.ResultRequeue, err
 		}
+		isMigrating := !r.restoreMode && isSubnetPortMigrating(subnetPort)
+		if isMigrating {
+			log.Info("Migrating SubnetPort", "SubnetPort", req.NamespacedName, "realizedParent", subnetPort.Status.RealizedParent, "nsxSubnetPath", nsxSubnetPath)
+			setSubnetPortMigratedStatus(r.Client, ctx, subnetPort, metav1.Now(), v1.ConditionFalse, "SubnetPortMigrating", fmt.Sprintf("SubnetPort is migrating to Subnet %s", nsxSubnetPath))
+		}
 		nsxSubnetPortState, err := r.SubnetPortService.CreateOrUpdateSubnetPort(subnetPort, nsxSubnet, "", labels, isVmSubnetPort, r.restoreMode, interfaceIPType)
+		if nsxutil.IsRealizationPendingError(err) {
+			// The SubnetPort CR is requeued by the realization watcher, or after the realization timeout if the
+			// realization event is dropped.
+			return common.ResultRequeueAfterRealization, nil
+		}
 		if err != nil {
+			if isMigrating {
+				setSubnetPortMigratedStatus(r.Client, ctx, subnetPort, metav1.Now(), v1.ConditionFalse, "SubnetPortMigrationFailed", fmt.Sprintf("error occurred while migrating the SubnetPort to Subnet %s. Error: %v", nsxSubnetPath, err))
+			}
 			r.StatusUpdater.UpdateFail(ctx, subnetPort, err, "", setSubnetPortReadyStatusFalse, r.SubnetPortService, r.restoreMode)
 			if nsxutil.IsRealizeStateError(err) {
 				return common.ResultRequeueAfter60sec, nil
 			}
+			// No need to retry until the segment profiles are fixed by the user.
+			var validationErr *nsxutil.ValidationError
+			if errors.As(err, &validationErr) {
+				return common.ResultNormal, nil
+			}
 			return common.ResultRequeue, err
 		}
 		if nsxSubnetPortState != nil {
@@ -252,6 +277,10 @@ func (r *SubnetPortReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				log.Error(err, "Failed to retrieve Subnet status for SubnetPort", "SubnetPort", subnetPort, "nsxSubnetPath", nsxSubnetPath)
 			}
 		}
+		subnetPort.Status.RealizedParent = &v1alpha1.SubnetPortParent{Subnet: subnetPort.Spec.Subnet, SubnetSet: subnetPort.Spec.SubnetSet}
+		if isMigrating {
+			setSubnetPortMigratedStatus(r.Client, ctx, subnetPort, metav1.Now(), v1.ConditionTrue, "SubnetPortMigrated", fmt.Sprintf("SubnetPort has been migrated to Subnet %s", nsxSubnetPath))
+		}
 		if reflect.DeepEqual(*old_status, subnetPort.Status) {
 			log.Info("Status (without conditions) already matched", "new status", subnetPort.Status, "existing status", old_status)
 		} else {
@@ -481,10 +510,35 @@ func (r *SubnetPortReconciler) setupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.vmMapFunc),
 			builder.WithPredicates(predicate.LabelChangedPredicate{})).
 		Watches(&v1alpha1.AddressBinding{},
-				handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
+			handler.EnqueueRequestsFromMapFunc(r.addressBindingMapFunc)).
+		WatchesRawSource(common.NewStoreDriftSource(servicecommon.ResourceTypeSubnetPort, subnetPortDriftMapFunc)).
+		WatchesRawSource(common.NewRealizationSource(servicecommon.ResourceTypeSubnetPort, func() client.Object {
+			return &v1alpha1.SubnetPort{}
+		})).
+		WatchesRawSource(common.NewRealizationSourceWithMapFunc(realizestate.RealizationKindSubnetSet, func() client.Object {
+			return &v1alpha1.SubnetSet{}
+		}, r.subnetSetRealizationMapFunc)).
 		Complete(r) // TODO: watch the virtualmachine event and update the labels on NSX subnet port.
 }
 
+// subnetPortDriftMapFunc maps the drifted NSX SubnetPort to the SubnetPort CR it is created for, the NSX SubnetPorts
+// created for Pods are skipped.
+func subnetPortDriftMapFunc(obj interface{}) client.Object {
+	nsxSubnetPort, ok := obj.(*model.VpcSubnetPort)
+	if !ok {
+		return nil
+	}
+	name := nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopeSubnetPortCRName)
+	if name == "" {
+		return nil
+	}
+	namespace := nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopeNamespace)
+	if namespace == "" {
+		namespace = nsxutil.FindTag(nsxSubnetPort.Tags, servicecommon.TagScopeVMNamespace)
+	}
+	return &v1alpha1.SubnetPort{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
 func (r *SubnetPortReconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(context.TODO(), &v1alpha1.SubnetPort{}, util.SubnetPortNamespaceVMIndexKey, subnetPortNamespaceVMIndexFunc); err != nil {
 		return err
@@ -507,6 +561,26 @@ func (r *SubnetPortReconciler) SetupFieldIndexers(mgr ctrl.Manager) error {
 	return nil
 }
 
+// subnetSetRealizationMapFunc maps the SubnetSet whose Subnet is realized to the SubnetPort CRs waiting for it, they
+// are the SubnetPort CRs on the SubnetSet or on the default SubnetSet without an attachment.
+func (r *SubnetPortReconciler) subnetSetRealizationMapFunc(ctx context.Context, subnetSet client.Object) []reconcile.Request {
+	subnetPortList := &v1alpha1.SubnetPortList{}
+	if err := r.Client.List(ctx, subnetPortList, client.InNamespace(subnetSet.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list SubnetPort CRs waiting for SubnetSet", "SubnetSet", client.ObjectKeyFromObject(subnetSet))
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, subnetPort := range subnetPortList.Items {
+		if subnetPort.Status.Attachment.ID != "" || subnetPort.Spec.Subnet != "" {
+			continue
+		}
+		if subnetPort.Spec.SubnetSet == "" || subnetPort.Spec.SubnetSet == subnetSet.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: subnetPort.Namespace, Name: subnetPort.Name}})
+		}
+	}
+	return requests
+}
+
 func (r *SubnetPortReconciler) vmMapFunc(_ context.Context, vm client.Object) []reconcile.Request {
 	subnetPortList := &v1alpha1.SubnetPortList{}
 	var requests []reconcile.Request
@@ -621,6 +695,43 @@ func (r *SubnetPortReconciler) getRestoreList() ([]types.NamespacedName, error)
 	return restoreList, nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. The QoS profiles only bound to the stale SubnetPorts are
+// not reported, as the stale SubnetPorts are still in the store.
+func (r *SubnetPortReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SubnetPort restore list: %w", err)
+	}
+	diffSet, err := r.listStaleSubnetPortIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	staleIPAddressAllocations, err := r.listStaleIPAddressAllocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	for _, id := range sets.List(diffSet) {
+		if nsxSubnetPort := r.SubnetPortService.SubnetPortStore.GetByKey(id); nsxSubnetPort != nil && nsxSubnetPort.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxSubnetPort.Path)
+		}
+	}
+	for _, nsxIPAddressAllocation := range staleIPAddressAllocations {
+		if nsxIPAddressAllocation.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxIPAddressAllocation.Path)
+		}
+	}
+	for _, profile := range r.SubnetPortService.ListUnusedQoSProfiles() {
+		if profile.Path != nil {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *profile.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *SubnetPortReconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	if err := r.Start(mgr); err != nil {
 		log.Error(err, "failed to create controller", "controller", "SubnetPort")
@@ -686,22 +797,54 @@ func (r *SubnetPortReconciler) pollVIFsPeriodically(stopCh chan bool) {
 
 }
 
-// CollectGarbage collect SubnetPort which has been removed from crd.
-// it implements the interface GarbageCollector method.
-func (r *SubnetPortReconciler) CollectGarbage(ctx context.Context) error {
-	log.Info("subnetport garbage collector started")
+// listStaleSubnetPortIDs returns the IDs of the NSX SubnetPorts whose SubnetPort CRs have been deleted.
+func (r *SubnetPortReconciler) listStaleSubnetPortIDs(ctx context.Context) (sets.Set[string], error) {
 	nsxSubnetPortSet := r.SubnetPortService.ListNSXSubnetPortIDForCR()
 	if len(nsxSubnetPortSet) == 0 {
 		log.Trace("There is no SubnetPort in store")
 	}
 
 	crSubnetPortIDsSet, err := r.SubnetPortService.ListSubnetPortIDsFromCRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nsxSubnetPortSet.Difference(crSubnetPortIDsSet), nil
+}
+
+// listStaleIPAddressAllocations returns the NSX IPAddressAllocations whose AddressBinding CRs or SubnetPort CRs have
+// been deleted.
+func (r *SubnetPortReconciler) listStaleIPAddressAllocations(ctx context.Context) ([]*model.VpcIpAddressAllocation, error) {
+	addressBindingUIDSet, err := r.getAddressBindingCRUIDSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subnetPortUIDSet, err := r.getSubnetPortCRUIDSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var staleIPAddressAllocations []*model.VpcIpAddressAllocation
+	for _, nsxIPAddressAllocation := range r.IpAddressAllocationService.ListIPAddressAllocationWithAddressBinding() {
+		abUID := nsxutil.FindTag(nsxIPAddressAllocation.Tags, servicecommon.TagScopeAddressBindingCRUID)
+		spUID := nsxutil.FindTag(nsxIPAddressAllocation.Tags, servicecommon.TagScopeSubnetPortCRUID)
+		if addressBindingUIDSet.Has(abUID) && subnetPortUIDSet.Has(spUID) {
+			continue
+		}
+		staleIPAddressAllocations = append(staleIPAddressAllocations, nsxIPAddressAllocation)
+	}
+	return staleIPAddressAllocations, nil
+}
+
+// CollectGarbage collect SubnetPort which has been removed from crd.
+// it implements the interface GarbageCollector method.
+func (r *SubnetPortReconciler) CollectGarbage(ctx context.Context) error {
+	log.Info("subnetport garbage collector started")
+	diffSet, err := r.listStaleSubnetPortIDs(ctx)
 	if err != nil {
 		return err
 	}
 
 	var errList []error
-	diffSet := nsxSubnetPortSet.Difference(crSubnetPortIDsSet)
 	for elem := range diffSet {
 		log.Debug("GC collected SubnetPort CR", "UID", elem)
 		r.StatusUpdater.IncreaseDeleteTotal()
@@ -713,30 +856,24 @@ func (r *SubnetPortReconciler) CollectGarbage(ctx context.Context) error {
 			r.StatusUpdater.IncreaseDeleteSuccessTotal()
 		}
 	}
-	addressBindingUIDSet, err := r.getAddressBindingCRUIDSet(ctx)
-	if err != nil {
-		return err
-	}
-	subnetPortUIDSet, err := r.getSubnetPortCRUIDSet(ctx)
+	staleIPAddressAllocations, err := r.listStaleIPAddressAllocations(ctx)
 	if err != nil {
 		return err
 	}
-
-	nsxIPAddressAllocationList := r.IpAddressAllocationService.ListIPAddressAllocationWithAddressBinding()
-	for _, nsxIPAddressAllocation := range nsxIPAddressAllocationList {
-		abUID := nsxutil.FindTag(nsxIPAddressAllocation.Tags, servicecommon.TagScopeAddressBindingCRUID)
-		spUID := nsxutil.FindTag(nsxIPAddressAllocation.Tags, servicecommon.TagScopeSubnetPortCRUID)
-		if addressBindingUIDSet.Has(abUID) && subnetPortUIDSet.Has(spUID) {
-			continue
-		}
+	for _, nsxIPAddressAllocation := range staleIPAddressAllocations {
 		// Reclaim the NSX IPAddressAllocation if its AddressBinding CR or SubnetPort CR is removed.
-		log.Info("GC collected NSX IPAddressAllocation", "nsxIPAddressAllocation", nsxIPAddressAllocation, "AddressBinding.UID", abUID, "SubnetPort.UID", spUID)
+		log.Info("GC collected NSX IPAddressAllocation", "nsxIPAddressAllocation", nsxIPAddressAllocation)
 		err := r.IpAddressAllocationService.DeleteIPAddressAllocationByNSXResource(nsxIPAddressAllocation)
 		if err != nil {
 			errList = append(errList, err)
 		}
 	}
 
+	// The QoS profiles are shared by the SubnetPorts of both SubnetPort CRs and Pods with the same bandwidth limits.
+	if err := r.SubnetPortService.GarbageCollectQoSProfiles(); err != nil {
+		errList = append(errList, err)
+	}
+
 	r.collectAddressBindingGarbage(ctx, nil, nil)
 	if len(errList) > 0 {
 		return fmt.Errorf("errors found in SubnetPort garbage collection: %s", errList)
@@ -785,7 +922,7 @@ func setSubnetPortReadyStatusFalse(client client.Client, ctx context.Context, ob
 				"error occurred while processing the SubnetPort CR. Error: %v",
 				err,
 			),
-			Reason:             "SubnetPortNotReady",
+			Reason:             nsxutil.ErrorReason(err, "SubnetPortNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -797,6 +934,29 @@ func setSubnetPortReadyStatusFalse(client client.Client, ctx context.Context, ob
 	setAddressBindingStatusBySubnetPort(client, ctx, subnetPort, subnetPortService, transitionTime, subnetPortRealizationError)
 }
 
+// setSubnetPortMigratedStatus updates the Migrated condition which tracks the migration of the SubnetPort to a new parent.
+func setSubnetPortMigratedStatus(client client.Client, ctx context.Context, subnetPort *v1alpha1.SubnetPort, transitionTime metav1.Time, status v1.ConditionStatus, reason string, message string) {
+	newConditions := []v1alpha1.Condition{
+		{
+			Type:               v1alpha1.Migrated,
+			Status:             status,
+			Message:            message,
+			Reason:             reason,
+			LastTransitionTime: transitionTime,
+		},
+	}
+	updateSubnetPortStatusConditions(client, ctx, subnetPort, newConditions)
+}
+
+// isSubnetPortMigrating returns true if the parent Subnet or SubnetSet in the spec is changed after the SubnetPort is realized.
+func isSubnetPortMigrating(subnetPort *v1alpha1.SubnetPort) bool {
+	realizedParent := subnetPort.Status.RealizedParent
+	if realizedParent == nil {
+		return false
+	}
+	return realizedParent.Subnet != subnetPort.Spec.Subnet || realizedParent.SubnetSet != subnetPort.Spec.SubnetSet
+}
+
 func updateSubnetPortStatusConditions(client client.Client, ctx context.Context, subnetPort *v1alpha1.SubnetPort, newConditions []v1alpha1.Condition) {
 	retry.OnError(util.K8sClientRetry, func(err error) bool {
 		log.Error(err, "Failed to update SubnetPort Status, will retry", "Namespace", subnetPort.Namespace, "SubnetPort", subnetPort.Name)
@@ -815,6 +975,7 @@ func updateSubnetPortStatusConditions(client client.Client, ctx context.Context,
 		if conditionsUpdated {
 			latestSubnetPort.Status.Attachment = subnetPort.Status.Attachment
 			latestSubnetPort.Status.NetworkInterfaceConfig = subnetPort.Status.NetworkInterfaceConfig
+			latestSubnetPort.Status.RealizedParent = subnetPort.Status.RealizedParent
 			return client.Status().Update(ctx, latestSubnetPort)
 		}
 		return nil
@@ -909,7 +1070,7 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 		log.Error(err, "failed to use the SubnetPort CR to search VpcSubnetPort", "CR UID", subnetPort.GetUID())
 		return false, false, "", nil, nil, "", err
 	}
-	if existingSubnetPort != nil && existingSubnetPort.ParentPath != nil && len(*existingSubnetPort.ParentPath) > 0 {
+	if existingSubnetPort != nil && existingSubnetPort.ParentPath != nil && len(*existingSubnetPort.ParentPath) > 0 && (r.restoreMode || !isSubnetPortMigrating(subnetPort)) {
 		subnetPath = *existingSubnetPort.ParentPath
 		// If there is a SubnetPath in store, there is a subnetport in NSX, the subnetport is not created first time.
 		log.Debug("NSX SubnetPort had been created, returning the existing NSX Subnet path", "subnetPort.UID", subnetPort.UID, "subnetPath", subnetPath)
@@ -939,6 +1100,9 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 		if err != nil {
 			return
 		}
+		if err = r.checkSubnetPortVPC(subnetPort, strings.Split(*nsxSubnet.Path, "/subnets/")[0]); err != nil {
+			return
+		}
 		var canAllocate bool
 		interfaceType = subnetport.GetDefaultInterfaceIPType(subnetPort.Spec.InterfaceIPType, subnetCR.Spec.IPAddressType)
 		canAllocate, err = r.SubnetPortService.AllocatePortFromSubnet(nsxSubnet, servicecommon.IsSharedSubnet(subnetCR), interfaceType)
@@ -946,7 +1110,7 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 			return
 		}
 		if !canAllocate {
-			err = fmt.Errorf("Subnet %s is exhausted", *nsxSubnet.Id)
+			err = nsxutil.SubnetExhaustedError{Desc: fmt.Sprintf("Subnet %s is exhausted", *nsxSubnet.Id)}
 			return
 		}
 		subnetPath = *nsxSubnet.Path
@@ -969,6 +1133,9 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 			err = fmt.Errorf("Waiting for SubnetSet %s/%s IPAddressType calculation", subnetSet.Namespace, subnetSet.Name)
 			return
 		}
+		if err = r.checkSubnetPortVPCBySubnetSet(subnetPort, subnetSet); err != nil {
+			return
+		}
 		interfaceType = subnetport.GetDefaultInterfaceIPType(subnetPort.Spec.InterfaceIPType, subnetSet.Spec.IPAddressType)
 		log.Info("Got SubnetSet for SubnetPort CR, allocating the NSX subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.UID", subnetSet.UID, "subnetPort.Name", subnetPort.Name, "subnetPort.UID", subnetPort.UID)
 		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
@@ -991,6 +1158,9 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 			err = fmt.Errorf("Waiting for SubnetSet %s/%s IPAddressType calculation", subnetSet.Namespace, subnetSet.Name)
 			return
 		}
+		if err = r.checkSubnetPortVPCBySubnetSet(subnetPort, subnetSet); err != nil {
+			return
+		}
 		log.Info("Got default SubnetSet for SubnetPort CR, allocating the NSX Subnet", "subnetSet.Name", subnetSet.Name, "subnetSet.UID", subnetSet.UID, "subnetPort.Name", subnetPort.Name, "subnetPort.UID", subnetPort.UID)
 		interfaceType = subnetport.GetDefaultInterfaceIPType(subnetPort.Spec.InterfaceIPType, subnetSet.Spec.IPAddressType)
 		subnetPath, subnetSetUID, subnetSetLock, err = common.AllocateSubnetFromSubnetSet(r.Client, r.APIReader, subnetSet, r.VPCService, r.SubnetService, r.SubnetPortService, interfaceType)
@@ -1002,6 +1172,49 @@ func (r *SubnetPortReconciler) CheckAndGetSubnetPathForSubnetPort(ctx context.Co
 	return
 }
 
+// checkSubnetPortVPC checks that the parent VPC of the SubnetPort is the one selected by spec.vpcName.
+func (r *SubnetPortReconciler) checkSubnetPortVPC(subnetPort *v1alpha1.SubnetPort, parentVPCPath string) error {
+	if subnetPort.Spec.VPCName == "" {
+		return nil
+	}
+	vpcInfo, err := servicecommon.GetVPCInfoByName(r.VPCService, subnetPort.Namespace, subnetPort.Spec.VPCName)
+	if err != nil {
+		return err
+	}
+	if vpcPath := vpcInfo.GetVPCPath(); vpcPath != parentVPCPath {
+		return fmt.Errorf("SubnetPort %s/%s selects VPC %s, but its parent Subnet is in VPC %s", subnetPort.Namespace, subnetPort.Name, vpcPath, parentVPCPath)
+	}
+	return nil
+}
+
+// checkSubnetPortVPCBySubnetSet checks the VPC of the SubnetPort against the VPC of the SubnetSet.
+// The VPC of a pre-created SubnetSet is resolved from the NSX Subnets of its listed Subnets.
+func (r *SubnetPortReconciler) checkSubnetPortVPCBySubnetSet(subnetPort *v1alpha1.SubnetPort, subnetSet *v1alpha1.SubnetSet) error {
+	if subnetPort.Spec.VPCName == "" {
+		return nil
+	}
+	if subnetSet.Spec.SubnetNames != nil {
+		nsxSubnets, err := common.GetNSXSubnetsForSubnetSet(r.Client, subnetSet, r.SubnetService)
+		if err != nil {
+			return err
+		}
+		for _, nsxSubnet := range nsxSubnets {
+			if nsxSubnet.Path == nil {
+				continue
+			}
+			if err = r.checkSubnetPortVPC(subnetPort, strings.Split(*nsxSubnet.Path, "/subnets/")[0]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	vpcInfo, err := servicecommon.GetVPCInfoByName(r.VPCService, subnetSet.Namespace, subnetSet.Spec.VPCName)
+	if err != nil {
+		return err
+	}
+	return r.checkSubnetPortVPC(subnetPort, vpcInfo.GetVPCPath())
+}
+
 func (r *SubnetPortReconciler) updateSubnetStatusOnSubnetPort(subnetPort *v1alpha1.SubnetPort, nsxSubnet *model.VpcSubnet) error {
 	subnetPort.Status.NetworkInterfaceConfig.LogicalSwitchUUID = *nsxSubnet.RealizationId
 	// Get all gateways from the subnet (may be IPv4, IPv6, or both for dual-stack)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
@@ -966,6 +967,42 @@ func TestSubnetPortReconciler_GarbageCollector(t *testing.T) {
 	r.CollectGarbage(context.Background())
 }
 
+func TestSubnetPortReconciler_RestoreDryRun(t *testing.T) {
+	r := &SubnetPortReconciler{
+		SubnetPortService: &subnetport.SubnetPortService{SubnetPortStore: &subnetport.SubnetPortStore{}},
+	}
+	patches := gomonkey.ApplyPrivateMethod(r, "getRestoreList", func(_ *SubnetPortReconciler) ([]types.NamespacedName, error) {
+		return []types.NamespacedName{{Namespace: "ns-1", Name: "port-1"}}, nil
+	})
+	patches.ApplyPrivateMethod(r, "listStaleSubnetPortIDs", func(_ *SubnetPortReconciler, _ context.Context) (sets.Set[string], error) {
+		return sets.New[string]("port-stale"), nil
+	})
+	patches.ApplyPrivateMethod(r, "listStaleIPAddressAllocations", func(_ *SubnetPortReconciler, _ context.Context) ([]*model.VpcIpAddressAllocation, error) {
+		return []*model.VpcIpAddressAllocation{{Path: servicecommon.String("/vpcs/vpc-1/ip-address-allocations/ab-stale")}}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService.SubnetPortStore), "GetByKey", func(_ *subnetport.SubnetPortStore, key string) *model.VpcSubnetPort {
+		assert.Equal(t, "port-stale", key)
+		return &model.VpcSubnetPort{Id: servicecommon.String(key), Path: servicecommon.String("/vpcs/vpc-1/subnets/subnet-1/ports/port-stale")}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "ListUnusedQoSProfiles", func(_ *subnetport.SubnetPortService) []*model.QosProfile {
+		return []*model.QosProfile{{Path: servicecommon.String("/infra/qos-profiles/unused")}}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "DeleteSubnetPortById", func(_ *subnetport.SubnetPortService, _ string) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	defer patches.Reset()
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/port-1"}, plan.CRsToRestore)
+	assert.Equal(t, []string{
+		"/vpcs/vpc-1/subnets/subnet-1/ports/port-stale",
+		"/vpcs/vpc-1/ip-address-allocations/ab-stale",
+		"/infra/qos-profiles/unused",
+	}, plan.NSXResourcesToDelete)
+}
+
 func TestSubnetPortReconciler_subnetPortNamespaceVMIndexFunc(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1480,6 +1517,53 @@ func TestSubnetPortReconciler_CheckAndGetSubnetPathForSubnetPort(t *testing.T) {
 			},
 			expectedIPType: v1alpha1.IPAddressTypeIPv4,
 		},
+		{
+			name: "MigrateToSpecificSubnet",
+			prepareFunc: func(t *testing.T, spr *SubnetPortReconciler) *gomonkey.Patches {
+				patches := gomonkey.ApplyFunc((*subnetport.SubnetPortStore).GetVpcSubnetPortByUID,
+					func(s *subnetport.SubnetPortStore, uid types.UID) (*model.VpcSubnetPort, error) {
+						return &model.VpcSubnetPort{
+							Id:         servicecommon.String("port1"),
+							ParentPath: servicecommon.String("subnet-path-old"),
+						}, nil
+					})
+				patches.ApplyFunc((*SubnetPortReconciler).getSubnetCR,
+					func(r *SubnetPortReconciler, ctx context.Context, subnetPort *v1alpha1.SubnetPort) (*v1alpha1.Subnet, bool, error) {
+						return &v1alpha1.Subnet{
+							Spec: v1alpha1.SubnetSpec{
+								IPAddressType: v1alpha1.IPAddressTypeIPv4,
+							},
+						}, false, nil
+					})
+				patches.ApplyFunc((*subnet.SubnetService).GetSubnetsByIndex,
+					func(s *subnet.SubnetService, key string, value string) []*model.VpcSubnet {
+						return []*model.VpcSubnet{{
+							Path:           servicecommon.String("subnet-path-1"),
+							Ipv4SubnetSize: servicecommon.Int64(16),
+							Id:             servicecommon.String("subnet-1"),
+						}}
+					})
+				patches.ApplyFunc((*subnetport.SubnetPortService).AllocatePortFromSubnet,
+					func(s *subnetport.SubnetPortService, nsxSubnet *model.VpcSubnet, sharedSubnet bool, interfaceType v1alpha1.IPAddressType) (bool, error) {
+						return true, nil
+					})
+				return patches
+			},
+			expectedSubnetPath: "subnet-path-1",
+			subnetport: &v1alpha1.SubnetPort{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "subnetport-1",
+					Namespace: "ns-1",
+				},
+				Spec: v1alpha1.SubnetPortSpec{
+					Subnet: "subnet-1",
+				},
+				Status: v1alpha1.SubnetPortStatus{
+					RealizedParent: &v1alpha1.SubnetPortParent{Subnet: "subnet-old"},
+				},
+			},
+			expectedIPType: v1alpha1.IPAddressTypeIPv4,
+		},
 		{
 			name: "SpecificSubnetSetNotExisted",
 			prepareFunc: func(t *testing.T, spr *SubnetPortReconciler) *gomonkey.Patches {
@@ -1731,6 +1815,53 @@ func TestSubnetPortReconciler_CheckAndGetSubnetPathForSubnetPort(t *testing.T) {
 	}
 }
 
+func TestIsSubnetPortMigrating(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           v1alpha1.SubnetPortSpec
+		realizedParent *v1alpha1.SubnetPortParent
+		expected       bool
+	}{
+		{
+			name:     "NotRealized",
+			spec:     v1alpha1.SubnetPortSpec{Subnet: "subnet-1"},
+			expected: false,
+		},
+		{
+			name:           "SameSubnet",
+			spec:           v1alpha1.SubnetPortSpec{Subnet: "subnet-1"},
+			realizedParent: &v1alpha1.SubnetPortParent{Subnet: "subnet-1"},
+			expected:       false,
+		},
+		{
+			name:           "DefaultSubnetSet",
+			realizedParent: &v1alpha1.SubnetPortParent{},
+			expected:       false,
+		},
+		{
+			name:           "SubnetChanged",
+			spec:           v1alpha1.SubnetPortSpec{Subnet: "subnet-2"},
+			realizedParent: &v1alpha1.SubnetPortParent{Subnet: "subnet-1"},
+			expected:       true,
+		},
+		{
+			name:           "SubnetToSubnetSet",
+			spec:           v1alpha1.SubnetPortSpec{SubnetSet: "subnetset-1"},
+			realizedParent: &v1alpha1.SubnetPortParent{Subnet: "subnet-1"},
+			expected:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnetPort := &v1alpha1.SubnetPort{
+				Spec:   tt.spec,
+				Status: v1alpha1.SubnetPortStatus{RealizedParent: tt.realizedParent},
+			}
+			assert.Equal(t, tt.expected, isSubnetPortMigrating(subnetPort))
+		})
+	}
+}
+
 func TestSubnetPortReconciler_updateSubnetStatusOnSubnetPort(t *testing.T) {
 	r := &SubnetPortReconciler{
 		SubnetPortService: &subnetport.SubnetPortService{},
@@ -3066,3 +3197,73 @@ func TestSubnetPortReconciler_UpdateSubnetPortIPType(t *testing.T) {
 		assert.Equal(t, v1alpha1.StaticIPAllocationTypeNone, subnetPort.Spec.StaticIPAllocationType)
 	})
 }
+
+func TestSubnetPortReconciler_checkSubnetPortVPC(t *testing.T) {
+	vpcService := &mock.MockVPCServiceProvider{}
+	vpcService.On("ListVPCInfo", "ns-1").Return([]servicecommon.VPCResourceInfo{{OrgID: "default", ProjectID: "project-1", VPCID: "vpc-1"}})
+	vpcService.On("GetVPCNetworkConfigByNamespace", "ns-1").Return(&v1alpha1.VPCNetworkConfiguration{
+		Spec: v1alpha1.VPCNetworkConfigurationSpec{
+			AdditionalVPCs: []v1alpha1.AdditionalVPC{{Name: "data", VPC: "/orgs/default/projects/project-1/vpcs/data-vpc"}},
+		},
+	}, nil)
+	r := &SubnetPortReconciler{VPCService: vpcService}
+	newSubnetPort := func(vpcName string) *v1alpha1.SubnetPort {
+		return &v1alpha1.SubnetPort{
+			ObjectMeta: metav1.ObjectMeta{Name: "port-1", Namespace: "ns-1"},
+			Spec:       v1alpha1.SubnetPortSpec{VPCName: vpcName},
+		}
+	}
+
+	// SubnetPort without vpcName is not checked.
+	assert.NoError(t, r.checkSubnetPortVPC(newSubnetPort(""), "/orgs/default/projects/project-1/vpcs/vpc-1"))
+	assert.NoError(t, r.checkSubnetPortVPC(newSubnetPort("data"), "/orgs/default/projects/project-1/vpcs/data-vpc"))
+	assert.ErrorContains(t, r.checkSubnetPortVPC(newSubnetPort("data"), "/orgs/default/projects/project-1/vpcs/vpc-1"),
+		"SubnetPort ns-1/port-1 selects VPC /orgs/default/projects/project-1/vpcs/data-vpc, but its parent Subnet is in VPC /orgs/default/projects/project-1/vpcs/vpc-1")
+	assert.ErrorContains(t, r.checkSubnetPortVPC(newSubnetPort("mgmt"), "/orgs/default/projects/project-1/vpcs/vpc-1"),
+		"VPC mgmt is not associated with Namespace ns-1")
+
+	// The auto-created SubnetSet without vpcName is in the primary VPC.
+	subnetSet := &v1alpha1.SubnetSet{ObjectMeta: metav1.ObjectMeta{Name: "subnetset-1", Namespace: "ns-1"}}
+	assert.ErrorContains(t, r.checkSubnetPortVPCBySubnetSet(newSubnetPort("data"), subnetSet), "its parent Subnet is in VPC /orgs/default/projects/project-1/vpcs/vpc-1")
+	subnetSet.Spec.VPCName = "data"
+	assert.NoError(t, r.checkSubnetPortVPCBySubnetSet(newSubnetPort("data"), subnetSet))
+
+	// The pre-created SubnetSet is in the VPC of its listed Subnets.
+	subnetPath := "/orgs/default/projects/project-1/vpcs/vpc-1/subnets/subnet-1"
+	patches := gomonkey.ApplyFunc(common.GetNSXSubnetsForSubnetSet, func(client client.Client, subnetSet *v1alpha1.SubnetSet, subnetService servicecommon.SubnetServiceProvider) ([]*model.VpcSubnet, error) {
+		return []*model.VpcSubnet{{Path: &subnetPath}}, nil
+	})
+	defer patches.Reset()
+	preCreatedSubnetSet := &v1alpha1.SubnetSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "subnetset-2", Namespace: "ns-1"},
+		Spec:       v1alpha1.SubnetSetSpec{SubnetNames: &[]string{"subnet-1"}},
+	}
+	assert.ErrorContains(t, r.checkSubnetPortVPCBySubnetSet(newSubnetPort("data"), preCreatedSubnetSet), "its parent Subnet is in VPC /orgs/default/projects/project-1/vpcs/vpc-1")
+	subnetPath = "/orgs/default/projects/project-1/vpcs/data-vpc/subnets/subnet-1"
+	assert.NoError(t, r.checkSubnetPortVPCBySubnetSet(newSubnetPort("data"), preCreatedSubnetSet))
+	patches.Reset()
+	patches = gomonkey.ApplyFunc(common.GetNSXSubnetsForSubnetSet, func(client client.Client, subnetSet *v1alpha1.SubnetSet, subnetService servicecommon.SubnetServiceProvider) ([]*model.VpcSubnet, error) {
+		return nil, errors.New("subnet-1 not found")
+	})
+	assert.ErrorContains(t, r.checkSubnetPortVPCBySubnetSet(newSubnetPort("data"), preCreatedSubnetSet), "subnet-1 not found")
+}
+
+func TestSubnetPortDriftMapFunc(t *testing.T) {
+	tag := func(scope, value string) model.Tag {
+		return model.Tag{Scope: servicecommon.String(scope), Tag: servicecommon.String(value)}
+	}
+	owner := subnetPortDriftMapFunc(&model.VpcSubnetPort{Tags: []model.Tag{
+		tag(servicecommon.TagScopeNamespace, "ns-1"), tag(servicecommon.TagScopeSubnetPortCRName, "port-1"),
+	}})
+	assert.Equal(t, types.NamespacedName{Namespace: "ns-1", Name: "port-1"}, client.ObjectKeyFromObject(owner))
+
+	owner = subnetPortDriftMapFunc(&model.VpcSubnetPort{Tags: []model.Tag{
+		tag(servicecommon.TagScopeVMNamespace, "ns-2"), tag(servicecommon.TagScopeSubnetPortCRName, "port-2"),
+	}})
+	assert.Equal(t, types.NamespacedName{Namespace: "ns-2", Name: "port-2"}, client.ObjectKeyFromObject(owner))
+
+	// The NSX SubnetPort created for a Pod is not mapped.
+	assert.Nil(t, subnetPortDriftMapFunc(&model.VpcSubnetPort{Tags: []model.Tag{
+		tag(servicecommon.TagScopeNamespace, "ns-1"), tag(servicecommon.TagScopePodName, "pod-1"),
+	}}))
+}
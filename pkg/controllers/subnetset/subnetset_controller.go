@@ -28,8 +28,10 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
 	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnet"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/subnetbinding"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -289,11 +291,11 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		}
 		if r.restoreMode {
 			log.Debug("Restore SubnetSet", "SubnetSet", req.NamespacedName)
-			vpcInfoList := r.VPCService.ListVPCInfo(req.Namespace)
-			if len(vpcInfoList) == 0 {
-				return ResultNormal, fmt.Errorf("failed to find VPC for Namespace %s", req.Namespace)
+			vpcInfo, err := servicecommon.GetVPCInfoByName(r.VPCService, req.Namespace, subnetsetCR.Spec.VPCName)
+			if err != nil {
+				return ResultNormal, fmt.Errorf("failed to find VPC for Namespace %s: %w", req.Namespace, err)
 			}
-			if err := r.SubnetService.RestoreSubnetSet(subnetsetCR, vpcInfoList[0], tags); err != nil {
+			if err := r.SubnetService.RestoreSubnetSet(subnetsetCR, *vpcInfo, tags); err != nil {
 				r.StatusUpdater.UpdateFail(ctx, subnetsetCR, err, "Failed to restore SubnetSet", setSubnetSetReadyStatusFalse)
 				return ResultNormal, err
 			}
@@ -301,6 +303,11 @@ func (r *SubnetSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 			return ResultNormal, nil
 		}
 		if err := r.SubnetService.UpdateSubnetSet(subnetsetCR.Namespace, nsxSubnets, tags, subnetsetCR); err != nil {
+			if nsxutil.IsRealizationPendingError(err) {
+				// The SubnetSet is requeued by the realization watcher, or after the realization timeout if the
+				// realization event is dropped.
+				return common.ResultRequeueAfterRealization, nil
+			}
 			r.StatusUpdater.UpdateFail(ctx, subnetsetCR, err, "Failed to update SubnetSet", setSubnetSetReadyStatusFalse)
 			return ResultNormal, err
 		}
@@ -360,7 +367,7 @@ func setSubnetSetReadyStatusFalse(client client.Client, ctx context.Context, obj
 			Type:               v1alpha1.Ready,
 			Status:             v1.ConditionFalse,
 			Message:            "SubnetSet CR could not be created/updated",
-			Reason:             "SubnetSetNotReady",
+			Reason:             nsxutil.ErrorReason(err, "SubnetSetNotReady"),
 			LastTransitionTime: transitionTime,
 		},
 	}
@@ -463,6 +470,9 @@ func (r *SubnetSetReconciler) setupWithManager(mgr ctrl.Manager) error {
 			},
 			builder.WithPredicates(common.PredicateFuncsWithSubnetBindings),
 		).
+		WatchesRawSource(common.NewRealizationSource(realizestate.RealizationKindSubnetSet, func() client.Object {
+			return &v1alpha1.SubnetSet{}
+		})).
 		Complete(r)
 }
 
@@ -539,24 +549,32 @@ func (r *SubnetSetReconciler) deleteSubnetBySubnetSetName(ctx context.Context, s
 	return nil
 }
 
-func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
-	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
+// listSubnetsToDelete returns the NSX Subnets of the SubnetSet to be deleted, the ones without ports are deleted
+// by deleteSubnets.
+func (r *SubnetSetReconciler) listSubnetsToDelete(subnetSet v1alpha1.SubnetSet) []*model.VpcSubnet {
 	nsxSubnets := r.SubnetService.SubnetStore.GetByIndex(servicecommon.TagScopeSubnetSetCRUID, string(subnetSet.GetUID()))
-
 	// For restore mode, we use SubnetSet CR status as source of the truth to sync the NSX Subnet
 	// For non-restore mode, we scale down the SubnetSet by deleting NSX Subnet without ports
-	if r.restoreMode {
-		subnetCIDRSet := sets.New[string]()
-		for _, subnet := range subnetSet.Status.Subnets {
-			subnetCIDRSet.Insert(strings.Join(subnet.NetworkAddresses, ","))
-		}
-		var revisedNSXSubnet []*model.VpcSubnet
-		for _, nsxSubnet := range nsxSubnets {
-			if !subnetCIDRSet.Has(strings.Join(nsxSubnet.IpAddresses, ",")) {
-				revisedNSXSubnet = append(revisedNSXSubnet, nsxSubnet)
-			}
+	if !r.restoreMode {
+		return nsxSubnets
+	}
+	subnetCIDRSet := sets.New[string]()
+	for _, subnet := range subnetSet.Status.Subnets {
+		subnetCIDRSet.Insert(strings.Join(subnet.NetworkAddresses, ","))
+	}
+	var revisedNSXSubnet []*model.VpcSubnet
+	for _, nsxSubnet := range nsxSubnets {
+		if !subnetCIDRSet.Has(strings.Join(nsxSubnet.IpAddresses, ",")) {
+			revisedNSXSubnet = append(revisedNSXSubnet, nsxSubnet)
 		}
-		nsxSubnets = revisedNSXSubnet
+	}
+	return revisedNSXSubnet
+}
+
+func (r *SubnetSetReconciler) deleteSubnetForSubnetSet(subnetSet v1alpha1.SubnetSet, updateStatus, ignoreStaleSubnetPort bool) error {
+	subnetSetLock := common.WLockSubnetSet(subnetSet.GetUID())
+	nsxSubnets := r.listSubnetsToDelete(subnetSet)
+	if r.restoreMode {
 		// NSX SubnetPorts under the NSX Subnet not in CR status should be deleted before SubnetSet GC
 		ignoreStaleSubnetPort = false
 	}
@@ -659,6 +677,42 @@ func (r *SubnetSetReconciler) getRestoreList() ([]types.NamespacedName, error) {
 	return restoreList, nil
 }
 
+// RestoreDryRun implements the interface RestoreDryRunner. The NSX Subnets with ports are not reported, as they are
+// skipped by the garbage collection.
+func (r *SubnetSetReconciler) RestoreDryRun(ctx context.Context) (*util.RestorePlan, error) {
+	restoreList, err := r.getRestoreList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SubnetSet restore list: %w", err)
+	}
+	crdSubnetSetList, err := listSubnetSet(r.Client, ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan := &util.RestorePlan{}
+	for _, key := range restoreList {
+		plan.CRsToRestore = append(plan.CRsToRestore, key.String())
+	}
+	var nsxSubnets []*model.VpcSubnet
+	crdSubnetSetIDsSet := sets.New[string]()
+	for _, subnetSet := range crdSubnetSetList.Items {
+		if subnetSet.Spec.SubnetNames != nil {
+			continue
+		}
+		crdSubnetSetIDsSet.Insert(string(subnetSet.UID))
+		nsxSubnets = append(nsxSubnets, r.listSubnetsToDelete(subnetSet)...)
+	}
+	subnetSetIDsToDelete := r.SubnetService.ListSubnetSetIDsFromNSXSubnets().Difference(crdSubnetSetIDsSet)
+	for _, subnetSetID := range sets.List(subnetSetIDsToDelete) {
+		nsxSubnets = append(nsxSubnets, r.SubnetService.ListSubnetCreatedBySubnetSet(subnetSetID)...)
+	}
+	for _, nsxSubnet := range nsxSubnets {
+		if r.SubnetPortService.IsEmptySubnet(*nsxSubnet.Path) {
+			plan.NSXResourcesToDelete = append(plan.NSXResourcesToDelete, *nsxSubnet.Path)
+		}
+	}
+	return plan, nil
+}
+
 func (r *SubnetSetReconciler) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	if err := r.Start(mgr, hookServer); err != nil {
 		log.Error(err, "Failed to create controller", "controller", "SubnetSet")
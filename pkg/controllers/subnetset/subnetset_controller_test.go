@@ -1511,6 +1511,48 @@ func TestSubnetSetReconciler_RestoreReconcile(t *testing.T) {
 	err = r.RestoreReconcile()
 	assert.Contains(t, err.Error(), "failed to restore SubnetSet ns-1/subnetset-1")
 }
+func TestSubnetSetReconciler_RestoreDryRun(t *testing.T) {
+	subnetPathPrefix := "/orgs/default/projects/default/vpcs/vpc-1/subnets/"
+	r := createFakeSubnetSetReconciler([]client.Object{
+		&v1alpha1.SubnetSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "subnetset-1", Namespace: "ns-1", UID: "subnetset-1"},
+			Status: v1alpha1.SubnetSetStatus{
+				Subnets: []v1alpha1.SubnetInfo{{NetworkAddresses: []string{"10.0.0.0/28"}}},
+			},
+		},
+	})
+	r.EnableRestoreMode()
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(r.SubnetService.SubnetStore), "GetByIndex", func(_ *subnet.SubnetStore, _ string, _ string) []*model.VpcSubnet {
+		return []*model.VpcSubnet{
+			{Id: common.String("subnet-restored"), Path: common.String(subnetPathPrefix + "subnet-restored"), IpAddresses: []string{"10.0.0.0/28"}},
+			{Id: common.String("subnet-stale"), Path: common.String(subnetPathPrefix + "subnet-stale"), IpAddresses: []string{"10.0.0.16/28"}},
+		}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "ListSubnetSetIDsFromNSXSubnets", func(_ *subnet.SubnetService) sets.Set[string] {
+		return sets.New[string]("subnetset-1", "subnetset-deleted")
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "ListSubnetCreatedBySubnetSet", func(_ *subnet.SubnetService, id string) []*model.VpcSubnet {
+		assert.Equal(t, "subnetset-deleted", id)
+		return []*model.VpcSubnet{
+			{Id: common.String("subnet-deleted"), Path: common.String(subnetPathPrefix + "subnet-deleted")},
+			{Id: common.String("subnet-with-ports"), Path: common.String(subnetPathPrefix + "subnet-with-ports")},
+		}
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetPortService), "IsEmptySubnet", func(_ *subnetport.SubnetPortService, path string) bool {
+		return path != subnetPathPrefix+"subnet-with-ports"
+	})
+	patches.ApplyMethod(reflect.TypeOf(r.SubnetService), "DeleteSubnet", func(_ *subnet.SubnetService, _ model.VpcSubnet) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	defer patches.Reset()
+
+	plan, err := r.RestoreDryRun(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns-1/subnetset-1"}, plan.CRsToRestore)
+	assert.Equal(t, []string{subnetPathPrefix + "subnet-stale", subnetPathPrefix + "subnet-deleted"}, plan.NSXResourcesToDelete)
+}
+
 func TestUpdateLabels(t *testing.T) {
 	// nil labels should not change anything
 	t.Run("nil labels", func(t *testing.T) {
@@ -60,14 +60,14 @@ func defaultSubnetSetLabelChanged(oldSubnetSet, subnetSet *v1alpha1.SubnetSet) b
 }
 
 func hasExclusiveFields(s *v1alpha1.SubnetSet) bool {
-	return s.Spec.SubnetNames != nil && (s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "")
+	return s.Spec.SubnetNames != nil && (s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "" || s.Spec.VPCName != "")
 }
 
 func subnetSetType(s *v1alpha1.SubnetSet) SubnetSetType {
 	if s.Spec.SubnetNames != nil {
 		return SubnetSetTypePreCreated
 	}
-	if s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "" {
+	if s.Spec.IPv4SubnetSize != 0 || s.Spec.IPv6PrefixLength != 0 || s.Spec.AccessMode != "" || s.Spec.SubnetDHCPConfig.Mode != "" || s.Spec.SubnetDHCPv6Config.Mode != "" || s.Spec.VPCName != "" {
 		return SubnetSetTypeAutoCreated
 	}
 	return SubnetSetTypeNone
@@ -116,6 +116,11 @@ func (v *SubnetSetValidator) Handle(ctx context.Context, req admission.Request)
 		if subnetSet.Spec.SubnetNames != nil && subnetSet.Spec.IPAddressType != "" && req.UserInfo.Username != NSXOperatorSA {
 			return admission.Denied("Pre-created SubnetSet spec.ipAddressType can only be set by NSX Operator")
 		}
+		if subnetSet.Spec.VPCName != "" {
+			if _, err := common.GetVPCInfoByName(v.vpcService, subnetSet.Namespace, subnetSet.Spec.VPCName); err != nil {
+				return admission.Denied(fmt.Sprintf("SubnetSet %s/%s: spec.vpcName is invalid: %v", subnetSet.Namespace, subnetSet.Name, err))
+			}
+		}
 		deny, err := v.validateSubnets(ctx, subnetSet.Namespace, subnetSet.Spec.SubnetNames, subnetSet.Name)
 		if err != nil {
 			if deny {
@@ -182,7 +187,7 @@ func (v *SubnetSetValidator) Handle(ctx context.Context, req admission.Request)
 	}
 	if req.Operation != admissionv1.Delete {
 		if hasExclusiveFields(subnetSet) {
-			return admission.Denied("SubnetSet spec.subnetNames is exclusive with spec.ipv4SubnetSize, spec.accessMode and spec.subnetDHCPConfig")
+			return admission.Denied("SubnetSet spec.subnetNames is exclusive with spec.ipv4SubnetSize, spec.accessMode, spec.subnetDHCPConfig and spec.vpcName")
 		}
 		err := controllercommon.CheckAccessModeOrVisibility(v.Client, ctx, subnetSet.Namespace, string(subnetSet.Spec.AccessMode), "subnetset")
 		if err != nil {
@@ -305,12 +310,12 @@ func (v *SubnetSetValidator) validateRemovedSubnets(ctx context.Context, subnetS
 	return true, nil
 }
 
-func (v *SubnetSetValidator) getVPCPath(ns string) (string, error) {
-	vpcInfoList := v.vpcService.ListVPCInfo(ns)
-	if len(vpcInfoList) == 0 {
-		return "", fmt.Errorf("failed to get VPC Info %s", ns)
+func (v *SubnetSetValidator) getVPCPath(ns, vpcName string) (string, error) {
+	vpcInfo, err := common.GetVPCInfoByName(v.vpcService, ns, vpcName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get VPC Info %s: %w", ns, err)
 	}
-	return fmt.Sprintf("/orgs/%s/projects/%s/vpcs/%s", vpcInfoList[0].OrgID, vpcInfoList[0].ProjectID, vpcInfoList[0].VPCID), nil
+	return vpcInfo.GetVPCPath(), nil
 }
 
 // Check all Subnet CRs referred by the SubnetSet to make sure they not breake any rules.
@@ -330,7 +335,7 @@ func getEffectiveStaticIPAllocation(subnet *v1alpha1.Subnet) bool {
 }
 
 func (v *SubnetSetValidator) validateSubnets(ctx context.Context, ns string, subnetNames *[]string, subnetSet string) (bool, error) {
-	var namespaceVpc string
+	namespaceVPCs := map[string]string{}
 	var existingVPC string
 	firstAccessMode := ""
 	firstDHCPMode := ""
@@ -382,13 +387,14 @@ func (v *SubnetSetValidator) validateSubnets(ctx context.Context, ns string, sub
 			}
 			subnetVPC = strings.Split(subnetPath, "/subnets/")[0]
 		} else {
-			if namespaceVpc == "" {
-				namespaceVpc, err = v.getVPCPath(ns)
+			// Subnets in the primary VPC may have spec.vpcName unset before they are realized.
+			if _, ok := namespaceVPCs[crdSubnet.Spec.VPCName]; !ok {
+				namespaceVPCs[crdSubnet.Spec.VPCName], err = v.getVPCPath(ns, crdSubnet.Spec.VPCName)
 				if err != nil {
 					return false, err
 				}
 			}
-			subnetVPC = namespaceVpc
+			subnetVPC = namespaceVPCs[crdSubnet.Spec.VPCName]
 		}
 		if existingVPC == "" {
 			existingVPC = subnetVPC
@@ -202,6 +202,17 @@ func TestParseSubnetVPCName(t *testing.T) {
 	assert.Equal(t, "", proj)
 	assert.Equal(t, "vpc-only", vpc)
 }
+func TestSubnetVPCFullID(t *testing.T) {
+	subnetCR := &vpcv1alpha1.Subnet{Spec: vpcv1alpha1.SubnetSpec{VPCName: "proj1:vpc1"}}
+	assert.Equal(t, "proj1:vpc1", subnetVPCFullID(subnetCR))
+
+	// The name of an additional VPC is resolved by the operator in status
+	subnetCR = &vpcv1alpha1.Subnet{
+		Spec:   vpcv1alpha1.SubnetSpec{VPCName: "data"},
+		Status: vpcv1alpha1.SubnetStatus{VPCFullID: "proj1:data-vpc"},
+	}
+	assert.Equal(t, "proj1:data-vpc", subnetVPCFullID(subnetCR))
+}
 func TestNsxTagValue_Found(t *testing.T) {
 	s1, t1 := "scope1", "tag1"
 	tags := []model.Tag{{Scope: &s1, Tag: &t1}}
@@ -42,16 +42,17 @@ func NewSubnetDHCPStatsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client
 func (s *SubnetDHCPStatsStorage) Get(ctx context.Context, namespace, name string) (*easv1alpha1.SubnetDHCPServerStats, error) {
 	log := logger.Log
 
-	// Resolve VPC info from the Subnet CR's spec.vpcName.
+	// Resolve VPC info from the Subnet CR's status.vpcFullID or spec.vpcName.
 	subnetCR := &vpcv1alpha1.Subnet{}
 	if err := s.k8sClient.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: name}, subnetCR); err != nil {
 		return nil, fmt.Errorf("subnet CR %s/%s not found: %w", namespace, name, err)
 	}
-	if subnetCR.Spec.VPCName == "" {
+	vpcFullID := subnetVPCFullID(subnetCR)
+	if vpcFullID == "" {
 		return nil, fmt.Errorf("subnet CR %s/%s has empty spec.vpcName", namespace, name)
 	}
 
-	orgID, projectID, vpcID := parseSubnetVPCName(subnetCR.Spec.VPCName)
+	orgID, projectID, vpcID := parseSubnetVPCName(vpcFullID)
 	log.Debug("Fetching DHCP stats by name", "namespace", namespace, "name", name,
 		"projectID", projectID, "vpcID", vpcID)
 
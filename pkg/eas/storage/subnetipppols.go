@@ -42,16 +42,17 @@ func NewSubnetIPPoolsStorage(nsxClient *nsx.Client, k8sClient k8sclient.Client)
 func (s *SubnetIPPoolsStorage) Get(ctx context.Context, namespace, name string) (*easv1alpha1.SubnetIPPools, error) {
 	log := logger.Log
 
-	// Resolve VPC info from the Subnet CR's spec.vpcName.
+	// Resolve VPC info from the Subnet CR's status.vpcFullID or spec.vpcName.
 	subnetCR := &vpcv1alpha1.Subnet{}
 	if err := s.k8sClient.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: name}, subnetCR); err != nil {
 		return nil, fmt.Errorf("subnet CR %s/%s not found: %w", namespace, name, err)
 	}
-	if subnetCR.Spec.VPCName == "" {
+	vpcFullID := subnetVPCFullID(subnetCR)
+	if vpcFullID == "" {
 		return nil, fmt.Errorf("subnet CR %s/%s has empty spec.vpcName", namespace, name)
 	}
 
-	orgID, projectID, vpcID := parseSubnetVPCName(subnetCR.Spec.VPCName)
+	orgID, projectID, vpcID := parseSubnetVPCName(vpcFullID)
 	log.Debug("Fetching subnet IP pools by name", "namespace", namespace, "name", name,
 		"projectID", projectID, "vpcID", vpcID)
 
@@ -131,6 +132,15 @@ func (s *SubnetIPPoolsStorage) fetchIPPools(namespace, nsxSubnetID, name string,
 	return result, nil
 }
 
+// subnetVPCFullID returns the VPC full ID of a Subnet CR. spec.vpcName may be the name of an
+// additional VPC set by users, so the VPC full ID resolved by the operator in status is preferred.
+func subnetVPCFullID(subnetCR *vpcv1alpha1.Subnet) string {
+	if subnetCR.Status.VPCFullID != "" {
+		return subnetCR.Status.VPCFullID
+	}
+	return subnetCR.Spec.VPCName
+}
+
 // parseSubnetVPCName parses the spec.vpcName field of a Subnet CR.
 //
 // The operator sets spec.vpcName via GetVPCFullID:
@@ -0,0 +1,242 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/metrics"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	// AuditReportName is the name of the NSXAuditReport recording the audit results.
+	AuditReportName = "nsx-operator-audit"
+	// ReasonGroupNotReferenced is reported for the Groups of the policies which are referenced by no Rule or
+	// SecurityPolicy.
+	ReasonGroupNotReferenced = "GroupNotReferenced"
+	// ReasonShareWithoutGroup is reported for the Shares which share no existing Group.
+	ReasonShareWithoutGroup = "ShareWithoutGroup"
+	// maxAuditReportItems is the maximum number of the orphans or dangling references listed in the NSXAuditReport
+	// status, the status is bounded by the object size limit of the API server.
+	maxAuditReportItems = 100
+	// maxPathsPerQuery is the maximum number of the parent paths in a search query to bound the query length.
+	maxPathsPerQuery = 50
+)
+
+// DanglingReference is an NSX resource tagged with the cluster which is not referenced by or references no other
+// resource as expected.
+type DanglingReference struct {
+	Resource Resource `json:"resource"`
+	Reason   string   `json:"reason"`
+}
+
+// DanglingReferences returns the Groups of the SecurityPolicies and the NetworkPolicies which are referenced by no
+// Rule or SecurityPolicy, and the Shares which share no existing Group.
+func (i *Inspector) DanglingReferences() ([]DanglingReference, error) {
+	cluster := i.NSXClient.NsxConfig.Cluster
+	resources, err := i.search(clusterQuery(cluster, common.ResourceTypeGroup, common.ResourceTypeRule,
+		common.ResourceTypeSecurityPolicy, common.ResourceTypeShare), nil)
+	if err != nil {
+		return nil, err
+	}
+	var groups, shares []Resource
+	groupPaths := sets.New[string]()
+	referencedPaths := sets.New[string]()
+	for _, resource := range resources {
+		switch resource.ResourceType {
+		case common.ResourceTypeGroup:
+			groups = append(groups, resource)
+			groupPaths.Insert(resource.Path)
+		case common.ResourceTypeShare:
+			shares = append(shares, resource)
+		case common.ResourceTypeRule, common.ResourceTypeSecurityPolicy:
+			referencedPaths.Insert(resource.SourceGroups...)
+			referencedPaths.Insert(resource.DestinationGroups...)
+			referencedPaths.Insert(resource.Scope...)
+		}
+	}
+
+	var references []DanglingReference
+	for _, group := range groups {
+		if ownerKind, _, found := findOwnerUID(&group); !found || !isPolicyKind(ownerKind.GVK.Kind) {
+			continue
+		}
+		if !referencedPaths.Has(group.Path) {
+			references = append(references, DanglingReference{Resource: group, Reason: ReasonGroupNotReferenced})
+		}
+	}
+	if len(shares) == 0 {
+		return references, nil
+	}
+
+	// The SharedResources are not tagged, they are searched under the paths of the Shares.
+	sharePaths := make([]string, 0, len(shares))
+	for _, share := range shares {
+		sharePaths = append(sharePaths, share.Path)
+	}
+	sharingPaths := sets.New[string]()
+	for start := 0; start < len(sharePaths); start += maxPathsPerQuery {
+		sharedResources, err := i.searchInCluster("", fmt.Sprintf("%s:%s AND %s AND marked_for_delete:false",
+			common.ResourceType, common.ResourceTypeSharedResource,
+			parentPathQuery(sharePaths[start:min(start+maxPathsPerQuery, len(sharePaths))])), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sharedResource := range sharedResources {
+			for _, object := range sharedResource.ResourceObjects {
+				if groupPaths.Has(object.ResourcePath) {
+					sharingPaths.Insert(sharedResource.ParentPath)
+				}
+			}
+		}
+	}
+	for _, share := range shares {
+		if !sharingPaths.Has(share.Path) {
+			references = append(references, DanglingReference{Resource: share, Reason: ReasonShareWithoutGroup})
+		}
+	}
+	return references, nil
+}
+
+func isPolicyKind(kind string) bool {
+	return kind == "SecurityPolicy" || kind == "NetworkPolicy"
+}
+
+// Auditor periodically reports the orphaned NSX resources and the dangling references in the NSXAuditReport and the
+// metrics. It only reads NSX, nothing is deleted.
+type Auditor struct {
+	nsxClient *nsx.Client
+	client    client.Client
+	cf        *config.NSXOperatorConfig
+	period    time.Duration
+	// orphanCounts and referenceCounts are the metric counts of the previous audit by the label values, the label
+	// values not found in the next audit are deleted from the metrics.
+	orphanCounts    map[[2]string]int
+	referenceCounts map[[2]string]int
+}
+
+// NewAuditor returns the Auditor running every period, the client should not be cached as all the objects of the
+// owner kinds are listed in each audit.
+func NewAuditor(nsxClient *nsx.Client, k8sClient client.Client, cf *config.NSXOperatorConfig, period time.Duration) *Auditor {
+	return &Auditor{nsxClient: nsxClient, client: k8sClient, cf: cf, period: period}
+}
+
+// Start runs the audit until the context is done, it implements the manager Runnable.
+func (a *Auditor) Start(ctx context.Context) error {
+	log.Info("Starting NSX resource audit", "period", a.period)
+	wait.UntilWithContext(ctx, a.audit, a.period)
+	return nil
+}
+
+// NeedLeaderElection makes the audit run only on the leader.
+func (a *Auditor) NeedLeaderElection() bool {
+	return true
+}
+
+func (a *Auditor) audit(ctx context.Context) {
+	// A new Inspector is used in each audit to list the owners again.
+	inspector := NewInspector(a.nsxClient, a.client)
+	orphans, err := inspector.Orphans(ctx)
+	var references []DanglingReference
+	if err == nil {
+		references, err = inspector.DanglingReferences()
+	}
+	now := metav1.Now()
+	if err != nil {
+		log.Error(err, "Failed to audit NSX resources")
+		a.save(ctx, func(status *v1alpha1.NSXAuditReportStatus) {
+			status.LastAuditTime = &now
+			status.Error = err.Error()
+		})
+		return
+	}
+	log.Info("Audited NSX resources", "orphans", len(orphans), "danglingReferences", len(references))
+	result := v1alpha1.NSXAuditReportStatus{
+		LastAuditTime:          &now,
+		OrphanCount:            len(orphans),
+		DanglingReferenceCount: len(references),
+	}
+
+	orphanCounts := map[[2]string]int{}
+	for _, orphan := range orphans {
+		orphanCounts[[2]string{orphan.Resource.ResourceType, orphan.Owner.Kind}]++
+		if len(result.Orphans) < maxAuditReportItems {
+			result.Orphans = append(result.Orphans, v1alpha1.AuditedNSXResource{
+				ResourceType:   orphan.Resource.ResourceType,
+				Path:           orphan.Resource.Path,
+				OwnerKind:      orphan.Owner.Kind,
+				OwnerNamespace: orphan.Owner.Namespace,
+				OwnerUID:       orphan.Owner.UID,
+			})
+		}
+	}
+	a.setGauge(metrics.OrphanedNSXResources, orphanCounts, a.orphanCounts)
+	a.orphanCounts = orphanCounts
+
+	referenceCounts := map[[2]string]int{}
+	for _, reference := range references {
+		referenceCounts[[2]string{reference.Resource.ResourceType, reference.Reason}]++
+		if len(result.DanglingReferences) < maxAuditReportItems {
+			result.DanglingReferences = append(result.DanglingReferences, v1alpha1.AuditedNSXResource{
+				ResourceType: reference.Resource.ResourceType,
+				Path:         reference.Resource.Path,
+				Reason:       reference.Reason,
+			})
+		}
+	}
+	a.setGauge(metrics.DanglingNSXReferences, referenceCounts, a.referenceCounts)
+	a.referenceCounts = referenceCounts
+	a.save(ctx, func(status *v1alpha1.NSXAuditReportStatus) {
+		*status = result
+	})
+}
+
+// setGauge sets the gauge with the counts by the label values, the label values of the previous counts which are not
+// in the counts are deleted.
+func (a *Auditor) setGauge(gauge *prometheus.GaugeVec, counts, previousCounts map[[2]string]int) {
+	for key, count := range counts {
+		metrics.GaugeSet(a.cf, gauge, float64(count), key[0], key[1])
+	}
+	for key := range previousCounts {
+		if _, found := counts[key]; !found {
+			metrics.GaugeDelete(a.cf, gauge, key[0], key[1])
+		}
+	}
+}
+
+// save updates the NSXAuditReport status by the update function, the previous status is kept if the audit failed.
+func (a *Auditor) save(ctx context.Context, update func(status *v1alpha1.NSXAuditReportStatus)) {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj := &v1alpha1.NSXAuditReport{}
+		if err := a.client.Get(ctx, types.NamespacedName{Name: AuditReportName}, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			obj.SetName(AuditReportName)
+			if err = a.client.Create(ctx, obj); err != nil {
+				return err
+			}
+		}
+		update(&obj.Status)
+		return a.client.Status().Update(ctx, obj)
+	})
+	if err != nil {
+		log.Error(err, "Failed to update NSXAuditReport status", "NSXAuditReport", AuditReportName)
+	}
+}
@@ -0,0 +1,151 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	projectPath        = "/orgs/default/projects/p1"
+	referencedGroup    = policyPath + "/groups/src"
+	unreferencedGroup  = vpcPath + "/groups/unreferenced"
+	sharedGroup        = projectPath + "/infra/domains/default/groups/peer"
+	sharePath          = projectPath + "/infra/shares/share-1"
+	shareWithoutGroups = projectPath + "/infra/shares/share-2"
+)
+
+// fakeReferenceResources are the Groups, Rules and Shares of the SecurityPolicy sp-1 for the dangling references.
+func fakeReferenceResources(t *testing.T) []*data.StructValue {
+	return []*data.StructValue{
+		toStructValue(t, model.Rule{
+			ResourceType:      common.String(common.ResourceTypeRule),
+			Path:              common.String(policyPath + "/rules/rule-3"),
+			SourceGroups:      []string{referencedGroup},
+			DestinationGroups: []string{sharedGroup},
+			Tags:              newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.RuleBindingType()),
+		toStructValue(t, model.Group{
+			ResourceType: common.String(common.ResourceTypeGroup),
+			Path:         common.String(referencedGroup),
+			Tags:         newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.GroupBindingType()),
+		toStructValue(t, model.Group{
+			ResourceType: common.String(common.ResourceTypeGroup),
+			Path:         common.String(unreferencedGroup),
+			Tags:         newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.GroupBindingType()),
+		toStructValue(t, model.Group{
+			ResourceType: common.String(common.ResourceTypeGroup),
+			Path:         common.String(sharedGroup),
+			Tags:         newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.GroupBindingType()),
+		toStructValue(t, model.Share{
+			ResourceType: common.String(common.ResourceTypeShare),
+			Path:         common.String(sharePath),
+			Tags:         newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.ShareBindingType()),
+		toStructValue(t, model.SharedResource{
+			ResourceType:    common.String(common.ResourceTypeSharedResource),
+			ParentPath:      common.String(sharePath),
+			Path:            common.String(sharePath + "/resources/share-1"),
+			ResourceObjects: []model.ResourceObject{{ResourcePath: common.String(sharedGroup)}},
+		}, model.SharedResourceBindingType()),
+		toStructValue(t, model.Share{
+			ResourceType: common.String(common.ResourceTypeShare),
+			Path:         common.String(shareWithoutGroups),
+			Tags:         newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.ShareBindingType()),
+		toStructValue(t, model.SharedResource{
+			ResourceType:    common.String(common.ResourceTypeSharedResource),
+			ParentPath:      common.String(shareWithoutGroups),
+			Path:            common.String(shareWithoutGroups + "/resources/share-2"),
+			ResourceObjects: []model.ResourceObject{{ResourcePath: common.String(projectPath + "/infra/domains/default/groups/deleted")}},
+		}, model.SharedResourceBindingType()),
+	}
+}
+
+func TestDanglingReferences(t *testing.T) {
+	inspector, patches := createInspector(t, fakeReferenceResources(t)...)
+	defer patches.Reset()
+
+	references, err := inspector.DanglingReferences()
+	require.NoError(t, err)
+	require.Len(t, references, 2)
+	assert.Equal(t, unreferencedGroup, references[0].Resource.Path)
+	assert.Equal(t, ReasonGroupNotReferenced, references[0].Reason)
+	assert.Equal(t, shareWithoutGroups, references[1].Resource.Path)
+	assert.Equal(t, ReasonShareWithoutGroup, references[1].Reason)
+}
+
+func TestAuditorAudit(t *testing.T) {
+	inspector, patches := createInspector(t, fakeReferenceResources(t)...)
+	defer func() { patches.Reset() }()
+	auditor := NewAuditor(inspector.NSXClient, inspector.Client, &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{}}, time.Hour)
+	assert.True(t, auditor.NeedLeaderElection())
+
+	ctx := context.TODO()
+	auditor.audit(ctx)
+	report := &v1alpha1.NSXAuditReport{}
+	require.NoError(t, inspector.Client.Get(ctx, types.NamespacedName{Name: AuditReportName}, report))
+	assert.NotNil(t, report.Status.LastAuditTime)
+	assert.Empty(t, report.Status.Error)
+	assert.Equal(t, 1, report.Status.OrphanCount)
+	assert.Equal(t, []v1alpha1.AuditedNSXResource{{
+		ResourceType:   common.ResourceTypeSubnetPort,
+		Path:           portPath,
+		OwnerKind:      "Pod",
+		OwnerNamespace: namespace,
+		OwnerUID:       "pod-uid-deleted",
+	}}, report.Status.Orphans)
+	assert.Equal(t, 2, report.Status.DanglingReferenceCount)
+	assert.Equal(t, []v1alpha1.AuditedNSXResource{
+		{ResourceType: common.ResourceTypeGroup, Path: unreferencedGroup, Reason: ReasonGroupNotReferenced},
+		{ResourceType: common.ResourceTypeShare, Path: shareWithoutGroups, Reason: ReasonShareWithoutGroup},
+	}, report.Status.DanglingReferences)
+
+	// The results of the previous audit are kept if the audit fails.
+	patches.Reset()
+	patches = gomonkey.ApplyMethod(reflect.TypeOf(&inspector.Service), "SearchResource",
+		func(_ *common.Service, _ string, _ string, _ common.Store, _ common.Filter) (uint64, error) {
+			return 0, errors.New("NSX is unavailable")
+		})
+	auditor.audit(ctx)
+	require.NoError(t, inspector.Client.Get(ctx, types.NamespacedName{Name: AuditReportName}, report))
+	assert.Equal(t, "NSX is unavailable", report.Status.Error)
+	assert.Equal(t, 1, report.Status.OrphanCount)
+	assert.Equal(t, 2, report.Status.DanglingReferenceCount)
+}
+
+func TestAuditorSetGauge(t *testing.T) {
+	cf := &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{EnforcementPoint: "vmc-enforcementpoint"}}
+	auditor := NewAuditor(nil, nil, cf, time.Hour)
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_audit_gauge"}, []string{"resource_type", "reason"})
+
+	previousCounts := map[[2]string]int{{"Group", ReasonGroupNotReferenced}: 2, {"Share", ReasonShareWithoutGroup}: 1}
+	auditor.setGauge(gauge, previousCounts, nil)
+	assert.Equal(t, 2, testutil.CollectAndCount(gauge))
+
+	// The label values not found in the next audit are deleted.
+	auditor.setGauge(gauge, map[[2]string]int{{"Group", ReasonGroupNotReferenced}: 1}, previousCounts)
+	assert.Equal(t, 1, testutil.CollectAndCount(gauge))
+	assert.Equal(t, float64(1), testutil.ToFloat64(gauge.WithLabelValues("Group", ReasonGroupNotReferenced)))
+}
@@ -0,0 +1,266 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var log = &logger.Log
+
+// Inspector cross-references the NSX resources created by nsx-operator and the K8s objects owning them, it only
+// reads NSX and K8s.
+type Inspector struct {
+	common.Service
+	// owners caches the objects of each owner kind by UID.
+	owners map[schema.GroupVersionKind]map[string]types.NamespacedName
+}
+
+func NewInspector(nsxClient *nsx.Client, k8sClient client.Client) *Inspector {
+	return &Inspector{
+		Service: common.Service{Client: k8sClient, NSXClient: nsxClient},
+		owners:  map[schema.GroupVersionKind]map[string]types.NamespacedName{},
+	}
+}
+
+// Orphan is an NSX resource tagged with the cluster whose owner does not exist.
+type Orphan struct {
+	Resource Resource `json:"resource"`
+	Owner    Owner    `json:"owner"`
+}
+
+// PolicyRules are the NSX rules of a SecurityPolicy or a NetworkPolicy which selects a Pod.
+type PolicyRules struct {
+	Policy Owner      `json:"policy"`
+	Rules  []Resource `json:"rules"`
+}
+
+// OwnedResources returns the NSX resources tagged with the UID of the object of the kind.
+func (i *Inspector) OwnedResources(ctx context.Context, kind, namespace, name string) ([]Resource, error) {
+	ownerKinds := LookupOwnerKinds(kind)
+	if len(ownerKinds) == 0 {
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(ownerKinds[0].GVK)
+	key := types.NamespacedName{Name: name}
+	if ownerKinds[0].Namespaced {
+		key.Namespace = namespace
+	}
+	if err := i.Client.Get(ctx, key, obj); err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", ownerKinds[0].GVK.Kind, key, err)
+	}
+	return i.resourcesOwnedBy(ownerKinds, string(obj.UID))
+}
+
+// Owner returns the NSX resource of the path and its owner, the owner is nil if the resource has no owner tag.
+func (i *Inspector) Owner(ctx context.Context, path string) (*Resource, *Owner, error) {
+	query := clusterQuery(i.NSXClient.NsxConfig.Cluster) + " AND " + pathQuery(path)
+	resources, err := i.search(query, func(resource *Resource) bool {
+		return resource.Path == path
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(resources) == 0 {
+		return nil, nil, fmt.Errorf("NSX resource %s tagged with cluster %s not found", path, i.NSXClient.NsxConfig.Cluster)
+	}
+	owner, err := i.resolveOwner(ctx, &resources[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	return &resources[0], owner, nil
+}
+
+// EffectiveRules returns the NSX rules of the SecurityPolicies and the NetworkPolicies which select the Pod. All the
+// rules of a SecurityPolicy are returned if the Pod is selected by the policy or by any of its rules.
+func (i *Inspector) EffectiveRules(ctx context.Context, namespace, name string) ([]PolicyRules, error) {
+	pod := &v1.Pod{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+		return nil, fmt.Errorf("failed to get Pod %s/%s: %w", namespace, name, err)
+	}
+	podLabels := labels.Set(pod.Labels)
+	var result []PolicyRules
+
+	securityPolicies := &v1alpha1.SecurityPolicyList{}
+	if err := i.Client.List(ctx, securityPolicies, client.InNamespace(namespace)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, err
+	}
+	for _, policy := range securityPolicies.Items {
+		if !securityPolicySelectsPod(&policy, podLabels) {
+			continue
+		}
+		rules, err := i.resourcesOwnedBy(LookupOwnerKinds("SecurityPolicy"), string(policy.UID), common.ResourceTypeRule)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, PolicyRules{
+			Policy: Owner{Kind: "SecurityPolicy", Namespace: policy.Namespace, Name: policy.Name, UID: string(policy.UID), Exists: true},
+			Rules:  sortRules(rules),
+		})
+	}
+
+	networkPolicies := &networkingv1.NetworkPolicyList{}
+	if err := i.Client.List(ctx, networkPolicies, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, policy := range networkPolicies.Items {
+		if !selectorMatches(&policy.Spec.PodSelector, podLabels) {
+			continue
+		}
+		rules, err := i.resourcesOwnedBy(LookupOwnerKinds("NetworkPolicy"), string(policy.UID), common.ResourceTypeRule)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, PolicyRules{
+			Policy: Owner{Kind: "NetworkPolicy", Namespace: policy.Namespace, Name: policy.Name, UID: string(policy.UID), Exists: true},
+			Rules:  sortRules(rules),
+		})
+	}
+	return result, nil
+}
+
+// Orphans returns the NSX resources tagged with the cluster whose owners do not exist. The resources without owner
+// tags are not returned.
+func (i *Inspector) Orphans(ctx context.Context) ([]Orphan, error) {
+	resources, err := i.search(clusterQuery(i.NSXClient.NsxConfig.Cluster), nil)
+	if err != nil {
+		return nil, err
+	}
+	var orphans []Orphan
+	for idx := range resources {
+		owner, err := i.resolveOwner(ctx, &resources[idx])
+		if err != nil {
+			return nil, err
+		}
+		if owner == nil || owner.Exists {
+			continue
+		}
+		orphans = append(orphans, Orphan{Resource: resources[idx], Owner: *owner})
+	}
+	return orphans, nil
+}
+
+func (i *Inspector) search(query string, filter func(*Resource) bool) ([]Resource, error) {
+	return i.searchInCluster(i.NSXClient.NsxConfig.Cluster, query, filter)
+}
+
+// searchInCluster returns the resources matching the query and tagged with the cluster, the cluster tag is not
+// checked if cluster is empty.
+func (i *Inspector) searchInCluster(cluster, query string, filter func(*Resource) bool) ([]Resource, error) {
+	collector := &resourceCollector{cluster: cluster, filter: filter}
+	count, err := i.SearchResource("", query, collector, nil)
+	if err != nil {
+		log.Error(err, "Failed to search NSX resources", "query", query)
+		return nil, err
+	}
+	log.Debug("Searched NSX resources", "query", query, "count", count, "matched", len(collector.resources))
+	sort.Slice(collector.resources, func(a, b int) bool {
+		return collector.resources[a].Path < collector.resources[b].Path
+	})
+	return collector.resources, nil
+}
+
+// resourcesOwnedBy returns the NSX resources of the types tagged with the owner UID in the scopes of the owner kinds.
+func (i *Inspector) resourcesOwnedBy(ownerKinds []OwnerKind, uid string, resourceTypes ...string) ([]Resource, error) {
+	values := sets.New[string]()
+	for _, ownerKind := range ownerKinds {
+		values.Insert(ownerKind.tagValues(uid)...)
+	}
+	query := clusterQuery(i.NSXClient.NsxConfig.Cluster, resourceTypes...) + " AND " + tagValuesQuery(sets.List(values))
+	return i.search(query, func(resource *Resource) bool {
+		for _, ownerKind := range ownerKinds {
+			if value, ok := resource.TagValue(ownerKind.Scope); ok && ownerKind.ownerUID(value) == uid {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// resolveOwner returns the owner of the NSX resource by its tags, it is nil if the resource has no owner tag.
+func (i *Inspector) resolveOwner(ctx context.Context, resource *Resource) (*Owner, error) {
+	ownerKind, uid, found := findOwnerUID(resource)
+	if !found {
+		return nil, nil
+	}
+	owner := &Owner{Kind: ownerKind.GVK.Kind, UID: uid}
+	objects, err := i.listOwners(ctx, ownerKind.GVK)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := objects[uid]; ok {
+		owner.Namespace, owner.Name, owner.Exists = key.Namespace, key.Name, true
+	} else if ownerKind.Namespaced {
+		owner.Namespace, _ = resource.TagValue(common.TagScopeNamespace)
+	}
+	return owner, nil
+}
+
+// listOwners returns the objects of the kind by UID, the kinds whose CRDs are not installed have no objects.
+func (i *Inspector) listOwners(ctx context.Context, gvk schema.GroupVersionKind) (map[string]types.NamespacedName, error) {
+	if objects, ok := i.owners[gvk]; ok {
+		return objects, nil
+	}
+	objects := map[string]types.NamespacedName{}
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := i.Client.List(ctx, list); err != nil {
+		if !meta.IsNoMatchError(err) {
+			return nil, err
+		}
+		log.Info("Kind is not installed", "kind", gvk)
+	}
+	for _, item := range list.Items {
+		objects[string(item.UID)] = types.NamespacedName{Namespace: item.Namespace, Name: item.Name}
+	}
+	i.owners[gvk] = objects
+	return objects, nil
+}
+
+func securityPolicySelectsPod(policy *v1alpha1.SecurityPolicy, podLabels labels.Set) bool {
+	targets := append([]v1alpha1.SecurityPolicyTarget{}, policy.Spec.AppliedTo...)
+	for _, rule := range policy.Spec.Rules {
+		targets = append(targets, rule.AppliedTo...)
+	}
+	for _, target := range targets {
+		if target.PodSelector != nil && selectorMatches(target.PodSelector, podLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+func selectorMatches(labelSelector *metav1.LabelSelector, podLabels labels.Set) bool {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		log.Error(err, "Failed to convert label selector", "selector", labelSelector)
+		return false
+	}
+	return selector.Matches(podLabels)
+}
+
+func sortRules(rules []Resource) []Resource {
+	sort.SliceStable(rules, func(a, b int) bool {
+		return rules[a].SequenceNumber < rules[b].SequenceNumber
+	})
+	return rules
+}
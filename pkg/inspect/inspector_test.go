@@ -0,0 +1,271 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	legacyv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	cluster    = "cluster-1"
+	namespace  = "ns-1"
+	vpcPath    = "/orgs/default/projects/p1/vpcs/vpc-1"
+	subnetPath = vpcPath + "/subnets/subnet-1"
+	portPath   = subnetPath + "/ports/port-1"
+	policyPath = vpcPath + "/security-policies/sp-1"
+)
+
+func newTags(pairs ...string) []model.Tag {
+	tags := []model.Tag{{Scope: common.String(common.TagScopeCluster), Tag: common.String(cluster)}}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		tags = append(tags, model.Tag{Scope: common.String(pairs[i]), Tag: common.String(pairs[i+1])})
+	}
+	return tags
+}
+
+func toStructValue(t *testing.T, obj interface{}, bindingType bindings.BindingType) *data.StructValue {
+	dataValue, errs := common.NewConverter().ConvertToVapi(obj, bindingType)
+	require.Empty(t, errs)
+	return dataValue.(*data.StructValue)
+}
+
+// fakeNSXResources are returned by every search, the queries are checked by the filters of the collectors.
+func fakeNSXResources(t *testing.T) []*data.StructValue {
+	return []*data.StructValue{
+		toStructValue(t, model.Vpc{
+			ResourceType: common.String(common.ResourceTypeVpc),
+			Path:         common.String(vpcPath),
+			Tags:         newTags(common.TagScopeNamespace, namespace, common.TagScopeNamespaceUID, "ns-uid-1"),
+		}, model.VpcBindingType()),
+		toStructValue(t, model.VpcSubnet{
+			ResourceType: common.String(common.ResourceTypeSubnet),
+			Path:         common.String(subnetPath),
+			Tags:         newTags(common.TagScopeNamespaceUID, "ns-uid-1", common.TagScopeSubnetCRUID, "subnet-uid-1"),
+		}, model.VpcSubnetBindingType()),
+		toStructValue(t, model.VpcSubnet{
+			ResourceType: common.String(common.ResourceTypeSubnet),
+			Path:         common.String(vpcPath + "/subnets/subnet-2"),
+			Tags: []model.Tag{
+				{Scope: common.String(common.TagScopeCluster), Tag: common.String("cluster-2")},
+				{Scope: common.String(common.TagScopeSubnetCRUID), Tag: common.String("subnet-uid-1")},
+			},
+		}, model.VpcSubnetBindingType()),
+		toStructValue(t, model.VpcSubnetPort{
+			ResourceType: common.String(common.ResourceTypeSubnetPort),
+			Path:         common.String(portPath),
+			Tags:         newTags(common.TagScopeNamespace, namespace, common.TagScopePodUID, "pod-uid-deleted"),
+		}, model.VpcSubnetPortBindingType()),
+		toStructValue(t, model.Rule{
+			ResourceType:   common.String(common.ResourceTypeRule),
+			Path:           common.String(policyPath + "/rules/rule-2"),
+			Action:         common.String("DROP"),
+			SequenceNumber: common.Int64(2),
+			Tags:           newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.RuleBindingType()),
+		toStructValue(t, model.Rule{
+			ResourceType:   common.String(common.ResourceTypeRule),
+			Path:           common.String(policyPath + "/rules/rule-1"),
+			Action:         common.String("ALLOW"),
+			Direction:      common.String("IN"),
+			SequenceNumber: common.Int64(1),
+			Tags:           newTags(common.TagScopeSecurityPolicyUID, "sp-uid-1"),
+		}, model.RuleBindingType()),
+		toStructValue(t, model.Rule{
+			ResourceType: common.String(common.ResourceTypeRule),
+			Path:         common.String(vpcPath + "/security-policies/np-1_allow/rules/rule-1"),
+			Tags:         newTags(common.TagScopeNetworkPolicyUID, "np-uid-1_allow"),
+		}, model.RuleBindingType()),
+		toStructValue(t, model.Group{
+			ResourceType: common.String(common.ResourceTypeGroup),
+			Path:         common.String(vpcPath + "/groups/shared"),
+			Tags:         newTags(),
+		}, model.GroupBindingType()),
+	}
+}
+
+// createInspector returns the Inspector whose searches return the fake NSX resources and the extra resources.
+func createInspector(t *testing.T, extra ...*data.StructValue) (*Inspector, *gomonkey.Patches) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(legacyv1alpha1.AddToScheme(scheme))
+	action := v1alpha1.RuleActionAllow
+	direction := v1alpha1.RuleDirectionIn
+	objects := []client.Object{
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, UID: "ns-uid-1"}},
+		&v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "subnet-1", UID: "subnet-uid-1"}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "web", UID: "pod-uid-1", Labels: map[string]string{"app": "web"}}},
+		&v1alpha1.SecurityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "sp-1", UID: "sp-uid-1"},
+			Spec: v1alpha1.SecurityPolicySpec{Rules: []v1alpha1.SecurityPolicyRule{{
+				Action:    &action,
+				Direction: &direction,
+				AppliedTo: []v1alpha1.SecurityPolicyTarget{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}},
+			}}},
+		},
+		&v1alpha1.SecurityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "sp-2", UID: "sp-uid-2"},
+			Spec: v1alpha1.SecurityPolicySpec{AppliedTo: []v1alpha1.SecurityPolicyTarget{
+				{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}},
+		},
+		&networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "np-1", UID: "np-uid-1"},
+			Spec:       networkingv1.NetworkPolicySpec{PodSelector: metav1.LabelSelector{}},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&v1alpha1.NSXAuditReport{}).Build()
+	inspector := NewInspector(&nsx.Client{NsxConfig: &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: cluster}}}, k8sClient)
+	resources := append(fakeNSXResources(t), extra...)
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&inspector.Service), "SearchResource",
+		func(_ *common.Service, _ string, _ string, store common.Store, _ common.Filter) (uint64, error) {
+			for _, resource := range resources {
+				if err := store.TransResourceToStore(resource); err != nil {
+					return 0, err
+				}
+			}
+			return uint64(len(resources)), nil
+		})
+	return inspector, patches
+}
+
+func TestOwnedResources(t *testing.T) {
+	inspector, patches := createInspector(t)
+	defer patches.Reset()
+
+	resources, err := inspector.OwnedResources(context.TODO(), "subnet", namespace, "subnet-1")
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, subnetPath, resources[0].Path)
+
+	resources, err = inspector.OwnedResources(context.TODO(), "NetworkPolicy", namespace, "np-1")
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	assert.Equal(t, vpcPath+"/security-policies/np-1_allow/rules/rule-1", resources[0].Path)
+
+	_, err = inspector.OwnedResources(context.TODO(), "Subnet", namespace, "subnet-2")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = inspector.OwnedResources(context.TODO(), "ConfigMap", namespace, "cm")
+	assert.ErrorContains(t, err, "unsupported kind")
+}
+
+func TestOwner(t *testing.T) {
+	inspector, patches := createInspector(t)
+	defer patches.Reset()
+
+	resource, owner, err := inspector.Owner(context.TODO(), subnetPath)
+	require.NoError(t, err)
+	assert.Equal(t, common.ResourceTypeSubnet, resource.ResourceType)
+	assert.Equal(t, &Owner{Kind: "Subnet", Namespace: namespace, Name: "subnet-1", UID: "subnet-uid-1", Exists: true}, owner)
+
+	_, owner, err = inspector.Owner(context.TODO(), vpcPath)
+	require.NoError(t, err)
+	assert.Equal(t, &Owner{Kind: "Namespace", Name: namespace, UID: "ns-uid-1", Exists: true}, owner)
+
+	_, owner, err = inspector.Owner(context.TODO(), vpcPath+"/groups/shared")
+	require.NoError(t, err)
+	assert.Nil(t, owner)
+
+	_, _, err = inspector.Owner(context.TODO(), vpcPath+"/subnets/subnet-2")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestEffectiveRules(t *testing.T) {
+	inspector, patches := createInspector(t)
+	defer patches.Reset()
+
+	policies, err := inspector.EffectiveRules(context.TODO(), namespace, "web")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "sp-1", policies[0].Policy.Name)
+	require.Len(t, policies[0].Rules, 2)
+	assert.Equal(t, policyPath+"/rules/rule-1", policies[0].Rules[0].Path)
+	assert.Equal(t, "ALLOW", policies[0].Rules[0].Action)
+	assert.Equal(t, "IN", policies[0].Rules[0].Direction)
+	assert.Equal(t, policyPath+"/rules/rule-2", policies[0].Rules[1].Path)
+	assert.Equal(t, "NetworkPolicy", policies[1].Policy.Kind)
+	assert.Equal(t, "np-1", policies[1].Policy.Name)
+	require.Len(t, policies[1].Rules, 1)
+}
+
+func TestOrphans(t *testing.T) {
+	inspector, patches := createInspector(t)
+	defer patches.Reset()
+
+	orphans, err := inspector.Orphans(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+	assert.Equal(t, portPath, orphans[0].Resource.Path)
+	assert.Equal(t, Owner{Kind: "Pod", Namespace: namespace, UID: "pod-uid-deleted"}, orphans[0].Owner)
+}
+
+func TestOrphansRetainedStatefulSetPort(t *testing.T) {
+	inspector, patches := createInspector(t, toStructValue(t, model.VpcSubnetPort{
+		ResourceType: common.String(common.ResourceTypeSubnetPort),
+		Path:         common.String(subnetPath + "/ports/web-0"),
+		Tags: newTags(common.TagScopeNamespace, namespace, common.TagScopePodUID, "pod-uid-recreated",
+			common.TagScopeStatefulSetUID, "sts-uid-1"),
+	}, model.VpcSubnetPortBindingType()))
+	defer patches.Reset()
+	require.NoError(t, inspector.Client.Create(context.TODO(), &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "web", UID: "sts-uid-1"},
+	}))
+
+	// The retained port of the recreated StatefulSet Pod is owned by the StatefulSet, it is not an orphan.
+	orphans, err := inspector.Orphans(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+	assert.Equal(t, portPath, orphans[0].Resource.Path)
+
+	_, owner, err := inspector.Owner(context.TODO(), subnetPath+"/ports/web-0")
+	require.NoError(t, err)
+	assert.Equal(t, &Owner{Kind: "StatefulSet", Namespace: namespace, Name: "web", UID: "sts-uid-1", Exists: true}, owner)
+}
+
+func TestLookupOwnerKinds(t *testing.T) {
+	kinds := LookupOwnerKinds("securitypolicy")
+	require.Len(t, kinds, 2)
+	assert.Equal(t, common.TagScopeSecurityPolicyUID, kinds[0].Scope)
+	assert.Equal(t, common.TagScopeSecurityPolicyCRUID, kinds[1].Scope)
+	assert.Empty(t, LookupOwnerKinds("ConfigMap"))
+
+	networkPolicy := LookupOwnerKinds("NetworkPolicy")[0]
+	assert.Equal(t, []string{"uid_allow", "uid_isolation"}, networkPolicy.tagValues("uid"))
+	assert.Equal(t, "uid", networkPolicy.ownerUID("uid_isolation"))
+}
+
+func TestQueries(t *testing.T) {
+	assert.Equal(t, "tags.scope:nsx-op\\/cluster AND tags.tag:domain-c9\\:uuid AND marked_for_delete:false",
+		clusterQuery("domain-c9:uuid"))
+	assert.Equal(t, "resource_type:(Rule OR Group) AND tags.scope:nsx-op\\/cluster AND tags.tag:c1 AND marked_for_delete:false",
+		clusterQuery("c1", "Rule", "Group"))
+	assert.Equal(t, "tags.tag:(a OR b)", tagValuesQuery([]string{"a", "b"}))
+	assert.Equal(t, "path:\\/orgs\\/default", pathQuery("/orgs/default"))
+	assert.Equal(t, "parent_path:(\\/shares\\/a OR \\/shares\\/b)", parentPathQuery([]string{"/shares/a", "/shares/b"}))
+}
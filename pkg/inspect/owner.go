@@ -0,0 +1,109 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	legacyv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// OwnerKind is the kind of the K8s objects whose UIDs are tagged on the NSX resources with Scope.
+type OwnerKind struct {
+	Scope string
+	GVK   schema.GroupVersionKind
+	// Namespaced is false for the cluster scoped kinds.
+	Namespaced bool
+	// uidSuffixes are appended to the UIDs in the tags, e.g. a NetworkPolicy is realized as an allow and an
+	// isolation SecurityPolicy.
+	uidSuffixes []string
+}
+
+// OwnerKinds are ordered from the most specific owner, the first kind whose scope is tagged on an NSX resource owns
+// it, e.g. a Subnet is tagged with both the Subnet CR UID and the Namespace UID, it is owned by the Subnet CR. The
+// SubnetPorts of the StatefulSet Pods are retained across the Pod recreations, they are owned by the StatefulSet.
+var OwnerKinds = []OwnerKind{
+	{Scope: common.TagScopeSubnetPortCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SubnetPort"), Namespaced: true},
+	{Scope: common.TagScopeStatefulSetUID, GVK: appsv1.SchemeGroupVersion.WithKind("StatefulSet"), Namespaced: true},
+	{Scope: common.TagScopePodUID, GVK: v1.SchemeGroupVersion.WithKind("Pod"), Namespaced: true},
+	{Scope: common.TagScopeSubnetBindingCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SubnetConnectionBindingMap"), Namespaced: true},
+	{Scope: common.TagScopeSubnetIPReservationCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SubnetIPReservation"), Namespaced: true},
+	{Scope: common.TagScopeSubnetCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("Subnet"), Namespaced: true},
+	{Scope: common.TagScopeSubnetSetCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SubnetSet"), Namespaced: true},
+	{Scope: common.TagScopeIPAddressAllocationCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocation"), Namespaced: true},
+	{Scope: common.TagScopeAddressBindingCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("AddressBinding"), Namespaced: true},
+	{Scope: common.TagScopeStaticRouteCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("StaticRoute"), Namespaced: true},
+	{Scope: common.TagScopeSecurityPolicyUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SecurityPolicy"), Namespaced: true},
+	{Scope: common.TagScopeSecurityPolicyCRUID, GVK: v1alpha1.SchemeGroupVersion.WithKind("SecurityPolicy"), Namespaced: true},
+	{
+		Scope:       common.TagScopeNetworkPolicyUID,
+		GVK:         networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy"),
+		Namespaced:  true,
+		uidSuffixes: []string{common.ConnectorUnderline + common.RuleActionAllow, common.ConnectorUnderline + common.RuleActionDrop},
+	},
+	{Scope: common.TagScopeServiceUID, GVK: v1.SchemeGroupVersion.WithKind("Service"), Namespaced: true},
+	{Scope: common.TagScopeNSXServiceAccountCRUID, GVK: legacyv1alpha1.SchemeGroupVersion.WithKind("NSXServiceAccount"), Namespaced: true},
+	{Scope: common.TagScopeNamespaceUID, GVK: v1.SchemeGroupVersion.WithKind("Namespace")},
+}
+
+// LookupOwnerKinds returns the owner kinds of the kind name, the name is case-insensitive. A kind may be tagged with
+// more than one scope, e.g. the SecurityPolicy.
+func LookupOwnerKinds(kind string) []OwnerKind {
+	var kinds []OwnerKind
+	for _, ownerKind := range OwnerKinds {
+		if strings.EqualFold(ownerKind.GVK.Kind, kind) {
+			kinds = append(kinds, ownerKind)
+		}
+	}
+	return kinds
+}
+
+// tagValues returns the values of the tags for the owner UID.
+func (k OwnerKind) tagValues(uid string) []string {
+	if len(k.uidSuffixes) == 0 {
+		return []string{uid}
+	}
+	values := make([]string, 0, len(k.uidSuffixes))
+	for _, suffix := range k.uidSuffixes {
+		values = append(values, uid+suffix)
+	}
+	return values
+}
+
+// ownerUID returns the owner UID of the tag value.
+func (k OwnerKind) ownerUID(value string) string {
+	for _, suffix := range k.uidSuffixes {
+		if strings.HasSuffix(value, suffix) {
+			return strings.TrimSuffix(value, suffix)
+		}
+	}
+	return value
+}
+
+// Owner is the K8s object which owns an NSX resource.
+type Owner struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	UID       string `json:"uid"`
+	// Exists is false if no object of the kind has the UID.
+	Exists bool `json:"exists"`
+}
+
+// findOwnerUID returns the owner kind and the owner UID of the NSX resource by its tags.
+func findOwnerUID(resource *Resource) (OwnerKind, string, bool) {
+	for _, ownerKind := range OwnerKinds {
+		if value, ok := resource.TagValue(ownerKind.Scope); ok && value != "" {
+			return ownerKind, ownerKind.ownerUID(value), true
+		}
+	}
+	return OwnerKind{}, "", false
+}
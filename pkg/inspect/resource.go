@@ -0,0 +1,116 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// Resource is the summary of an NSX resource returned by the search API.
+type Resource struct {
+	ResourceType string `json:"resource_type"`
+	Path         string `json:"path"`
+	ParentPath   string `json:"parent_path,omitempty"`
+	DisplayName  string `json:"display_name,omitempty"`
+	Tags         []Tag  `json:"tags,omitempty"`
+	// Action, Direction and SequenceNumber are only set for the Rules.
+	Action         string `json:"action,omitempty"`
+	Direction      string `json:"direction,omitempty"`
+	SequenceNumber int64  `json:"sequence_number,omitempty"`
+	// SourceGroups and DestinationGroups are only set for the Rules, Scope is set for the Rules and the
+	// SecurityPolicies.
+	SourceGroups      []string `json:"source_groups,omitempty"`
+	DestinationGroups []string `json:"destination_groups,omitempty"`
+	Scope             []string `json:"scope,omitempty"`
+	// ResourceObjects are only set for the SharedResources.
+	ResourceObjects []ResourceObject `json:"resource_objects,omitempty"`
+}
+
+// ResourceObject is a resource shared by a SharedResource.
+type ResourceObject struct {
+	ResourcePath string `json:"resource_path"`
+}
+
+type Tag struct {
+	Scope string `json:"scope"`
+	Tag   string `json:"tag"`
+}
+
+// TagValue returns the value of the first tag with the scope.
+func (r *Resource) TagValue(scope string) (string, bool) {
+	for _, tag := range r.Tags {
+		if tag.Scope == scope {
+			return tag.Tag, true
+		}
+	}
+	return "", false
+}
+
+// resourceCollector is a Store which collects the search results tagged with the cluster, the filter drops the
+// resources which match the query but not the expected tag pairs. The cluster tag is not checked if cluster is empty.
+type resourceCollector struct {
+	common.SearchCollector
+	cluster   string
+	filter    func(*Resource) bool
+	resources []Resource
+}
+
+func (c *resourceCollector) TransResourceToStore(entity *data.StructValue) error {
+	content, err := cleanjson.NewDataValueToJsonEncoder().Encode(entity)
+	if err != nil {
+		return err
+	}
+	resource := Resource{}
+	if err := json.Unmarshal([]byte(content), &resource); err != nil {
+		return err
+	}
+	if value, _ := resource.TagValue(common.TagScopeCluster); c.cluster != "" && value != c.cluster {
+		return nil
+	}
+	if c.filter != nil && !c.filter(&resource) {
+		return nil
+	}
+	c.resources = append(c.resources, resource)
+	return nil
+}
+
+// clusterQuery returns the query of the resources tagged with the cluster, the resource types are optional.
+func clusterQuery(cluster string, resourceTypes ...string) string {
+	query := fmt.Sprintf("tags.scope:%s AND tags.tag:%s",
+		strings.ReplaceAll(common.TagScopeCluster, "/", "\\/"),
+		strings.ReplaceAll(cluster, ":", "\\:"))
+	if len(resourceTypes) > 0 {
+		query = fmt.Sprintf("%s:(%s) AND %s", common.ResourceType, strings.Join(resourceTypes, " OR "), query)
+	}
+	return query + " AND marked_for_delete:false"
+}
+
+// tagValuesQuery returns the query condition of the resources tagged with any of the values.
+func tagValuesQuery(values []string) string {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = strings.ReplaceAll(value, ":", "\\:")
+	}
+	return fmt.Sprintf("tags.tag:(%s)", strings.Join(escaped, " OR "))
+}
+
+func pathQuery(path string) string {
+	return fmt.Sprintf("path:%s", strings.ReplaceAll(path, "/", "\\/"))
+}
+
+// parentPathQuery returns the query condition of the resources under any of the parent paths.
+func parentPathQuery(paths []string) string {
+	escaped := make([]string, len(paths))
+	for i, path := range paths {
+		escaped[i] = strings.ReplaceAll(path, "/", "\\/")
+	}
+	return fmt.Sprintf("parent_path:(%s)", strings.Join(escaped, " OR "))
+}
@@ -21,6 +21,9 @@ const (
 	ControllerDeleteTotalKey        = "controller_delete_total"
 	ControllerDeleteSuccessTotalKey = "controller_delete_success_total"
 	ControllerDeleteFailTotalKey    = "controller_delete_fail_total"
+	NSXServiceAccountCertExpiryKey  = "nsxserviceaccount_cert_expiry_days"
+	OrphanedNSXResourcesKey         = "orphaned_nsx_resources"
+	DanglingNSXReferencesKey        = "dangling_nsx_references"
 	ScrapeTimeout                   = 30
 )
 
@@ -98,6 +101,33 @@ var (
 		},
 		[]string{"res_type"},
 	)
+	NSXServiceAccountCertExpiryDays = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      NSXServiceAccountCertExpiryKey,
+			Help:      "Days until the client cert of the NSXServiceAccount expires",
+		},
+		[]string{"namespace", "name"},
+	)
+	OrphanedNSXResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      OrphanedNSXResourcesKey,
+			Help:      "Number of NSX resources tagged with the cluster whose owners do not exist, found by the last audit",
+		},
+		[]string{"resource_type", "owner_kind"},
+	)
+	DanglingNSXReferences = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: MetricNamespace,
+			Subsystem: MetricSubsystem,
+			Name:      DanglingNSXReferencesKey,
+			Help:      "Number of dangling NSX references, e.g. Groups referenced by no Rule, found by the last audit",
+		},
+		[]string{"resource_type", "reason"},
+	)
 )
 
 var registerMetrics sync.Once
@@ -121,6 +151,9 @@ func InitializePrometheusMetrics() {
 		ControllerDeleteTotal,
 		ControllerDeleteSuccessTotal,
 		ControllerDeleteFailTotal,
+		NSXServiceAccountCertExpiryDays,
+		OrphanedNSXResources,
+		DanglingNSXReferences,
 	)
 }
 
@@ -133,3 +166,15 @@ func CounterInc(cf *config.NSXOperatorConfig, counter *prometheus.CounterVec, re
 		counter.WithLabelValues(res_type).Inc()
 	}
 }
+
+func GaugeSet(cf *config.NSXOperatorConfig, gauge *prometheus.GaugeVec, value float64, labels ...string) {
+	if AreMetricsExposed(cf) {
+		gauge.WithLabelValues(labels...).Set(value)
+	}
+}
+
+func GaugeDelete(cf *config.NSXOperatorConfig, gauge *prometheus.GaugeVec, labels ...string) {
+	if AreMetricsExposed(cf) {
+		gauge.DeleteLabelValues(labels...)
+	}
+}
@@ -0,0 +1,99 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// BundleVersion is the version of the bundle format, it is changed when the format is changed incompatibly.
+const BundleVersion = "nsx-operator.migrate/v1"
+
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Bundle is the NSX resources created by nsx-operator for a Namespace and the matching CRs.
+type Bundle struct {
+	Version string `json:"version"`
+	// Cluster is the value of the nsx-op/cluster tag of the exported NSX resources.
+	Cluster string `json:"cluster"`
+	// Namespace is the exported Namespace.
+	Namespace  string      `json:"namespace"`
+	ExportTime metav1.Time `json:"exportTime"`
+	// NSXResources are ordered by the dependencies, e.g. VPC -> Subnet -> StaticRoutes.
+	NSXResources []NSXResource `json:"nsxResources"`
+	// CRs keep the original UIDs, so that the CR UID tags of the NSX resources can be rewritten to the UIDs of
+	// the recreated CRs.
+	CRs []unstructured.Unstructured `json:"crs"`
+}
+
+// NSXResource is an NSX resource in the bundle, Object is its Policy API JSON without the read-only fields.
+type NSXResource struct {
+	ResourceType string                 `json:"resourceType"`
+	Path         string                 `json:"path"`
+	Object       map[string]interface{} `json:"object"`
+}
+
+// FormatFromFile returns the bundle format by the file extension, JSON is the default.
+func FormatFromFile(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatJSON
+	}
+}
+
+// Marshal encodes the bundle in the format.
+func (b *Bundle) Marshal(format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(b, "", "  ")
+	case FormatYAML:
+		return yaml.Marshal(b)
+	default:
+		return nil, fmt.Errorf("unsupported bundle format %q", format)
+	}
+}
+
+// UnmarshalBundle decodes the bundle in JSON or YAML, and checks its version.
+func UnmarshalBundle(content []byte) (*Bundle, error) {
+	bundle := &Bundle{}
+	// JSON is a subset of YAML, so both formats are decoded as YAML.
+	if err := yaml.Unmarshal(content, bundle); err != nil {
+		return nil, fmt.Errorf("failed to decode bundle: %w", err)
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %q, expected %q", bundle.Version, BundleVersion)
+	}
+	return bundle, nil
+}
+
+// WriteBundle writes the bundle to the file in the format of the file extension.
+func WriteBundle(bundle *Bundle, file string) error {
+	content, err := bundle.Marshal(FormatFromFile(file))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, content, 0o600)
+}
+
+// ReadBundle reads the bundle from the file.
+func ReadBundle(file string) (*Bundle, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalBundle(content)
+}
@@ -0,0 +1,64 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package migrate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	cr := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "crd.nsx.vmware.com/v1alpha1",
+		"kind":       "Subnet",
+		"metadata":   map[string]interface{}{"name": "subnet-1", "namespace": "ns-1", "uid": "uid-1"},
+		"spec":       map[string]interface{}{"ipv4SubnetSize": int64(16)},
+	}}
+	bundle := &Bundle{
+		Version:    BundleVersion,
+		Cluster:    "cluster-1",
+		Namespace:  "ns-1",
+		ExportTime: metav1.Now().Rfc3339Copy(),
+		NSXResources: []NSXResource{{
+			ResourceType: "VpcSubnet",
+			Path:         "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1",
+			Object:       map[string]interface{}{"id": "subnet-1", "ipv4_subnet_size": float64(16)},
+		}},
+		CRs: []unstructured.Unstructured{cr},
+	}
+	dir := t.TempDir()
+	for _, file := range []string{"bundle.json", "bundle.yaml"} {
+		t.Run(file, func(t *testing.T) {
+			path := filepath.Join(dir, file)
+			require.NoError(t, WriteBundle(bundle, path))
+			got, err := ReadBundle(path)
+			require.NoError(t, err)
+			assert.Equal(t, bundle.Cluster, got.Cluster)
+			assert.Equal(t, bundle.Namespace, got.Namespace)
+			assert.True(t, bundle.ExportTime.Equal(&got.ExportTime))
+			assert.Equal(t, bundle.NSXResources, got.NSXResources)
+			require.Len(t, got.CRs, 1)
+			assert.Equal(t, "uid-1", string(got.CRs[0].GetUID()))
+			size, _, _ := unstructured.NestedInt64(got.CRs[0].Object, "spec", "ipv4SubnetSize")
+			assert.Equal(t, int64(16), size)
+		})
+	}
+}
+
+func TestUnmarshalBundleVersion(t *testing.T) {
+	_, err := UnmarshalBundle([]byte(`{"version": "nsx-operator.migrate/v0"}`))
+	assert.ErrorContains(t, err, "unsupported bundle version")
+}
+
+func TestFormatFromFile(t *testing.T) {
+	assert.Equal(t, FormatYAML, FormatFromFile("bundle.YML"))
+	assert.Equal(t, FormatYAML, FormatFromFile("bundle.yaml"))
+	assert.Equal(t, FormatJSON, FormatFromFile("bundle.json"))
+	assert.Equal(t, FormatJSON, FormatFromFile("bundle"))
+}
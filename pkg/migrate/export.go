@@ -0,0 +1,173 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var log = &logger.Log
+
+// exportedResourceTypes are the NSX resource types exported, in the order they are imported.
+var exportedResourceTypes = []string{
+	common.ResourceTypeVpc,
+	common.ResourceTypeSubnet,
+	common.ResourceTypeIPAddressAllocation,
+	common.ResourceTypeStaticRoutes,
+	common.ResourceTypeGroup,
+	common.ResourceTypeSecurityPolicy,
+	common.ResourceTypeRule,
+}
+
+// exportedCRKinds are the kinds of the CRs exported, in the order they are imported.
+var exportedCRKinds = []string{"Subnet", "SubnetSet", "IPAddressAllocation", "StaticRoute", "SecurityPolicy"}
+
+// readOnlyFields are the fields set by NSX which are not accepted when the resources are imported, the fields
+// prefixed with "_" are also read-only.
+var readOnlyFields = sets.New[string]("realization_id", "unique_id", "marked_for_delete", "overridden",
+	"owner_id", "origin_site_id", "remote_path", "relative_path")
+
+// crMetadataFields are the metadata fields of the CRs set by the API server.
+var crMetadataFields = []string{"resourceVersion", "creationTimestamp", "generation", "managedFields",
+	"ownerReferences", "finalizers", "deletionTimestamp", "deletionGracePeriodSeconds", "selfLink"}
+
+// Exporter exports the NSX resources created by nsx-operator for a Namespace and the matching CRs.
+type Exporter struct {
+	common.Service
+}
+
+func NewExporter(nsxClient *nsx.Client, k8sClient client.Client) *Exporter {
+	return &Exporter{Service: common.Service{Client: k8sClient, NSXClient: nsxClient}}
+}
+
+// Export returns the bundle of the NSX resources tagged with the cluster and the namespace, and the CRs in the
+// namespace. Only the resources under the VPCs are exported.
+func (e *Exporter) Export(ctx context.Context, namespace string) (*Bundle, error) {
+	cluster := e.NSXClient.NsxConfig.Cluster
+	bundle := &Bundle{
+		Version:    BundleVersion,
+		Cluster:    cluster,
+		Namespace:  namespace,
+		ExportTime: metav1.Now(),
+	}
+	for _, resourceType := range exportedResourceTypes {
+		collector := &resourceCollector{resourceType: resourceType, cluster: cluster, namespace: namespace}
+		count, err := e.SearchResource(resourceType, exportQuery(resourceType, cluster, namespace), collector, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", resourceType, err)
+		}
+		log.Info("Exported NSX resources", "resourceType", resourceType, "found", count, "exported", len(collector.resources))
+		bundle.NSXResources = append(bundle.NSXResources, collector.resources...)
+	}
+	for _, kind := range exportedCRKinds {
+		crs, err := e.exportCRs(ctx, kind, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s CRs: %w", kind, err)
+		}
+		log.Info("Exported CRs", "kind", kind, "count", len(crs))
+		bundle.CRs = append(bundle.CRs, crs...)
+	}
+	return bundle, nil
+}
+
+func (e *Exporter) exportCRs(ctx context.Context, kind, namespace string) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(kind + "List"))
+	if err := e.Client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	var crs []unstructured.Unstructured
+	for _, cr := range list.Items {
+		if cr.GetDeletionTimestamp() != nil {
+			continue
+		}
+		sanitizeCR(&cr)
+		crs = append(crs, cr)
+	}
+	return crs, nil
+}
+
+// sanitizeCR removes the status and the metadata set by the API server, the UID is kept to map the CR UID tags.
+func sanitizeCR(cr *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(cr.Object, "status")
+	for _, field := range crMetadataFields {
+		unstructured.RemoveNestedField(cr.Object, "metadata", field)
+	}
+}
+
+// exportQuery returns the query of the NSX resources tagged with the cluster and the Namespace. The NSX resources for
+// the VMs, e.g. the Subnets of the Subnet CRs and the VM SubnetSets, are tagged with the VM Namespace scope instead.
+func exportQuery(resourceType, cluster, namespace string) string {
+	return fmt.Sprintf("%s AND tags.scope:(%s OR %s) AND tags.tag:%s AND marked_for_delete:false",
+		common.QueryTagCondition(resourceType, cluster),
+		strings.ReplaceAll(common.TagScopeNamespace, "/", "\\/"),
+		strings.ReplaceAll(common.TagScopeVMNamespace, "/", "\\/"),
+		strings.ReplaceAll(namespace, ":", "\\:"))
+}
+
+// resourceCollector is a Store which collects the search results as NSXResources.
+type resourceCollector struct {
+	common.SearchCollector
+	resourceType string
+	cluster      string
+	namespace    string
+	resources    []NSXResource
+}
+
+func (c *resourceCollector) TransResourceToStore(entity *data.StructValue) error {
+	content, err := cleanjson.NewDataValueToJsonEncoder().Encode(entity)
+	if err != nil {
+		return err
+	}
+	obj := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewBufferString(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return err
+	}
+	path, _ := obj["path"].(string)
+	// The search matches the tag scopes and values separately, so the tag pairs are checked again.
+	if !hasTag(obj, common.TagScopeCluster, c.cluster) ||
+		(!hasTag(obj, common.TagScopeNamespace, c.namespace) && !hasTag(obj, common.TagScopeVMNamespace, c.namespace)) {
+		return nil
+	}
+	if _, err := common.ParseVPCResourcePath(path); err != nil {
+		log.Info("Skipped NSX resource not in VPC", "resourceType", c.resourceType, "path", path)
+		return nil
+	}
+	for field := range obj {
+		if strings.HasPrefix(field, "_") || readOnlyFields.Has(field) {
+			delete(obj, field)
+		}
+	}
+	c.resources = append(c.resources, NSXResource{ResourceType: c.resourceType, Path: path, Object: obj})
+	return nil
+}
+
+func hasTag(obj map[string]interface{}, scope, value string) bool {
+	tags, _ := obj["tags"].([]interface{})
+	for _, t := range tags {
+		tag, _ := t.(map[string]interface{})
+		if tag["scope"] == scope && tag["tag"] == value {
+			return true
+		}
+	}
+	return false
+}
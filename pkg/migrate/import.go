@@ -0,0 +1,285 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// ImportOptions are the target of the import, the values of the bundle are used for the empty options.
+type ImportOptions struct {
+	// Cluster is the new value of the nsx-op/cluster tag.
+	Cluster string
+	// ProjectPath is the path of the NSX project the VPCs are recreated in, e.g. /orgs/default/projects/p1.
+	ProjectPath string
+	// Namespace is the Namespace the CRs are recreated in, it must exist.
+	Namespace string
+}
+
+// Importer recreates the NSX resources and the CRs of a bundle. nsx-operator should be stopped in the target cluster
+// during the import, otherwise it may realize the recreated CRs before their NSX resources are imported.
+type Importer struct {
+	common.Service
+}
+
+func NewImporter(nsxClient *nsx.Client, k8sClient client.Client) *Importer {
+	return &Importer{Service: common.Service{Client: k8sClient, NSXClient: nsxClient}}
+}
+
+// Import creates the CRs in the bundle first to get their new UIDs, then patches the NSX resources with the cluster,
+// project, Namespace and CR UIDs rewritten.
+func (i *Importer) Import(ctx context.Context, bundle *Bundle, options ImportOptions) error {
+	if options.Cluster == "" {
+		options.Cluster = bundle.Cluster
+	}
+	if options.Namespace == "" {
+		options.Namespace = bundle.Namespace
+	}
+	namespace := &v1.Namespace{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: options.Namespace}, namespace); err != nil {
+		return fmt.Errorf("failed to get Namespace %s: %w", options.Namespace, err)
+	}
+	uids, err := i.importCRs(ctx, bundle, options.Namespace)
+	if err != nil {
+		return err
+	}
+	rewriter := &tagRewriter{
+		cluster:      options.Cluster,
+		namespace:    options.Namespace,
+		namespaceUID: string(namespace.UID),
+		uids:         uids,
+	}
+	resources := sortNSXResources(bundle.NSXResources)
+	for _, resource := range resources {
+		object := rewriter.rewrite(resource.Object, options.ProjectPath)
+		path, _ := object["path"].(string)
+		if err := i.patchNSXResource(resource.ResourceType, path, object); err != nil {
+			return fmt.Errorf("failed to import %s %s: %w", resource.ResourceType, resource.Path, err)
+		}
+		log.Info("Imported NSX resource", "resourceType", resource.ResourceType, "path", path)
+	}
+	return nil
+}
+
+// importCRs creates the CRs in the namespace and returns the map from the UIDs in the bundle to the UIDs of the
+// created CRs. The existing CRs are not updated.
+func (i *Importer) importCRs(ctx context.Context, bundle *Bundle, namespace string) (map[string]string, error) {
+	uids := map[string]string{}
+	for _, item := range bundle.CRs {
+		cr := item.DeepCopy()
+		oldUID := string(cr.GetUID())
+		cr.SetUID("")
+		cr.SetNamespace(namespace)
+		if err := i.Client.Create(ctx, cr); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				return nil, fmt.Errorf("failed to create %s %s/%s: %w", cr.GetKind(), namespace, cr.GetName(), err)
+			}
+			if err := i.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+				return nil, err
+			}
+			log.Info("CR already exists", "kind", cr.GetKind(), "namespace", namespace, "name", cr.GetName())
+		} else {
+			log.Info("Imported CR", "kind", cr.GetKind(), "namespace", namespace, "name", cr.GetName())
+		}
+		if oldUID != "" {
+			uids[oldUID] = string(cr.GetUID())
+		}
+	}
+	return uids, nil
+}
+
+func (i *Importer) patchNSXResource(resourceType, path string, object interface{}) error {
+	info, err := common.ParseVPCResourcePath(path)
+	if err != nil {
+		return err
+	}
+	switch resourceType {
+	case common.ResourceTypeVpc:
+		obj, err := convertNSXResource[model.Vpc](object, model.VpcBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.VPCClient.Patch(info.OrgID, info.ProjectID, info.ID, obj)
+	case common.ResourceTypeSubnet:
+		obj, err := convertNSXResource[model.VpcSubnet](object, model.VpcSubnetBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.SubnetsClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ID, obj)
+	case common.ResourceTypeIPAddressAllocation:
+		obj, err := convertNSXResource[model.VpcIpAddressAllocation](object, model.VpcIpAddressAllocationBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.IPAddressAllocationClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ID, obj)
+	case common.ResourceTypeStaticRoutes:
+		obj, err := convertNSXResource[model.StaticRoutes](object, model.StaticRoutesBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.StaticRouteClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ID, obj)
+	case common.ResourceTypeGroup:
+		obj, err := convertNSXResource[model.Group](object, model.GroupBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.VpcGroupClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ID, obj)
+	case common.ResourceTypeSecurityPolicy:
+		obj, err := convertNSXResource[model.SecurityPolicy](object, model.SecurityPolicyBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.VPCSecurityClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ID, obj)
+	case common.ResourceTypeRule:
+		obj, err := convertNSXResource[model.Rule](object, model.RuleBindingType())
+		if err != nil {
+			return err
+		}
+		return i.NSXClient.VPCRuleClient.Patch(info.OrgID, info.ProjectID, info.VPCID, info.ParentID, info.ID, obj)
+	default:
+		return fmt.Errorf("unsupported resource type %s", resourceType)
+	}
+}
+
+// convertNSXResource converts the Policy API JSON object to the NSX model. The object is encoded again to decode the
+// numbers as json.Number, which is required to distinguish the integers from the floats.
+func convertNSXResource[T any](object interface{}, bindingType bindings.BindingType) (T, error) {
+	var result T
+	content, err := json.Marshal(object)
+	if err != nil {
+		return result, err
+	}
+	var jsonObject interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&jsonObject); err != nil {
+		return result, err
+	}
+	dataValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(jsonObject)
+	if err != nil {
+		return result, err
+	}
+	obj, errs := common.NewConverter().ConvertToGolang(dataValue, bindingType)
+	if len(errs) > 0 {
+		return result, errs[0]
+	}
+	result, ok := obj.(T)
+	if !ok {
+		return result, fmt.Errorf("unexpected type %T", obj)
+	}
+	return result, nil
+}
+
+// sortNSXResources returns the resources ordered by exportedResourceTypes, so that the parents are created first.
+func sortNSXResources(resources []NSXResource) []NSXResource {
+	order := map[string]int{}
+	for i, resourceType := range exportedResourceTypes {
+		order[resourceType] = i
+	}
+	sorted := make([]NSXResource, len(resources))
+	copy(sorted, resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return order[sorted[i].ResourceType] < order[sorted[j].ResourceType]
+	})
+	return sorted
+}
+
+// tagRewriter rewrites the exported NSX resources for the target cluster, project and Namespace.
+type tagRewriter struct {
+	cluster      string
+	namespace    string
+	namespaceUID string
+	// uids maps the UIDs of the exported CRs to the UIDs of the imported CRs.
+	uids map[string]string
+}
+
+// rewrite returns a copy of the object with the nsx-operator tags rewritten, and the project prefix of the paths
+// replaced with projectPath if it is set.
+func (r *tagRewriter) rewrite(object map[string]interface{}, projectPath string) map[string]interface{} {
+	oldPrefix, newPrefix := "", ""
+	if path, ok := object["path"].(string); ok && projectPath != "" {
+		if info, err := common.ParseVPCResourcePath(path); err == nil {
+			oldPrefix = fmt.Sprintf("/orgs/%s/projects/%s/", info.OrgID, info.ProjectID)
+			newPrefix = strings.TrimSuffix(projectPath, "/") + "/"
+		}
+	}
+	return r.walk(object, "", oldPrefix, newPrefix).(map[string]interface{})
+}
+
+func (r *tagRewriter) walk(value interface{}, key, oldPrefix, newPrefix string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, field := range v {
+			result[k] = r.walk(field, k, oldPrefix, newPrefix)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			if tag, ok := item.(map[string]interface{}); ok && key == "tags" {
+				result[i] = r.rewriteTag(tag)
+				continue
+			}
+			result[i] = r.walk(item, key, oldPrefix, newPrefix)
+		}
+		return result
+	case string:
+		if key == "value" {
+			// The Condition values of the Groups are in the format "scope|tag".
+			if scope, tag, found := strings.Cut(v, "|"); found {
+				v = scope + "|" + r.rewriteTagValue(scope, tag)
+			}
+		}
+		if oldPrefix != "" && strings.Contains(v, oldPrefix) {
+			v = strings.ReplaceAll(v, oldPrefix, newPrefix)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (r *tagRewriter) rewriteTag(tag map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tag))
+	for k, v := range tag {
+		result[k] = v
+	}
+	scope, _ := tag["scope"].(string)
+	if value, ok := tag["tag"].(string); ok {
+		result["tag"] = r.rewriteTagValue(scope, value)
+	}
+	return result
+}
+
+func (r *tagRewriter) rewriteTagValue(scope, value string) string {
+	switch scope {
+	case common.TagScopeCluster:
+		return r.cluster
+	case common.TagScopeNamespace, common.TagScopeVMNamespace:
+		return r.namespace
+	case common.TagScopeNamespaceUID, common.TagScopeVMNamespaceUID:
+		return r.namespaceUID
+	}
+	if uid, ok := r.uids[value]; ok {
+		return uid
+	}
+	return value
+}
@@ -0,0 +1,166 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func TestTagRewriter(t *testing.T) {
+	rewriter := &tagRewriter{
+		cluster:      "cluster-2",
+		namespace:    "ns-2",
+		namespaceUID: "ns-uid-2",
+		uids:         map[string]string{"cr-uid-1": "cr-uid-2"},
+	}
+	object := map[string]interface{}{
+		"id":   "subnet-1",
+		"path": "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1",
+		"tags": []interface{}{
+			map[string]interface{}{"scope": common.TagScopeCluster, "tag": "cluster-1"},
+			map[string]interface{}{"scope": common.TagScopeNamespace, "tag": "ns-1"},
+			map[string]interface{}{"scope": common.TagScopeNamespaceUID, "tag": "ns-uid-1"},
+			map[string]interface{}{"scope": common.TagScopeVMNamespace, "tag": "ns-1"},
+			map[string]interface{}{"scope": common.TagScopeVMNamespaceUID, "tag": "ns-uid-1"},
+			map[string]interface{}{"scope": common.TagScopeSubnetCRUID, "tag": "cr-uid-1"},
+			map[string]interface{}{"scope": common.TagScopeSubnetCRName, "tag": "subnet-1"},
+		},
+		"expression": []interface{}{
+			map[string]interface{}{"value": common.TagScopeNamespace + "|ns-1"},
+		},
+		"ip_addresses":     []interface{}{"10.0.0.0/28"},
+		"ipv4_subnet_size": float64(16),
+	}
+
+	t.Run("Rewrite tags and project", func(t *testing.T) {
+		got := rewriter.rewrite(object, "/orgs/default/projects/p2/")
+		assert.Equal(t, "/orgs/default/projects/p2/vpcs/vpc-1/subnets/subnet-1", got["path"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"scope": common.TagScopeCluster, "tag": "cluster-2"},
+			map[string]interface{}{"scope": common.TagScopeNamespace, "tag": "ns-2"},
+			map[string]interface{}{"scope": common.TagScopeNamespaceUID, "tag": "ns-uid-2"},
+			map[string]interface{}{"scope": common.TagScopeVMNamespace, "tag": "ns-2"},
+			map[string]interface{}{"scope": common.TagScopeVMNamespaceUID, "tag": "ns-uid-2"},
+			map[string]interface{}{"scope": common.TagScopeSubnetCRUID, "tag": "cr-uid-2"},
+			map[string]interface{}{"scope": common.TagScopeSubnetCRName, "tag": "subnet-1"},
+		}, got["tags"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"value": common.TagScopeNamespace + "|ns-2"},
+		}, got["expression"])
+		assert.Equal(t, []interface{}{"10.0.0.0/28"}, got["ip_addresses"])
+		assert.Equal(t, float64(16), got["ipv4_subnet_size"])
+		// The exported object is not changed.
+		assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1", object["path"])
+		assert.Equal(t, "cluster-1", object["tags"].([]interface{})[0].(map[string]interface{})["tag"])
+	})
+
+	t.Run("Keep project", func(t *testing.T) {
+		got := rewriter.rewrite(object, "")
+		assert.Equal(t, object["path"], got["path"])
+	})
+}
+
+func TestSortNSXResources(t *testing.T) {
+	resources := []NSXResource{
+		{ResourceType: common.ResourceTypeRule, Path: "rule"},
+		{ResourceType: common.ResourceTypeSubnet, Path: "subnet-1"},
+		{ResourceType: common.ResourceTypeVpc, Path: "vpc"},
+		{ResourceType: common.ResourceTypeSecurityPolicy, Path: "policy"},
+		{ResourceType: common.ResourceTypeSubnet, Path: "subnet-2"},
+	}
+	var paths []string
+	for _, resource := range sortNSXResources(resources) {
+		paths = append(paths, resource.Path)
+	}
+	assert.Equal(t, []string{"vpc", "subnet-1", "subnet-2", "policy", "rule"}, paths)
+	assert.Equal(t, "rule", resources[0].Path)
+}
+
+func TestConvertNSXResource(t *testing.T) {
+	object := map[string]interface{}{
+		"id":               "subnet-1",
+		"path":             "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1",
+		"ipv4_subnet_size": float64(16),
+		"tags": []interface{}{
+			map[string]interface{}{"scope": common.TagScopeCluster, "tag": "cluster-1"},
+		},
+	}
+	subnet, err := convertNSXResource[model.VpcSubnet](object, model.VpcSubnetBindingType())
+	require.NoError(t, err)
+	assert.Equal(t, "subnet-1", *subnet.Id)
+	assert.Equal(t, int64(16), *subnet.Ipv4SubnetSize)
+	assert.Equal(t, "cluster-1", *subnet.Tags[0].Tag)
+}
+
+func TestSanitizeCR(t *testing.T) {
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Subnet",
+		"metadata": map[string]interface{}{
+			"name":            "subnet-1",
+			"uid":             "uid-1",
+			"resourceVersion": "10",
+			"finalizers":      []interface{}{"finalizer"},
+			"labels":          map[string]interface{}{"app": "test"},
+		},
+		"spec":   map[string]interface{}{"ipv4SubnetSize": int64(16)},
+		"status": map[string]interface{}{"networkAddresses": []interface{}{"10.0.0.0/28"}},
+	}}
+	sanitizeCR(cr)
+	assert.Equal(t, map[string]interface{}{
+		"kind": "Subnet",
+		"metadata": map[string]interface{}{
+			"name":   "subnet-1",
+			"uid":    "uid-1",
+			"labels": map[string]interface{}{"app": "test"},
+		},
+		"spec": map[string]interface{}{"ipv4SubnetSize": int64(16)},
+	}, cr.Object)
+}
+
+func TestResourceCollector(t *testing.T) {
+	collector := &resourceCollector{resourceType: common.ResourceTypeSubnet, cluster: "cluster-1", namespace: "ns-1"}
+	newSubnet := func(path, namespaceScope, namespace string) model.VpcSubnet {
+		return model.VpcSubnet{
+			Id:            common.String("subnet-1"),
+			Path:          common.String(path),
+			RealizationId: common.String("realization"),
+			Tags: []model.Tag{
+				{Scope: common.String(common.TagScopeCluster), Tag: common.String("cluster-1")},
+				{Scope: common.String(namespaceScope), Tag: common.String(namespace)},
+			},
+		}
+	}
+	for _, subnet := range []model.VpcSubnet{
+		newSubnet("/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1", common.TagScopeNamespace, "ns-1"),
+		// The Subnet of the Subnet CR is only tagged with the VM Namespace.
+		newSubnet("/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-4", common.TagScopeVMNamespace, "ns-1"),
+		// The tag values match the query, but the tag pair does not.
+		newSubnet("/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-2", common.TagScopeNamespace, "cluster-1"),
+		newSubnet("/infra/segments/subnet-3", common.TagScopeNamespace, "ns-1"),
+	} {
+		dataValue, errs := common.NewConverter().ConvertToVapi(subnet, model.VpcSubnetBindingType())
+		require.Empty(t, errs)
+		require.NoError(t, collector.TransResourceToStore(dataValue.(*data.StructValue)))
+	}
+	require.Len(t, collector.resources, 2)
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1", collector.resources[0].Path)
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-4", collector.resources[1].Path)
+	assert.NotContains(t, collector.resources[0].Object, "realization_id")
+	assert.Equal(t, "subnet-1", collector.resources[0].Object["id"])
+}
+
+func TestExportQuery(t *testing.T) {
+	query := exportQuery(common.ResourceTypeSubnet, "cluster-1", "ns:1")
+	assert.Contains(t, query, "tags.scope:(nsx-op\\/namespace OR nsx-op\\/vm_namespace) AND tags.tag:ns\\:1")
+	assert.True(t, strings.HasSuffix(query, "AND marked_for_delete:false"))
+}
@@ -83,6 +83,21 @@ func (mr *MockDNSRecordProviderMockRecorder) ValidateEndpointsByZone(namespace,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateEndpointsByZone", reflect.TypeOf((*MockDNSRecordProvider)(nil).ValidateEndpointsByZone), namespace, owner, eps)
 }
 
+// ListRecordPathsToDeleteByOwnerNN mocks base method.
+func (m *MockDNSRecordProvider) ListRecordPathsToDeleteByOwnerNN(kind, namespace, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecordPathsToDeleteByOwnerNN", kind, namespace, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecordPathsToDeleteByOwnerNN indicates an expected call of ListRecordPathsToDeleteByOwnerNN.
+func (mr *MockDNSRecordProviderMockRecorder) ListRecordPathsToDeleteByOwnerNN(kind, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecordPathsToDeleteByOwnerNN", reflect.TypeOf((*MockDNSRecordProvider)(nil).ListRecordPathsToDeleteByOwnerNN), kind, namespace, name)
+}
+
 // ListReferredGatewayNN mocks base method.
 func (m *MockDNSRecordProvider) ListReferredGatewayNN() sets.Set[types.NamespacedName] {
 	m.ctrl.T.Helper()
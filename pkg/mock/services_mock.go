@@ -92,6 +92,10 @@ func (m *MockSubnetServiceProvider) CreateOrUpdateSubnet(obj client.Object, vpcI
 	return arg.Get(0).(*model.VpcSubnet), arg.Error(1)
 }
 
+func (m *MockSubnetServiceProvider) CheckSubnetSetSubnetRealizeState(subnetSet *v1alpha1.SubnetSet, nsxSubnet *model.VpcSubnet) error {
+	return nil
+}
+
 func (m *MockSubnetServiceProvider) GenerateSubnetNSTags(obj client.Object) []model.Tag {
 	m.Called()
 	return []model.Tag{}
@@ -116,6 +116,47 @@ func GetVPCFullID(orgID, projectID, vpcID string, vpcService VPCServiceProvider)
 	return vpcFullID, nil
 }
 
+// GetVPCInfoByName returns the VPC of the Namespace selected by vpcName.
+// vpcName is either the name of an additional VPC in the VPCNetworkConfiguration of the Namespace,
+// or a VPC full ID generated by GetVPCFullID. The primary VPC is returned if vpcName is empty.
+func GetVPCInfoByName(vpcService VPCServiceProvider, ns, vpcName string) (*VPCResourceInfo, error) {
+	vpcInfoList := vpcService.ListVPCInfo(ns)
+	if vpcName == "" {
+		if len(vpcInfoList) == 0 {
+			return nil, fmt.Errorf("no VPC found for Namespace %s", ns)
+		}
+		return &vpcInfoList[0], nil
+	}
+	projectID, vpcID, isFullID := strings.Cut(vpcName, ":")
+	matchFullID := func(info VPCResourceInfo) bool {
+		return isFullID && info.VPCID == vpcID && (projectID == "" || projectID == info.ProjectID)
+	}
+	for i := range vpcInfoList {
+		if matchFullID(vpcInfoList[i]) {
+			return &vpcInfoList[i], nil
+		}
+	}
+
+	nc, err := vpcService.GetVPCNetworkConfigByNamespace(ns)
+	if err != nil {
+		return nil, err
+	}
+	if nc == nil {
+		return nil, fmt.Errorf("no VPCNetworkConfiguration found for Namespace %s", ns)
+	}
+	for _, additionalVPC := range nc.Spec.AdditionalVPCs {
+		vpcInfo, err := ParseVPCResourcePath(additionalVPC.VPC)
+		if err != nil {
+			log.Error(err, "Failed to get VPC info from additional VPC path", "VPCPath", additionalVPC.VPC)
+			continue
+		}
+		if additionalVPC.Name == vpcName || matchFullID(vpcInfo) {
+			return &vpcInfo, nil
+		}
+	}
+	return nil, fmt.Errorf("VPC %s is not associated with Namespace %s", vpcName, ns)
+}
+
 func GetSubnetPathFromAssociatedResource(associatedResource string) (string, error) {
 	// associatedResource has the format projectID:vpcID:subnetID
 	parts := strings.Split(associatedResource, ":")
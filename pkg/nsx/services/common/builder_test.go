@@ -335,3 +335,101 @@ func TestConvertSubnetPathToAssociatedResource(t *testing.T) {
 		})
 	}
 }
+
+type fakeVPCServiceProvider struct {
+	VPCServiceProvider
+	vpcInfoList []VPCResourceInfo
+	nc          *v1alpha1.VPCNetworkConfiguration
+}
+
+func (f *fakeVPCServiceProvider) ListVPCInfo(_ string) []VPCResourceInfo {
+	return f.vpcInfoList
+}
+
+func (f *fakeVPCServiceProvider) GetVPCNetworkConfigByNamespace(_ string) (*v1alpha1.VPCNetworkConfiguration, error) {
+	return f.nc, nil
+}
+
+func TestGetVPCInfoByName(t *testing.T) {
+	primaryVPC := VPCResourceInfo{OrgID: "default", ProjectID: "proj-1", VPCID: "vpc-1"}
+	nc := &v1alpha1.VPCNetworkConfiguration{
+		Spec: v1alpha1.VPCNetworkConfigurationSpec{
+			AdditionalVPCs: []v1alpha1.AdditionalVPC{
+				{Name: "invalid", VPC: "invalid-path"},
+				{Name: "data", VPC: "/orgs/default/projects/proj-2/vpcs/data-vpc"},
+			},
+		},
+	}
+	dataVPC := &VPCResourceInfo{OrgID: "default", ProjectID: "proj-2", VPCID: "data-vpc", ID: "data-vpc", ParentID: "proj-2"}
+	tests := []struct {
+		name              string
+		vpcInfoList       []VPCResourceInfo
+		nc                *v1alpha1.VPCNetworkConfiguration
+		vpcName           string
+		expectedVPC       *VPCResourceInfo
+		expectedErrString string
+	}{
+		{
+			name:        "Primary VPC",
+			vpcInfoList: []VPCResourceInfo{primaryVPC},
+			expectedVPC: &primaryVPC,
+		},
+		{
+			name:              "No primary VPC",
+			expectedErrString: "no VPC found for Namespace ns-1",
+		},
+		{
+			name:        "Primary VPC full ID",
+			vpcInfoList: []VPCResourceInfo{primaryVPC},
+			vpcName:     "proj-1:vpc-1",
+			expectedVPC: &primaryVPC,
+		},
+		{
+			name:        "Primary VPC full ID in default project",
+			vpcInfoList: []VPCResourceInfo{primaryVPC},
+			vpcName:     ":vpc-1",
+			expectedVPC: &primaryVPC,
+		},
+		{
+			name:        "Additional VPC name",
+			vpcInfoList: []VPCResourceInfo{primaryVPC},
+			nc:          nc,
+			vpcName:     "data",
+			expectedVPC: dataVPC,
+		},
+		{
+			name:        "Additional VPC full ID",
+			vpcInfoList: []VPCResourceInfo{primaryVPC},
+			nc:          nc,
+			vpcName:     "proj-2:data-vpc",
+			expectedVPC: dataVPC,
+		},
+		{
+			name:              "VPC not associated",
+			vpcInfoList:       []VPCResourceInfo{primaryVPC},
+			nc:                nc,
+			vpcName:           "mgmt",
+			expectedErrString: "VPC mgmt is not associated with Namespace ns-1",
+		},
+		{
+			name:              "No VPCNetworkConfiguration",
+			vpcInfoList:       []VPCResourceInfo{primaryVPC},
+			vpcName:           "data",
+			expectedErrString: "no VPCNetworkConfiguration found for Namespace ns-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcService := &fakeVPCServiceProvider{vpcInfoList: tt.vpcInfoList, nc: tt.nc}
+			vpcInfo, err := GetVPCInfoByName(vpcService, "ns-1", tt.vpcName)
+			if tt.expectedErrString != "" {
+				assert.ErrorContains(t, err, tt.expectedErrString)
+				assert.Nil(t, vpcInfo)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedVPC, vpcInfo)
+			}
+		})
+	}
+}
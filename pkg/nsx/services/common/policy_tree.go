@@ -395,12 +395,10 @@ func (b *PolicyTreeBuilder[T]) BuildRootNode(resources []T, parentPath string) *
 			resType: b.rootType,
 		},
 	}
-	leafPathKey := b.pathFormat[len(b.pathFormat)-1]
 	for _, res := range resources {
 		var path string
 		if parentPath != "" {
-			idValue := b.idGetter(res)
-			path = fmt.Sprintf("%s/%s/%s", parentPath, leafPathKey, *idValue)
+			path = b.LeafPath(parentPath, res)
 		} else {
 			pathValue := b.pathGetter(res)
 			if pathValue == nil {
@@ -418,6 +416,11 @@ func (b *PolicyTreeBuilder[T]) BuildRootNode(resources []T, parentPath string) *
 	return rootNode
 }
 
+// LeafPath returns the path of the resource under the parent path, e.g. the VPC path of a VpcSubnet.
+func (b *PolicyTreeBuilder[T]) LeafPath(parentPath string, res T) string {
+	return fmt.Sprintf("%s/%s/%s", parentPath, b.pathFormat[len(b.pathFormat)-1], *b.idGetter(res))
+}
+
 func (b *PolicyTreeBuilder[T]) buildTree(resources []T, parentPath string) ([]*data.StructValue, error) {
 	rootNode := b.BuildRootNode(resources, parentPath)
 	children, err := rootNode.buildTree(b.rootType, b.leafType)
@@ -527,6 +530,38 @@ func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClie
 		return nil
 	}
 
+	if err := b.patchResourcesOnNSX(objects, nsxClient); err != nil {
+		// Log failure for each resource
+		for _, obj := range objects {
+			id, name, path := b.getResourceInfo(obj)
+			log.Error(err, "Failed to delete resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name, "resourcePath", path)
+		}
+		return err
+	}
+	// Log success for each resource
+	for _, obj := range objects {
+		id, name, _ := b.getResourceInfo(obj)
+		log.Info("Successfully deleted resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name)
+	}
+	return nil
+}
+
+func (b *PolicyTreeBuilder[T]) getResourceInfo(obj T) (string, string, string) {
+	var id, name, path string
+	if resID := b.idGetter(obj); resID != nil {
+		id = *resID
+	}
+	if resName := getNSXResourceName(obj); resName != nil {
+		name = *resName
+	}
+	if resPath := b.pathGetter(obj); resPath != nil {
+		path = *resPath
+	}
+	return id, name, path
+}
+
+// patchResourcesOnNSX merges the resources into one OrgRoot or Infra tree and patches it with H-API.
+func (b *PolicyTreeBuilder[T]) patchResourcesOnNSX(objects []T, nsxClient *nsx.Client) error {
 	enforceRevisionCheckParam := false
 	if b.rootType == ResourceTypeOrgRoot {
 		orgRoot, err := b.BuildOrgRoot(objects, "")
@@ -534,41 +569,7 @@ func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClie
 			log.Error(err, "Failed to generate OrgRoot with multiple resources", "resourceType", b.leafType)
 			return err
 		}
-		if err = nsxClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
-			err = util.TransNSXApiError(err)
-			// Log failure for each resource
-			for _, obj := range objects {
-				resID := b.idGetter(obj)
-				resName := getNSXResourceName(obj)
-				resPath := b.pathGetter(obj)
-				var id, name, path string
-				if resID != nil {
-					id = *resID
-				}
-				if resName != nil {
-					name = *resName
-				}
-				if resPath != nil {
-					path = *resPath
-				}
-				log.Error(err, "Failed to delete resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name, "resourcePath", path)
-			}
-			return err
-		}
-		// Log success for each resource
-		for _, obj := range objects {
-			resID := b.idGetter(obj)
-			resName := getNSXResourceName(obj)
-			var id, name string
-			if resID != nil {
-				id = *resID
-			}
-			if resName != nil {
-				name = *resName
-			}
-			log.Info("Successfully deleted resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name)
-		}
-		return nil
+		return util.TransNSXApiError(nsxClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam))
 	}
 
 	infraRoot, err := b.BuildInfra(objects, "")
@@ -576,42 +577,7 @@ func (b *PolicyTreeBuilder[T]) UpdateMultipleResourcesOnNSX(objects []T, nsxClie
 		log.Error(err, "Failed to generate Infra with multiple resources", "resourceType", b.leafType)
 		return err
 	}
-	if err = nsxClient.InfraClient.Patch(*infraRoot, &enforceRevisionCheckParam); err != nil {
-		err = util.TransNSXApiError(err)
-		// Log failure for each resource
-		for _, obj := range objects {
-			resID := b.idGetter(obj)
-			resName := getNSXResourceName(obj)
-			resPath := b.pathGetter(obj)
-			var id, name, path string
-			if resID != nil {
-				id = *resID
-			}
-			if resName != nil {
-				name = *resName
-			}
-			if resPath != nil {
-				path = *resPath
-			}
-			log.Error(err, "Failed to delete resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name, "resourcePath", path)
-		}
-		return err
-	}
-	// Log success for each resource
-	for _, obj := range objects {
-		resID := b.idGetter(obj)
-		resName := getNSXResourceName(obj)
-		var id, name string
-		if resID != nil {
-			id = *resID
-		}
-		if resName != nil {
-			name = *resName
-		}
-		log.Info("Successfully deleted resource", "resourceType", b.leafType, "resourceID", id, "resourceName", name)
-	}
-
-	return nil
+	return util.TransNSXApiError(nsxClient.InfraClient.Patch(*infraRoot, &enforceRevisionCheckParam))
 }
 
 func PagingNSXResources[T any](resources []T, pageSize int) [][]T {
@@ -650,6 +616,64 @@ func (p *PolicyResourcePath[T]) NewPolicyTreeBuilder() (*PolicyTreeBuilder[T], e
 	}, nil
 }
 
+// ResourceUpdateFailure is a resource which failed to be updated in a batch.
+type ResourceUpdateFailure struct {
+	ID   string
+	Name string
+	Path string
+	Err  error
+}
+
+// BatchUpdateError reports the resources which failed to be updated by PagingUpdateResources, the other resources in
+// the same batches have been updated.
+type BatchUpdateError struct {
+	ResourceType string
+	Failures     []ResourceUpdateFailure
+}
+
+func (e *BatchUpdateError) Error() string {
+	var messages []string
+	for _, err := range e.Unwrap() {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("failed to update %d %s resources: %s", len(e.Failures), e.ResourceType, strings.Join(messages, "; "))
+}
+
+// Unwrap returns the distinct errors of the failed resources.
+func (e *BatchUpdateError) Unwrap() []error {
+	var errs []error
+	messages := sets.New[string]()
+	for _, failure := range e.Failures {
+		if !messages.Has(failure.Err.Error()) {
+			messages.Insert(failure.Err.Error())
+			errs = append(errs, failure.Err)
+		}
+	}
+	return errs
+}
+
+// FindBatchUpdateErrors walks the whole error tree and returns all the BatchUpdateErrors, unlike errors.As which only
+// finds the first one in the joined errors.
+func FindBatchUpdateErrors(err error) []*BatchUpdateError {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *BatchUpdateError:
+		return []*BatchUpdateError{e}
+	case interface{ Unwrap() []error }:
+		var batchErrs []*BatchUpdateError
+		for _, wrapped := range e.Unwrap() {
+			batchErrs = append(batchErrs, FindBatchUpdateErrors(wrapped)...)
+		}
+		return batchErrs
+	case interface{ Unwrap() error }:
+		return FindBatchUpdateErrors(e.Unwrap())
+	}
+	return nil
+}
+
+// PagingUpdateResources updates the resources in pages of pageSize with H-API. If NSX rejects a page, the page is
+// bisected to update the other resources, and a BatchUpdateError is returned with the failed resources.
 func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context, objs []T, pageSize int, nsxClient *nsx.Client, updateObjectsFromStoreFn func(updatedObjs []T)) error {
 	if len(objs) == 0 {
 		return nil
@@ -661,9 +685,8 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 
 	log.Info("Starting batch deletion", "resourceType", builder.leafType, "totalResources", totalCount, "totalBatches", totalBatches, "batchSize", pageSize)
 
-	var nsxErr error
+	batchErr := &BatchUpdateError{ResourceType: builder.leafType}
 	successCount := 0
-	failedCount := 0
 
 	for batchIdx, partialObjs := range pagedObjs {
 		currentBatch := batchIdx + 1
@@ -671,24 +694,68 @@ func (builder *PolicyTreeBuilder[T]) PagingUpdateResources(ctx context.Context,
 
 		select {
 		case <-ctx.Done():
-			log.Info("Batch deletion interrupted by context", "resourceType", builder.leafType, "processedBatches", currentBatch-1, "totalBatches", totalBatches, "successCount", successCount, "failedCount", failedCount)
+			log.Info("Batch deletion interrupted by context", "resourceType", builder.leafType, "processedBatches", currentBatch-1, "totalBatches", totalBatches, "successCount", successCount, "failedCount", len(batchErr.Failures))
 			return errors.Join(util.TimeoutFailed, ctx.Err())
 		default:
-			updateErr := builder.UpdateMultipleResourcesOnNSX(partialObjs, nsxClient)
-			if updateErr == nil {
-				successCount += len(partialObjs)
+			updatedObjs, failures := builder.bisectUpdateResources(ctx, partialObjs, nsxClient)
+			successCount += len(updatedObjs)
+			if len(updatedObjs) > 0 && updateObjectsFromStoreFn != nil {
+				updateObjectsFromStoreFn(updatedObjs)
+			}
+			if len(failures) == 0 {
 				log.Info("Batch update succeeded", "resourceType", builder.leafType, "batch", fmt.Sprintf("%d/%d", currentBatch, totalBatches), "batchResourceCount", len(partialObjs), "cumulativeSuccess", successCount)
-				if updateObjectsFromStoreFn != nil {
-					updateObjectsFromStoreFn(partialObjs)
-				}
 				continue
 			}
-			failedCount += len(partialObjs)
-			log.Error(updateErr, "Batch update failed", "resourceType", builder.leafType, "batch", fmt.Sprintf("%d/%d", currentBatch, totalBatches), "batchResourceCount", len(partialObjs), "cumulativeFailed", failedCount)
-			nsxErr = updateErr
+			batchErr.Failures = append(batchErr.Failures, failures...)
+			log.Error(failures[0].Err, "Batch update failed", "resourceType", builder.leafType, "batch", fmt.Sprintf("%d/%d", currentBatch, totalBatches), "batchResourceCount", len(partialObjs), "batchFailedCount", len(failures), "cumulativeFailed", len(batchErr.Failures))
 		}
 	}
 
-	log.Info("Batch deletion completed", "resourceType", builder.leafType, "totalResources", totalCount, "successCount", successCount, "failedCount", failedCount)
-	return nsxErr
+	log.Info("Batch deletion completed", "resourceType", builder.leafType, "totalResources", totalCount, "successCount", successCount, "failedCount", len(batchErr.Failures))
+	if len(batchErr.Failures) > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+// bisectUpdateResources updates the resources with bisectPatch. It returns the updated resources and the failed ones.
+func (builder *PolicyTreeBuilder[T]) bisectUpdateResources(ctx context.Context, objs []T, nsxClient *nsx.Client) ([]T, []ResourceUpdateFailure) {
+	var updatedObjs []T
+	var failures []ResourceUpdateFailure
+	patchFn := func(part []T) error {
+		return builder.patchResourcesOnNSX(part, nsxClient)
+	}
+	stop := func() bool {
+		return ctx.Err() != nil
+	}
+	bisectPatch(builder.leafType, objs, patchFn, stop, func(part []T, err error) {
+		for _, obj := range part {
+			id, name, path := builder.getResourceInfo(obj)
+			if err == nil {
+				log.Info("Successfully deleted resource", "resourceType", builder.leafType, "resourceID", id, "resourceName", name)
+				updatedObjs = append(updatedObjs, obj)
+				continue
+			}
+			log.Error(err, "Failed to delete resource", "resourceType", builder.leafType, "resourceID", id, "resourceName", name, "resourcePath", path)
+			failures = append(failures, ResourceUpdateFailure{ID: id, Name: name, Path: path, Err: err})
+		}
+	})
+	return updatedObjs, failures
+}
+
+// bisectPatch patches the items in one H-API call with patchFn. If NSX rejects it, the items are split in halves and
+// retried until the failed items are isolated. The other errors, e.g. the connection errors, are reported for all the
+// items, as retrying them separately doesn't help, and so is the error once stop returns true. done is called with
+// the items of each patch which is not split and the error of the patch.
+func bisectPatch[I any](resourceType string, items []I, patchFn func(items []I) error, stop func() bool, done func(items []I, err error)) {
+	err := patchFn(items)
+	var apiErr *util.NSXApiError
+	if err != nil && len(items) > 1 && errors.As(err, &apiErr) && (stop == nil || !stop()) {
+		log.Info("H-API batch failed, bisecting it to isolate the failed resources", "resourceType", resourceType, "count", len(items), "error", err.Error())
+		half := len(items) / 2
+		bisectPatch(resourceType, items[:half], patchFn, stop, done)
+		bisectPatch(resourceType, items[half:], patchFn, stop, done)
+		return
+	}
+	done(items, err)
 }
@@ -0,0 +1,150 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+// PolicyTreeBatchWriter coalesces the create, update and delete intents of the resources of the same type, which are
+// submitted by concurrent reconciles of one or more controllers. The intents submitted within a short window are
+// merged into one H-API patch per VPC, or per project or infra for the resources not under a VPC, and the result is
+// fanned back to the callers. If a merged patch is rejected by NSX, it is split in halves and retried, so that the
+// error is only returned to the callers whose resources caused it.
+//
+// The intents of the same resource in one window are collapsed and the latest one wins, so the callers should not
+// write the same resource concurrently, which is guaranteed as a controller reconciles an object in one worker.
+type PolicyTreeBatchWriter[T any] struct {
+	builder      *PolicyTreeBuilder[T]
+	window       time.Duration
+	maxBatchSize int
+	// patchFn patches the resources in one H-API call.
+	patchFn func(objects []T) error
+
+	lock    sync.Mutex
+	batches map[string]*writeBatch[T]
+}
+
+type writeIntent[T any] struct {
+	obj     T
+	waiters []chan error
+}
+
+type writeBatch[T any] struct {
+	intents []*writeIntent[T]
+	byPath  map[string]*writeIntent[T]
+}
+
+// NewBatchWriter creates a PolicyTreeBatchWriter which patches the intents after the window, or once maxBatchSize
+// intents are gathered for a VPC. DefaultHAPIChildrenCount is used if maxBatchSize is not positive.
+func (b *PolicyTreeBuilder[T]) NewBatchWriter(nsxClient *nsx.Client, window time.Duration, maxBatchSize int) *PolicyTreeBatchWriter[T] {
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultHAPIChildrenCount
+	}
+	return &PolicyTreeBatchWriter[T]{
+		builder:      b,
+		window:       window,
+		maxBatchSize: maxBatchSize,
+		patchFn: func(objects []T) error {
+			return b.patchResourcesOnNSX(objects, nsxClient)
+		},
+		batches: make(map[string]*writeBatch[T]),
+	}
+}
+
+// NewBatchWriterByConfig creates a PolicyTreeBatchWriter with the H-API batch window and size in the config of the
+// service, it returns nil if the coalescing is disabled.
+func NewBatchWriterByConfig[T any](builder *PolicyTreeBuilder[T], service Service) *PolicyTreeBatchWriter[T] {
+	if builder == nil || service.NSXConfig == nil || service.NSXConfig.NsxConfig == nil || service.NSXConfig.HAPIBatchWindow <= 0 {
+		return nil
+	}
+	return builder.NewBatchWriter(service.NSXClient, time.Duration(service.NSXConfig.HAPIBatchWindow)*time.Millisecond, service.NSXConfig.HAPIBatchSize)
+}
+
+// Write submits the resource to be created or updated, or deleted if it is marked for delete, then waits until the
+// batch containing it is patched on NSX. It returns the error of the patch containing the resource.
+func (w *PolicyTreeBatchWriter[T]) Write(obj T) error {
+	path := w.builder.pathGetter(obj)
+	if path == nil {
+		return fmt.Errorf("failed to write %s without path in batch", w.builder.leafType)
+	}
+	key := getBatchKey(*path)
+	result := make(chan error, 1)
+
+	w.lock.Lock()
+	batch, found := w.batches[key]
+	if !found {
+		batch = &writeBatch[T]{byPath: make(map[string]*writeIntent[T])}
+		w.batches[key] = batch
+		time.AfterFunc(w.window, func() { w.flush(key, batch) })
+	}
+	if intent, found := batch.byPath[*path]; found {
+		intent.obj = obj
+		intent.waiters = append(intent.waiters, result)
+	} else {
+		intent = &writeIntent[T]{obj: obj, waiters: []chan error{result}}
+		batch.intents = append(batch.intents, intent)
+		batch.byPath[*path] = intent
+	}
+	full := len(batch.intents) >= w.maxBatchSize
+	w.lock.Unlock()
+
+	if full {
+		go w.flush(key, batch)
+	}
+	return <-result
+}
+
+// flush detaches the batch, so that the following intents are gathered in a new batch, then patches it. It does
+// nothing if the batch has been flushed.
+func (w *PolicyTreeBatchWriter[T]) flush(key string, batch *writeBatch[T]) {
+	w.lock.Lock()
+	if w.batches[key] != batch {
+		w.lock.Unlock()
+		return
+	}
+	delete(w.batches, key)
+	w.lock.Unlock()
+
+	log.Debug("Patching H-API batch", "resourceType", w.builder.leafType, "batchKey", key, "count", len(batch.intents))
+	w.patch(batch.intents)
+}
+
+// patch patches the intents with bisectPatch, so that the error is only returned to the intents which caused it.
+func (w *PolicyTreeBatchWriter[T]) patch(intents []*writeIntent[T]) {
+	patchFn := func(part []*writeIntent[T]) error {
+		objects := make([]T, len(part))
+		for i := range part {
+			objects[i] = part[i].obj
+		}
+		return w.patchFn(objects)
+	}
+	bisectPatch(w.builder.leafType, intents, patchFn, nil, func(part []*writeIntent[T], err error) {
+		for _, intent := range part {
+			for _, waiter := range intent.waiters {
+				waiter <- err
+			}
+		}
+	})
+}
+
+// getBatchKey returns the path of the VPC the resource belongs to, or the path of the project or infra if the
+// resource is not under a VPC.
+func getBatchKey(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == PolicyResourceVpc.PathKey && i+1 < len(segments) {
+			return "/" + strings.Join(segments[:i+2], "/")
+		}
+	}
+	if len(segments) >= 4 && segments[0] == PolicyResourceOrg.PathKey {
+		return "/" + strings.Join(segments[:4], "/")
+	}
+	return "/" + PolicyResourceInfra.PathKey
+}
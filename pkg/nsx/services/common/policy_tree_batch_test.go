@@ -0,0 +1,138 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func newTestSubnetPort(vpc, id string) *model.VpcSubnetPort {
+	return &model.VpcSubnetPort{
+		Id:   String(id),
+		Path: String(fmt.Sprintf("/orgs/default/projects/p1/vpcs/%s/subnets/subnet1/ports/%s", vpc, id)),
+	}
+}
+
+// writeConcurrently writes the SubnetPorts concurrently and returns the errors in the same order.
+func writeConcurrently(writer *PolicyTreeBatchWriter[*model.VpcSubnetPort], ports []*model.VpcSubnetPort) []error {
+	errs := make([]error, len(ports))
+	var wg sync.WaitGroup
+	for i := range ports {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = writer.Write(ports[i])
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestPolicyTreeBatchWriter_Write(t *testing.T) {
+	builder, err := PolicyPathVpcSubnetPort.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+
+	t.Run("coalesce writes per VPC", func(t *testing.T) {
+		writer := builder.NewBatchWriter(nil, 50*time.Millisecond, 0)
+		var lock sync.Mutex
+		var batches [][]*model.VpcSubnetPort
+		writer.patchFn = func(objects []*model.VpcSubnetPort) error {
+			lock.Lock()
+			defer lock.Unlock()
+			batches = append(batches, objects)
+			return nil
+		}
+		ports := []*model.VpcSubnetPort{
+			newTestSubnetPort("vpc1", "port1"), newTestSubnetPort("vpc1", "port2"),
+			newTestSubnetPort("vpc1", "port3"), newTestSubnetPort("vpc2", "port4"),
+		}
+		for _, err := range writeConcurrently(writer, ports) {
+			assert.NoError(t, err)
+		}
+		require.Len(t, batches, 2)
+		assert.ElementsMatch(t, []int{3, 1}, []int{len(batches[0]), len(batches[1])})
+	})
+
+	t.Run("flush full batch before the window", func(t *testing.T) {
+		writer := builder.NewBatchWriter(nil, time.Hour, 2)
+		patched := 0
+		writer.patchFn = func(objects []*model.VpcSubnetPort) error {
+			patched += len(objects)
+			return nil
+		}
+		errs := writeConcurrently(writer, []*model.VpcSubnetPort{newTestSubnetPort("vpc1", "port1"), newTestSubnetPort("vpc1", "port2")})
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.Equal(t, 2, patched)
+	})
+
+	t.Run("split failed batch", func(t *testing.T) {
+		writer := builder.NewBatchWriter(nil, 50*time.Millisecond, 0)
+		badErr := util.NewNSXApiError(&model.ApiError{ErrorMessage: String("invalid port")}, apierrors.ErrorType_INVALID_REQUEST)
+		patchCount := 0
+		writer.patchFn = func(objects []*model.VpcSubnetPort) error {
+			patchCount++
+			for _, obj := range objects {
+				if *obj.Id == "bad" {
+					return badErr
+				}
+			}
+			return nil
+		}
+		ports := []*model.VpcSubnetPort{
+			newTestSubnetPort("vpc1", "port1"), newTestSubnetPort("vpc1", "bad"),
+			newTestSubnetPort("vpc1", "port2"), newTestSubnetPort("vpc1", "port3"),
+		}
+		errs := writeConcurrently(writer, ports)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], badErr)
+		assert.NoError(t, errs[2])
+		assert.NoError(t, errs[3])
+		assert.Greater(t, patchCount, 1)
+	})
+
+	t.Run("no split on connection error", func(t *testing.T) {
+		writer := builder.NewBatchWriter(nil, 50*time.Millisecond, 0)
+		connErr := fmt.Errorf("connection refused")
+		patchCount := 0
+		writer.patchFn = func(objects []*model.VpcSubnetPort) error {
+			patchCount++
+			return connErr
+		}
+		errs := writeConcurrently(writer, []*model.VpcSubnetPort{newTestSubnetPort("vpc1", "port1"), newTestSubnetPort("vpc1", "port2")})
+		assert.Equal(t, []error{connErr, connErr}, errs)
+		assert.Equal(t, 1, patchCount)
+	})
+}
+
+func TestGetBatchKey(t *testing.T) {
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc1", getBatchKey("/orgs/default/projects/p1/vpcs/vpc1/subnets/subnet1/ports/port1"))
+	assert.Equal(t, "/orgs/default/projects/p1", getBatchKey("/orgs/default/projects/p1/infra/domains/default/groups/group1"))
+	assert.Equal(t, "/infra", getBatchKey("/infra/shares/share1"))
+}
+
+func TestNewBatchWriterByConfig(t *testing.T) {
+	builder, err := PolicyPathVpcStaticRoutes.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+	service := Service{NSXConfig: &config.NSXOperatorConfig{NsxConfig: &config.NsxConfig{HAPIBatchSize: 10}}}
+	assert.Nil(t, NewBatchWriterByConfig(builder, service))
+
+	service.NSXConfig.HAPIBatchWindow = 20
+	writer := NewBatchWriterByConfig(builder, service)
+	require.NotNil(t, writer)
+	assert.Equal(t, 20*time.Millisecond, writer.window)
+	assert.Equal(t, 10, writer.maxBatchSize)
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc1/static-routes/route1",
+		builder.LeafPath("/orgs/default/projects/p1/vpcs/vpc1", &model.StaticRoutes{Id: String("route1")}))
+}
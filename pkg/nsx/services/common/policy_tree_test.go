@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -10,11 +11,14 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 
 	orgroot_mocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/orgrootclient"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 type mockInfraClient struct{}
@@ -69,6 +73,11 @@ func TestPagingDeleteResources(t *testing.T) {
 	t.Run("testPagingDeleteResourcesWithNSXFailure", func(t *testing.T) {
 		testPagingDeleteResourcesWithNSXFailure(t, targetSubnets)
 	})
+
+	// Verify the case that the page is bisected to delete the resources other than the failed one.
+	t.Run("testPagingDeleteResourcesWithBisection", func(t *testing.T) {
+		testPagingDeleteResourcesWithBisection(t, targetSubnets)
+	})
 }
 
 func testPagingDeleteResourcesSucceeded(t *testing.T, targetSubnets []*model.VpcSubnet) {
@@ -89,6 +98,15 @@ func testPagingDeleteResourcesSucceeded(t *testing.T, targetSubnets []*model.Vpc
 	require.NoError(t, err)
 }
 
+func TestFindBatchUpdateErrors(t *testing.T) {
+	groupErr := &BatchUpdateError{ResourceType: ResourceTypeGroup, Failures: []ResourceUpdateFailure{{ID: "group-1", Err: fmt.Errorf("in use")}}}
+	shareErr := &BatchUpdateError{ResourceType: ResourceTypeShare, Failures: []ResourceUpdateFailure{{ID: "share-1", Err: fmt.Errorf("in use")}}}
+	err := errors.Join(util.CleanupResourceFailed, errors.Join(groupErr, fmt.Errorf("wrapped: %w", shareErr)))
+	assert.Equal(t, []*BatchUpdateError{groupErr, shareErr}, FindBatchUpdateErrors(err))
+	assert.Empty(t, FindBatchUpdateErrors(fmt.Errorf("other error")))
+	assert.Empty(t, FindBatchUpdateErrors(nil))
+}
+
 func TestPagingNSXResources(t *testing.T) {
 	for _, tc := range []struct {
 		name     string
@@ -160,7 +178,66 @@ func testPagingDeleteResourcesWithNSXFailure(t *testing.T, targetSubnets []*mode
 	builder, err := PolicyPathVpcSubnet.NewPolicyTreeBuilder()
 	require.NoError(t, err)
 	err = builder.PagingUpdateResources(ctx, targetSubnets, 500, nsxClient, nil)
-	assert.EqualError(t, err, "NSX returned an error")
+	assert.EqualError(t, err, "failed to update 10 VpcSubnet resources: NSX returned an error")
+	var batchErr *BatchUpdateError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Len(t, batchErr.Failures, len(targetSubnets))
+}
+
+func testPagingDeleteResourcesWithBisection(t *testing.T, targetSubnets []*model.VpcSubnet) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inUseErr := util.NewNSXApiError(&model.ApiError{ErrorMessage: String("resource in use")}, apierrors.ErrorType_INVALID_REQUEST)
+	mockRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
+	mockRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).DoAndReturn(func(orgRoot model.OrgRoot, _ *bool) error {
+		for _, child := range orgRoot.Children {
+			if containsStringValue(child, "id-3") {
+				return inUseErr
+			}
+		}
+		return nil
+	}).AnyTimes()
+	nsxClient := &nsx.Client{
+		OrgRootClient: mockRootClient,
+	}
+
+	builder, err := PolicyPathVpcSubnet.NewPolicyTreeBuilder()
+	require.NoError(t, err)
+	var deleted []*model.VpcSubnet
+	err = builder.PagingUpdateResources(context.Background(), targetSubnets, 500, nsxClient, func(deletedObjs []*model.VpcSubnet) {
+		deleted = append(deleted, deletedObjs...)
+	})
+	var batchErr *BatchUpdateError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr.Failures, 1)
+	assert.Equal(t, "id-3", batchErr.Failures[0].ID)
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc1/subnets/id-3", batchErr.Failures[0].Path)
+	assert.ErrorIs(t, err, inUseErr)
+	assert.Len(t, deleted, len(targetSubnets)-1)
+}
+
+// containsStringValue checks whether the data value contains the string value in any level.
+func containsStringValue(value data.DataValue, str string) bool {
+	switch v := value.(type) {
+	case *data.StructValue:
+		for _, field := range v.Fields() {
+			if containsStringValue(field, str) {
+				return true
+			}
+		}
+	case *data.ListValue:
+		for _, element := range v.List() {
+			if containsStringValue(element, str) {
+				return true
+			}
+		}
+	case *data.OptionalValue:
+		return v.IsSet() && containsStringValue(v.Value(), str)
+	case *data.StringValue:
+		return v.Value() == str
+	}
+	return false
 }
 
 func testVPCResources(t *testing.T) {
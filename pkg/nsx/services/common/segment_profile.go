@@ -0,0 +1,189 @@
+package common
+
+import (
+	"crypto/sha1" // #nosec G505: not used for security purposes
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// SegmentProfileBindingMapID is the ID of the segment profile binding maps under a VpcSubnet or VpcSubnetPort,
+// there is at most one binding map of each type.
+const SegmentProfileBindingMapID = "default"
+
+// The NSX default profiles, which are used for the profiles not set in a binding map.
+const (
+	defaultSegmentSecurityProfilePath = "/infra/segment-security-profiles/default-segment-security-profile"
+	defaultSpoofGuardProfilePath      = "/infra/spoofguard-profiles/default-spoofguard-profile"
+	defaultIPDiscoveryProfilePath     = "/infra/ip-discovery-profiles/default-ip-discovery-profile"
+	defaultMACDiscoveryProfilePath    = "/infra/mac-discovery-profiles/default-mac-discovery-profile"
+	defaultQoSProfilePath             = "/infra/qos-profiles/default-qos-profile"
+)
+
+// segmentProfilePathRegex matches the path of the profiles under /infra or /orgs/<org>/projects/<project>/infra.
+var segmentProfilePathRegex = regexp.MustCompile(`^(/orgs/[^/]+/projects/[^/]+)?/infra/([^/]+)/([^/]+)$`)
+
+type segmentProfile struct {
+	kind         string
+	pathKey      string
+	resourceType string
+	path         string
+}
+
+func listSegmentProfiles(profiles v1alpha1.SegmentProfiles) []segmentProfile {
+	return []segmentProfile{
+		{kind: "segment security", pathKey: "segment-security-profiles", resourceType: ResourceTypeSegmentSecurityProfile, path: profiles.SegmentSecurityProfile},
+		{kind: "SpoofGuard", pathKey: "spoofguard-profiles", resourceType: ResourceTypeSpoofGuardProfile, path: profiles.SpoofGuardProfile},
+		{kind: "IP discovery", pathKey: "ip-discovery-profiles", resourceType: ResourceTypeIPDiscoveryProfile, path: profiles.IPDiscoveryProfile},
+		{kind: "MAC discovery", pathKey: "mac-discovery-profiles", resourceType: ResourceTypeMacDiscoveryProfile, path: profiles.MACDiscoveryProfile},
+		{kind: "QoS", pathKey: "qos-profiles", resourceType: ResourceTypeQoSProfile, path: profiles.QoSProfile},
+	}
+}
+
+// ValidateSegmentProfiles checks the segment profiles exist on NSX. A ValidationError is returned if a profile path
+// is invalid or the profile is not found, so that it can be reported to the user.
+func (service *Service) ValidateSegmentProfiles(profiles v1alpha1.SegmentProfiles) error {
+	for _, profile := range listSegmentProfiles(profiles) {
+		if profile.path == "" {
+			continue
+		}
+		matches := segmentProfilePathRegex.FindStringSubmatch(profile.path)
+		if matches == nil || matches[2] != profile.pathKey {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid %s profile path %q", profile.kind, profile.path)}
+		}
+		queryParam := fmt.Sprintf("%s:%s AND path:%s AND marked_for_delete:false", ResourceType, profile.resourceType,
+			strings.ReplaceAll(profile.path, "/", "\\/"))
+		response, err := service.NSXClient.QueryClient.List(queryParam, nil, nil, nil, nil, nil)
+		if err != nil {
+			err = nsxutil.TransNSXApiError(err)
+			log.Error(err, "Failed to search segment profile", "kind", profile.kind, "path", profile.path)
+			return err
+		}
+		if len(response.Results) == 0 {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("%s profile %s not found", profile.kind, profile.path)}
+		}
+	}
+	return nil
+}
+
+// BuildSegmentProfilesTags builds the tag with the hash of the segment profiles. The binding maps are not cached in
+// the stores, so the tag is used to detect the changes of the bindings on the VpcSubnet or VpcSubnetPort.
+func BuildSegmentProfilesTags(profiles v1alpha1.SegmentProfiles) []model.Tag {
+	if profiles == (v1alpha1.SegmentProfiles{}) {
+		return nil
+	}
+	var paths []string
+	for _, profile := range listSegmentProfiles(profiles) {
+		paths = append(paths, profile.path)
+	}
+	hash := sha1.Sum([]byte(strings.Join(paths, ","))) // #nosec G401: not used for security purposes
+	return []model.Tag{{Scope: String(TagScopeSegmentProfiles), Tag: String(fmt.Sprintf("%x", hash))}}
+}
+
+// BuildSubnetProfileBindingMaps builds the segment profile binding maps of a VpcSubnet as H-API children. If the
+// VpcSubnet had profiles bound before, the binding maps without any profile set are marked for delete to restore the
+// NSX default profiles.
+func BuildSubnetProfileBindingMaps(profiles v1alpha1.SegmentProfiles, bound bool) ([]*data.StructValue, error) {
+	var children []*data.StructValue
+	var err error
+	isSecuritySet, isDiscoverySet, isQoSSet := getBindingMapsSet(profiles)
+	if children, err = appendBindingMap(children, isSecuritySet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapSegmentSecurityProfileBindingMap(&model.SegmentSecurityProfileBindingMap{
+			Id:                         String(SegmentProfileBindingMapID),
+			MarkedForDelete:            markedForDelete,
+			SegmentSecurityProfilePath: pathOrDefault(profiles.SegmentSecurityProfile, defaultSegmentSecurityProfilePath),
+			SpoofguardProfilePath:      pathOrDefault(profiles.SpoofGuardProfile, defaultSpoofGuardProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if children, err = appendBindingMap(children, isDiscoverySet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapSegmentDiscoveryProfileBindingMap(&model.SegmentDiscoveryProfileBindingMap{
+			Id:                      String(SegmentProfileBindingMapID),
+			MarkedForDelete:         markedForDelete,
+			IpDiscoveryProfilePath:  pathOrDefault(profiles.IPDiscoveryProfile, defaultIPDiscoveryProfilePath),
+			MacDiscoveryProfilePath: pathOrDefault(profiles.MACDiscoveryProfile, defaultMACDiscoveryProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if children, err = appendBindingMap(children, isQoSSet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapSegmentQosProfileBindingMap(&model.SegmentQosProfileBindingMap{
+			Id:              String(SegmentProfileBindingMapID),
+			MarkedForDelete: markedForDelete,
+			QosProfilePath:  pathOrDefault(profiles.QoSProfile, defaultQoSProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// BuildPortProfileBindingMaps builds the segment profile binding maps of a VpcSubnetPort as H-API children, the
+// same as BuildSubnetProfileBindingMaps.
+func BuildPortProfileBindingMaps(profiles v1alpha1.SegmentProfiles, bound bool) ([]*data.StructValue, error) {
+	var children []*data.StructValue
+	var err error
+	isSecuritySet, isDiscoverySet, isQoSSet := getBindingMapsSet(profiles)
+	if children, err = appendBindingMap(children, isSecuritySet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapPortSecurityProfileBindingMap(&model.PortSecurityProfileBindingMap{
+			Id:                         String(SegmentProfileBindingMapID),
+			MarkedForDelete:            markedForDelete,
+			SegmentSecurityProfilePath: pathOrDefault(profiles.SegmentSecurityProfile, defaultSegmentSecurityProfilePath),
+			SpoofguardProfilePath:      pathOrDefault(profiles.SpoofGuardProfile, defaultSpoofGuardProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if children, err = appendBindingMap(children, isDiscoverySet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapPortDiscoveryProfileBindingMap(&model.PortDiscoveryProfileBindingMap{
+			Id:                      String(SegmentProfileBindingMapID),
+			MarkedForDelete:         markedForDelete,
+			IpDiscoveryProfilePath:  pathOrDefault(profiles.IPDiscoveryProfile, defaultIPDiscoveryProfilePath),
+			MacDiscoveryProfilePath: pathOrDefault(profiles.MACDiscoveryProfile, defaultMACDiscoveryProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	if children, err = appendBindingMap(children, isQoSSet, bound, func(markedForDelete *bool) (*data.StructValue, error) {
+		return WrapPortQosProfileBindingMap(&model.PortQosProfileBindingMap{
+			Id:              String(SegmentProfileBindingMapID),
+			MarkedForDelete: markedForDelete,
+			QosProfilePath:  pathOrDefault(profiles.QoSProfile, defaultQoSProfilePath),
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// getBindingMapsSet returns whether the security, discovery and QoS binding maps have any profile set.
+func getBindingMapsSet(profiles v1alpha1.SegmentProfiles) (bool, bool, bool) {
+	return profiles.SegmentSecurityProfile != "" || profiles.SpoofGuardProfile != "",
+		profiles.IPDiscoveryProfile != "" || profiles.MACDiscoveryProfile != "",
+		profiles.QoSProfile != ""
+}
+
+func appendBindingMap(children []*data.StructValue, set bool, bound bool, wrap func(markedForDelete *bool) (*data.StructValue, error)) ([]*data.StructValue, error) {
+	if !set && !bound {
+		return children, nil
+	}
+	child, err := wrap(Bool(!set))
+	if err != nil {
+		return nil, err
+	}
+	return append(children, child), nil
+}
+
+func pathOrDefault(path, defaultPath string) *string {
+	if path == "" {
+		return String(defaultPath)
+	}
+	return String(path)
+}
@@ -0,0 +1,165 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeSegmentProfileQueryClient struct {
+	existing []string
+	queries  []string
+	err      error
+}
+
+func (c *fakeSegmentProfileQueryClient) List(queryParam string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+	c.queries = append(c.queries, queryParam)
+	if c.err != nil {
+		return model.SearchResponse{}, c.err
+	}
+	var results []*data.StructValue
+	for _, path := range c.existing {
+		if strings.Contains(queryParam, "path:"+strings.ReplaceAll(path, "/", "\\/")+" ") {
+			results = append(results, data.NewStructValue("", nil))
+		}
+	}
+	return model.SearchResponse{Results: results}, nil
+}
+
+func TestValidateSegmentProfiles(t *testing.T) {
+	queryClient := &fakeSegmentProfileQueryClient{existing: []string{
+		"/infra/mac-discovery-profiles/mac-learning",
+		"/orgs/default/projects/proj-1/infra/spoofguard-profiles/relaxed",
+	}}
+	service := &Service{NSXClient: &nsx.Client{QueryClient: queryClient}}
+
+	assert.NoError(t, service.ValidateSegmentProfiles(v1alpha1.SegmentProfiles{}))
+	assert.Empty(t, queryClient.queries)
+
+	assert.NoError(t, service.ValidateSegmentProfiles(v1alpha1.SegmentProfiles{
+		MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning",
+		SpoofGuardProfile:   "/orgs/default/projects/proj-1/infra/spoofguard-profiles/relaxed",
+	}))
+	assert.Equal(t, []string{
+		"resource_type:SpoofGuardProfile AND path:\\/orgs\\/default\\/projects\\/proj-1\\/infra\\/spoofguard-profiles\\/relaxed AND marked_for_delete:false",
+		"resource_type:MacDiscoveryProfile AND path:\\/infra\\/mac-discovery-profiles\\/mac-learning AND marked_for_delete:false",
+	}, queryClient.queries)
+
+	tests := []struct {
+		name        string
+		profiles    v1alpha1.SegmentProfiles
+		expectedErr string
+	}{
+		{
+			name:        "not found",
+			profiles:    v1alpha1.SegmentProfiles{QoSProfile: "/infra/qos-profiles/gold"},
+			expectedErr: "QoS profile /infra/qos-profiles/gold not found",
+		},
+		{
+			name:        "wrong kind",
+			profiles:    v1alpha1.SegmentProfiles{IPDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"},
+			expectedErr: "invalid IP discovery profile path \"/infra/mac-discovery-profiles/mac-learning\"",
+		},
+		{
+			name:        "invalid path",
+			profiles:    v1alpha1.SegmentProfiles{SegmentSecurityProfile: "dhcp-allowed"},
+			expectedErr: "invalid segment security profile path \"dhcp-allowed\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.ValidateSegmentProfiles(tt.profiles)
+			var validationErr *nsxutil.ValidationError
+			assert.ErrorAs(t, err, &validationErr)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+
+	// The search failure is not a ValidationError so that it is retried.
+	queryClient.err = errors.New("connection refused")
+	err := service.ValidateSegmentProfiles(v1alpha1.SegmentProfiles{QoSProfile: "/infra/qos-profiles/gold"})
+	var validationErr *nsxutil.ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+	assert.Error(t, err)
+}
+
+func TestBuildSegmentProfilesTags(t *testing.T) {
+	assert.Nil(t, BuildSegmentProfilesTags(v1alpha1.SegmentProfiles{}))
+
+	tags := BuildSegmentProfilesTags(v1alpha1.SegmentProfiles{MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"})
+	require.Len(t, tags, 1)
+	assert.Equal(t, TagScopeSegmentProfiles, *tags[0].Scope)
+	assert.Len(t, *tags[0].Tag, 40)
+	assert.Equal(t, tags, BuildSegmentProfilesTags(v1alpha1.SegmentProfiles{MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"}))
+
+	// The same path set as another profile kind results in a different tag.
+	otherTags := BuildSegmentProfilesTags(v1alpha1.SegmentProfiles{IPDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"})
+	assert.NotEqual(t, *tags[0].Tag, *otherTags[0].Tag)
+}
+
+func TestBuildSubnetProfileBindingMaps(t *testing.T) {
+	children, err := BuildSubnetProfileBindingMaps(v1alpha1.SegmentProfiles{}, false)
+	assert.NoError(t, err)
+	assert.Empty(t, children)
+
+	children, err = BuildSubnetProfileBindingMaps(v1alpha1.SegmentProfiles{
+		MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning",
+	}, false)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	discovery := convertChild(t, children[0], model.ChildSegmentDiscoveryProfileBindingMapBindingType()).(model.ChildSegmentDiscoveryProfileBindingMap)
+	assert.Equal(t, ResourceTypeChildSegmentDiscoveryProfileBindingMap, discovery.ResourceType)
+	assert.False(t, *discovery.MarkedForDelete)
+	assert.Equal(t, SegmentProfileBindingMapID, *discovery.SegmentDiscoveryProfileBindingMap.Id)
+	assert.Equal(t, "/infra/mac-discovery-profiles/mac-learning", *discovery.SegmentDiscoveryProfileBindingMap.MacDiscoveryProfilePath)
+	assert.Equal(t, defaultIPDiscoveryProfilePath, *discovery.SegmentDiscoveryProfileBindingMap.IpDiscoveryProfilePath)
+
+	// The binding maps without profiles are deleted if the profiles were bound before.
+	children, err = BuildSubnetProfileBindingMaps(v1alpha1.SegmentProfiles{
+		SpoofGuardProfile: "/infra/spoofguard-profiles/relaxed",
+	}, true)
+	require.NoError(t, err)
+	require.Len(t, children, 3)
+	security := convertChild(t, children[0], model.ChildSegmentSecurityProfileBindingMapBindingType()).(model.ChildSegmentSecurityProfileBindingMap)
+	assert.False(t, *security.MarkedForDelete)
+	assert.Equal(t, "/infra/spoofguard-profiles/relaxed", *security.SegmentSecurityProfileBindingMap.SpoofguardProfilePath)
+	assert.Equal(t, defaultSegmentSecurityProfilePath, *security.SegmentSecurityProfileBindingMap.SegmentSecurityProfilePath)
+	discovery = convertChild(t, children[1], model.ChildSegmentDiscoveryProfileBindingMapBindingType()).(model.ChildSegmentDiscoveryProfileBindingMap)
+	assert.True(t, *discovery.MarkedForDelete)
+	qos := convertChild(t, children[2], model.ChildSegmentQosProfileBindingMapBindingType()).(model.ChildSegmentQosProfileBindingMap)
+	assert.True(t, *qos.MarkedForDelete)
+}
+
+func TestBuildPortProfileBindingMaps(t *testing.T) {
+	children, err := BuildPortProfileBindingMaps(v1alpha1.SegmentProfiles{}, true)
+	require.NoError(t, err)
+	require.Len(t, children, 3)
+	security := convertChild(t, children[0], model.ChildPortSecurityProfileBindingMapBindingType()).(model.ChildPortSecurityProfileBindingMap)
+	assert.True(t, *security.MarkedForDelete)
+	discovery := convertChild(t, children[1], model.ChildPortDiscoveryProfileBindingMapBindingType()).(model.ChildPortDiscoveryProfileBindingMap)
+	assert.True(t, *discovery.MarkedForDelete)
+
+	children, err = BuildPortProfileBindingMaps(v1alpha1.SegmentProfiles{QoSProfile: "/infra/qos-profiles/gold"}, false)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	qos := convertChild(t, children[0], model.ChildPortQosProfileBindingMapBindingType()).(model.ChildPortQosProfileBindingMap)
+	assert.Equal(t, ResourceTypeChildPortQosProfileBindingMap, qos.ResourceType)
+	assert.Equal(t, ResourceTypePortQosProfileBindingMap, *qos.PortQosProfileBindingMap.ResourceType)
+	assert.Equal(t, "/infra/qos-profiles/gold", *qos.PortQosProfileBindingMap.QosProfilePath)
+}
+
+func convertChild(t *testing.T, child *data.StructValue, bindingType bindings.BindingType) interface{} {
+	obj, errs := NewConverter().ConvertToGolang(child, bindingType)
+	require.Empty(t, errs)
+	return obj
+}
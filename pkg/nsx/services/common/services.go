@@ -31,6 +31,7 @@ type SubnetServiceProvider interface {
 	GetSubnetByPath(path string, sharedSubnet bool) (*model.VpcSubnet, error)
 	GetSubnetsByIndex(key, value string) []*model.VpcSubnet
 	CreateOrUpdateSubnet(obj client.Object, vpcInfo VPCResourceInfo, tags []model.Tag) (*model.VpcSubnet, error)
+	CheckSubnetSetSubnetRealizeState(subnetSet *v1alpha1.SubnetSet, nsxSubnet *model.VpcSubnet) error
 	GenerateSubnetNSTags(obj client.Object) []model.Tag
 	ListSubnetByName(ns, name string) []*model.VpcSubnet
 	ListSubnetBySubnetSetName(ns, subnetSetName string) []*model.VpcSubnet
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -47,13 +48,9 @@ type ResourceStore struct {
 // TransResourceToStore is the method to transform the resource of type data.StructValue
 // subclass could reuse it, distinguish the resource by bindingType and resourceAssertion
 func (resourceStore *ResourceStore) TransResourceToStore(entity *data.StructValue) error {
-	obj, err := NewConverter().ConvertToGolang(entity, resourceStore.BindingType)
-	for _, e := range err {
-		return e
-	}
-	objAddr := nsxutil.CasttoPointer(obj)
-	if objAddr == nil {
-		return fmt.Errorf("Failed to cast to pointer")
+	objAddr, err := resourceStore.transResource(entity)
+	if err != nil {
+		return err
 	}
 	err2 := resourceStore.Add(objAddr)
 	if err2 != nil {
@@ -62,6 +59,19 @@ func (resourceStore *ResourceStore) TransResourceToStore(entity *data.StructValu
 	return nil
 }
 
+// transResource converts the resource of type data.StructValue to the pointer of the nsx-t side resource.
+func (resourceStore *ResourceStore) transResource(entity *data.StructValue) (interface{}, error) {
+	obj, err := NewConverter().ConvertToGolang(entity, resourceStore.BindingType)
+	for _, e := range err {
+		return nil, e
+	}
+	objAddr := nsxutil.CasttoPointer(obj)
+	if objAddr == nil {
+		return nil, fmt.Errorf("Failed to cast to pointer")
+	}
+	return objAddr, nil
+}
+
 func DecrementPageSize(pageSize *int64) {
 	*pageSize -= 100
 	if int(*pageSize) <= 0 {
@@ -104,6 +114,22 @@ func (resourceStore *ResourceStore) IsPolicyAPI() bool {
 	return true
 }
 
+// SearchCollector implements the Store methods other than TransResourceToStore as no-ops, it is embedded by the
+// Stores which only collect the results of a search query instead of caching them.
+type SearchCollector struct{}
+
+func (c SearchCollector) ListIndexFuncValues(_ string) sets.Set[string] {
+	return sets.New[string]()
+}
+
+func (c SearchCollector) Apply(_ interface{}) error {
+	return nil
+}
+
+func (c SearchCollector) IsPolicyAPI() bool {
+	return true
+}
+
 func TransError(err error) error {
 	apierror, errortype := nsxutil.DumpAPIError(err)
 	if apierror != nil {
@@ -132,6 +158,12 @@ type Filter func(interface{}) *data.StructValue
 
 func (service *Service) SearchResource(resourceTypeValue string, queryParam string, store Store, filter Filter) (uint64, error) {
 	// TODO: resourceTypeValue is not used in this function, but cannot be deleted, as the `fakeSearchResource` use the parameter
+	return service.searchResource(queryParam, nil, store, filter)
+}
+
+// searchResource searches the resources page by page and transforms them to the store, only the includedFields
+// of the resources are returned if it is set.
+func (service *Service) searchResource(queryParam string, includedFields *string, store Store, filter Filter) (uint64, error) {
 	var cursor *string
 	count := uint64(0)
 	for {
@@ -139,13 +171,13 @@ func (service *Service) SearchResource(resourceTypeValue string, queryParam stri
 		var results []*data.StructValue
 		var resultCount *int64
 		if store.IsPolicyAPI() {
-			response, searchErr := service.NSXClient.QueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
+			response, searchErr := service.NSXClient.QueryClient.List(queryParam, cursor, includedFields, &pageSize, nil, nil)
 			results = response.Results
 			cursor = response.Cursor
 			resultCount = response.ResultCount
 			err = searchErr
 		} else {
-			response, searchErr := service.NSXClient.MPQueryClient.List(queryParam, cursor, nil, &pageSize, nil, nil)
+			response, searchErr := service.NSXClient.MPQueryClient.List(queryParam, cursor, includedFields, &pageSize, nil, nil)
 			results = response.Results
 			cursor = response.Cursor
 			resultCount = response.ResultCount
@@ -224,7 +256,9 @@ func (service *Service) InitializeCommonStore(wg *sync.WaitGroup, fatalErrors ch
 	if store.IsPolicyAPI() {
 		queryParam += " AND marked_for_delete:false"
 	}
+	startTime := time.Now()
 	service.PopulateResourcetoStore(wg, fatalErrors, resourceTypeValue, queryParam, store, nil)
+	defaultStoreResyncer.register(service, resourceTypeValue, queryParam, store, startTime)
 }
 
 // Helper function to check if any tag has the specified scopes
@@ -0,0 +1,334 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// storeResyncSearchSkew tolerates the clock difference between the operator and NSX, and the delay of the NSX
+	// search index.
+	storeResyncSearchSkew = time.Minute
+	// storeResyncJitterFactor spreads the periodic resync of the stores.
+	storeResyncJitterFactor = 0.1
+)
+
+// StoreDriftHandler is called with the NSX resource which is created, updated or deleted in NSX but not yet in the
+// store. The store can't tell the out-of-band changes from the changes of the operator's own writes, whose revisions
+// are not known when they are applied to the store, so the handler should be idempotent, e.g. enqueue the owner CR.
+type StoreDriftHandler func(obj interface{})
+
+// resyncableStore is a Store embedding ResourceStore, its resources are converted with the binding type of the store
+// and updated without Apply, as Apply may have side effects on other stores.
+type resyncableStore interface {
+	Store
+	Add(obj interface{}) error
+	Delete(obj interface{}) error
+	Get(obj interface{}) (item interface{}, exists bool, err error)
+	List() []interface{}
+	transResource(entity *data.StructValue) (interface{}, error)
+}
+
+// storeResyncQuery is a query the store is initialized with.
+type storeResyncQuery struct {
+	service      *Service
+	resourceType string
+	queryParam   string
+}
+
+// storeResyncTarget is a store with all the queries it is initialized with.
+type storeResyncTarget struct {
+	store   resyncableStore
+	queries []storeResyncQuery
+	// lastSearch is the time of the last successful resync, the resources modified after it are searched next time.
+	lastSearch time.Time
+	// missingPaths are the paths of the resources in the store which were not found in NSX by the last resync.
+	missingPaths sets.Set[string]
+}
+
+// storeResyncer periodically resyncs the stores initialized by InitializeCommonStore with NSX, so that the
+// resources created, updated or deleted out of band are reflected without restarting the operator.
+//
+// In each resync, the resources modified since the last resync are searched with _last_modified_time, and are
+// updated in the store if their _revision is newer. The paths of all the resources are listed to find the deleted
+// ones, a resource is deleted from the store only if it is missing in two successive resyncs, as the resources
+// created by the operator may not be indexed by the NSX search yet.
+type storeResyncer struct {
+	lock     sync.Mutex
+	targets  []*storeResyncTarget
+	handlers map[string][]StoreDriftHandler
+	// resyncLock serializes the periodic resync and the resync on demand.
+	resyncLock sync.Mutex
+	startOnce  sync.Once
+}
+
+var defaultStoreResyncer = &storeResyncer{handlers: make(map[string][]StoreDriftHandler)}
+
+// RegisterStoreDriftHandler registers the handler to be called when the resources of the resource type drift.
+func RegisterStoreDriftHandler(resourceType string, handler StoreDriftHandler) {
+	defaultStoreResyncer.lock.Lock()
+	defer defaultStoreResyncer.lock.Unlock()
+	defaultStoreResyncer.handlers[resourceType] = append(defaultStoreResyncer.handlers[resourceType], handler)
+}
+
+// StartStoreResync resyncs the stores with NSX every period until stopCh is closed. It only starts once, the
+// following calls are ignored.
+func StartStoreResync(period time.Duration, stopCh <-chan struct{}) {
+	defaultStoreResyncer.startOnce.Do(func() {
+		log.Info("Starting NSX store resync", "period", period)
+		go func() {
+			// The stores are just populated, skip the first resync.
+			select {
+			case <-time.After(period):
+			case <-stopCh:
+				return
+			}
+			wait.JitterUntil(defaultStoreResyncer.resync, period, storeResyncJitterFactor, false, stopCh)
+		}()
+	})
+}
+
+// ResyncStores resyncs the stores with NSX once to validate the stores kept by the standby replica before the
+// controllers are started. As the stores only hold the resources searched from NSX, the missing resources are deleted
+// at once.
+func ResyncStores() {
+	defaultStoreResyncer.resyncAll(true)
+}
+
+// register records the query the store is initialized with, startTime is the time before the store is populated.
+func (r *storeResyncer) register(service *Service, resourceType string, queryParam string, store Store, startTime time.Time) {
+	resyncStore, ok := store.(resyncableStore)
+	if !ok {
+		log.Debug("Store doesn't support resync", "resourceType", resourceType)
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	query := storeResyncQuery{service: service, resourceType: resourceType, queryParam: queryParam}
+	for _, target := range r.targets {
+		if target.store == resyncStore {
+			target.queries = append(target.queries, query)
+			if startTime.Before(target.lastSearch) {
+				target.lastSearch = startTime
+			}
+			return
+		}
+	}
+	r.targets = append(r.targets, &storeResyncTarget{
+		store:        resyncStore,
+		queries:      []storeResyncQuery{query},
+		lastSearch:   startTime,
+		missingPaths: sets.New[string](),
+	})
+}
+
+func (r *storeResyncer) resync() {
+	r.resyncAll(false)
+}
+
+func (r *storeResyncer) resyncAll(deleteMissing bool) {
+	r.resyncLock.Lock()
+	defer r.resyncLock.Unlock()
+	r.lock.Lock()
+	targets := slices.Clone(r.targets)
+	r.lock.Unlock()
+	for _, target := range targets {
+		r.resyncStore(target, time.Now(), deleteMissing)
+	}
+}
+
+// resyncStore applies the resources changed in NSX since the last resync to the store. The resources missing in NSX
+// are deleted at once if deleteMissing is true, otherwise only if they were also missing in the last resync.
+func (r *storeResyncer) resyncStore(target *storeResyncTarget, now time.Time, deleteMissing bool) {
+	r.lock.Lock()
+	queries := slices.Clone(target.queries)
+	since := target.lastSearch.Add(-storeResyncSearchSkew)
+	r.lock.Unlock()
+	if len(queries) == 0 {
+		return
+	}
+	resourceType := queries[0].resourceType
+
+	stored := make(map[string]interface{})
+	for _, obj := range target.store.List() {
+		if path := getResourcePath(obj); path != "" {
+			stored[path] = obj
+		}
+	}
+
+	searchFailed := false
+	updated, deleted := 0, 0
+	for _, query := range queries {
+		collector := &storeResyncCollector{store: target.store}
+		queryParam := fmt.Sprintf("%s AND %s:>%d", query.queryParam, LastModifiedTime, since.UnixMilli())
+		if _, err := query.service.SearchResource(query.resourceType, queryParam, collector, nil); err != nil {
+			log.Error(err, "Failed to search changed resources to resync store", "resourceType", query.resourceType)
+			searchFailed = true
+			continue
+		}
+		for _, obj := range collector.objects {
+			if old, found := stored[getResourcePath(obj)]; found && !isNewerRevision(obj, old) {
+				continue
+			}
+			if err := target.store.Add(obj); err != nil {
+				log.Error(err, "Failed to resync resource to store", "resourceType", query.resourceType, "path", getResourcePath(obj))
+				continue
+			}
+			updated++
+			r.notifyDrift(query.resourceType, obj)
+		}
+	}
+
+	existingPaths := sets.New[string]()
+	for _, query := range queries {
+		collector := &storePathCollector{policyAPI: target.store.IsPolicyAPI(), paths: existingPaths}
+		if _, err := query.service.searchResource(query.queryParam, String("path"), collector, nil); err != nil {
+			log.Error(err, "Failed to list resources to resync store", "resourceType", query.resourceType)
+			searchFailed = true
+		}
+	}
+	if searchFailed {
+		// Retry from the same time in the next resync, the deletion is not checked as the paths may be incomplete.
+		return
+	}
+
+	missingPaths := sets.New[string]()
+	for path, obj := range stored {
+		if existingPaths.Has(path) {
+			continue
+		}
+		if !deleteMissing && !target.missingPaths.Has(path) {
+			missingPaths.Insert(path)
+			continue
+		}
+		// Skip the resource if it has been updated by the operator since the store is listed.
+		if current, exists, err := target.store.Get(obj); err != nil || !exists || !isUnchanged(current, obj) {
+			continue
+		}
+		if err := target.store.Delete(obj); err != nil {
+			log.Error(err, "Failed to delete resource from store", "resourceType", resourceType, "path", path)
+			continue
+		}
+		deleted++
+		r.notifyDrift(resourceType, obj)
+	}
+
+	r.lock.Lock()
+	target.missingPaths = missingPaths
+	target.lastSearch = now
+	r.lock.Unlock()
+	if updated > 0 || deleted > 0 {
+		log.Info("Resynced store with NSX", "resourceType", resourceType, "updated", updated, "deleted", deleted)
+	}
+}
+
+func (r *storeResyncer) notifyDrift(resourceType string, obj interface{}) {
+	r.lock.Lock()
+	handlers := slices.Clone(r.handlers[resourceType])
+	r.lock.Unlock()
+	log.Debug("NSX resource drifted from store", "resourceType", resourceType, "path", getResourcePath(obj))
+	for _, handler := range handlers {
+		handler(obj)
+	}
+}
+
+// isNewerRevision returns false only if both resources have _revision and obj is not newer than old.
+func isNewerRevision(obj, old interface{}) bool {
+	revision, ok := getResourceField(obj, "Revision").(int64)
+	if !ok {
+		return true
+	}
+	oldRevision, ok := getResourceField(old, "Revision").(int64)
+	return !ok || revision > oldRevision
+}
+
+// isUnchanged returns if the resource in the store is still the listed one, the resources not stored as pointers are
+// considered unchanged.
+func isUnchanged(current, listed interface{}) bool {
+	currentValue, listedValue := reflect.ValueOf(current), reflect.ValueOf(listed)
+	if currentValue.Kind() != reflect.Ptr || listedValue.Kind() != reflect.Ptr {
+		return true
+	}
+	return currentValue.Pointer() == listedValue.Pointer()
+}
+
+func getResourcePath(obj interface{}) string {
+	path, _ := getResourceField(obj, "Path").(string)
+	return path
+}
+
+// getResourceField returns the value of the pointer field of the NSX resource, nil is returned if it is not set.
+func getResourceField(obj interface{}, name string) interface{} {
+	value := reflect.Indirect(reflect.ValueOf(obj))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	field := value.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Ptr || field.IsNil() {
+		return nil
+	}
+	return field.Elem().Interface()
+}
+
+// storeResyncCollector is a Store which collects the resources returned by a search query, they are converted with
+// the binding type of the store to resync.
+type storeResyncCollector struct {
+	SearchCollector
+	store   resyncableStore
+	objects []interface{}
+}
+
+func (c *storeResyncCollector) TransResourceToStore(entity *data.StructValue) error {
+	obj, err := c.store.transResource(entity)
+	if err != nil {
+		return err
+	}
+	c.objects = append(c.objects, obj)
+	return nil
+}
+
+func (c *storeResyncCollector) IsPolicyAPI() bool {
+	return c.store.IsPolicyAPI()
+}
+
+// storePathCollector is a Store which only records the paths of the resources returned by a search query with the
+// included field path.
+type storePathCollector struct {
+	SearchCollector
+	policyAPI bool
+	paths     sets.Set[string]
+}
+
+func (c *storePathCollector) TransResourceToStore(entity *data.StructValue) error {
+	if entity == nil || !entity.HasField("path") {
+		return nil
+	}
+	value, err := entity.Field("path")
+	if err != nil {
+		return err
+	}
+	if optional, ok := value.(*data.OptionalValue); ok {
+		if !optional.IsSet() {
+			return nil
+		}
+		value = optional.Value()
+	}
+	if path, ok := value.(*data.StringValue); ok {
+		c.paths.Insert(path.Value())
+	}
+	return nil
+}
+
+func (c *storePathCollector) IsPolicyAPI() bool {
+	return c.policyAPI
+}
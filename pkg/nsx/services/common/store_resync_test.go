@@ -0,0 +1,150 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+// fakeResyncQueryClient returns the changed Subnets for the incremental search, and the paths for the listing.
+type fakeResyncQueryClient struct {
+	changed []model.VpcSubnet
+	paths   []string
+	err     error
+	queries []string
+}
+
+func (c *fakeResyncQueryClient) List(queryParam string, _ *string, includedFields *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+	c.queries = append(c.queries, queryParam)
+	if c.err != nil {
+		return model.SearchResponse{}, c.err
+	}
+	var results []*data.StructValue
+	if includedFields != nil {
+		for _, path := range c.paths {
+			results = append(results, data.NewStructValue("", map[string]data.DataValue{"path": data.NewStringValue(path)}))
+		}
+	} else {
+		for _, subnet := range c.changed {
+			dataValue, errs := NewConverter().ConvertToVapi(subnet, model.VpcSubnetBindingType())
+			if len(errs) > 0 {
+				return model.SearchResponse{}, errs[0]
+			}
+			results = append(results, dataValue.(*data.StructValue))
+		}
+	}
+	return model.SearchResponse{Results: results, ResultCount: Int64(int64(len(results)))}, nil
+}
+
+// resyncTestStore is a store of VpcSubnets keyed by path.
+type resyncTestStore struct {
+	ResourceStore
+}
+
+func (s *resyncTestStore) Apply(_ interface{}) error {
+	return nil
+}
+
+func newResyncTestSubnet(id string, revision int64) *model.VpcSubnet {
+	return &model.VpcSubnet{
+		Id:       String(id),
+		Path:     String("/orgs/default/projects/p1/vpcs/vpc1/subnets/" + id),
+		Revision: Int64(revision),
+	}
+}
+
+func TestStoreResyncer_resyncStore(t *testing.T) {
+	store := &resyncTestStore{ResourceStore: ResourceStore{
+		Indexer: cache.NewIndexer(func(obj interface{}) (string, error) {
+			return *obj.(*model.VpcSubnet).Path, nil
+		}, cache.Indexers{}),
+		BindingType: model.VpcSubnetBindingType(),
+	}}
+	for _, id := range []string{"subnet1", "subnet2", "subnet3"} {
+		require.NoError(t, store.Add(newResyncTestSubnet(id, 1)))
+	}
+	queryClient := &fakeResyncQueryClient{}
+	service := &Service{NSXClient: &nsx.Client{QueryClient: queryClient}}
+	resyncer := &storeResyncer{handlers: make(map[string][]StoreDriftHandler)}
+	var drifted []string
+	resyncer.handlers[ResourceTypeSubnet] = []StoreDriftHandler{func(obj interface{}) {
+		drifted = append(drifted, *obj.(*model.VpcSubnet).Id)
+	}}
+	startTime := time.UnixMilli(1_700_000_000_000)
+	resyncer.register(service, ResourceTypeSubnet, "resource_type:VpcSubnet", store, startTime)
+	require.Len(t, resyncer.targets, 1)
+	target := resyncer.targets[0]
+
+	t.Run("apply changed resources", func(t *testing.T) {
+		queryClient.changed = []model.VpcSubnet{*newResyncTestSubnet("subnet1", 2), *newResyncTestSubnet("subnet2", 1), *newResyncTestSubnet("subnet4", 0)}
+		queryClient.paths = []string{*newResyncTestSubnet("subnet1", 0).Path, *newResyncTestSubnet("subnet2", 0).Path, *newResyncTestSubnet("subnet4", 0).Path}
+		now := startTime.Add(time.Hour)
+		resyncer.resyncStore(target, now, false)
+
+		assert.Equal(t, "resource_type:VpcSubnet AND _last_modified_time:>1699999940000", queryClient.queries[0])
+		assert.ElementsMatch(t, []string{"subnet1", "subnet4"}, drifted)
+		assert.Len(t, store.List(), 4)
+		// The missing resource is kept until it is missing in the next resync.
+		assert.True(t, target.missingPaths.Has(*newResyncTestSubnet("subnet3", 0).Path))
+		assert.Equal(t, now, target.lastSearch)
+	})
+
+	t.Run("keep resources on search failure", func(t *testing.T) {
+		drifted = nil
+		queryClient.err = errors.New("connection refused")
+		lastSearch := target.lastSearch
+		resyncer.resyncStore(target, lastSearch.Add(time.Hour), false)
+
+		assert.Empty(t, drifted)
+		assert.Len(t, store.List(), 4)
+		assert.Equal(t, lastSearch, target.lastSearch)
+		queryClient.err = nil
+	})
+
+	t.Run("delete missing resources", func(t *testing.T) {
+		drifted = nil
+		queryClient.changed = nil
+		resyncer.resyncStore(target, target.lastSearch.Add(time.Hour), false)
+
+		assert.Equal(t, []string{"subnet3"}, drifted)
+		assert.Len(t, store.List(), 3)
+		assert.Empty(t, target.missingPaths)
+	})
+
+	t.Run("delete missing resources at once", func(t *testing.T) {
+		drifted = nil
+		queryClient.paths = queryClient.paths[1:]
+		resyncer.resyncStore(target, target.lastSearch.Add(time.Hour), true)
+
+		assert.Equal(t, []string{"subnet1"}, drifted)
+		assert.Len(t, store.List(), 2)
+	})
+}
+
+func TestStoreResyncer_register(t *testing.T) {
+	store := &resyncTestStore{ResourceStore: ResourceStore{
+		Indexer:     cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}),
+		BindingType: model.VpcIpAddressAllocationBindingType(),
+	}}
+	resyncer := &storeResyncer{handlers: make(map[string][]StoreDriftHandler)}
+	startTime := time.Now()
+	resyncer.register(&Service{}, ResourceTypeIPAddressAllocation, "query1", store, startTime)
+	resyncer.register(&Service{}, ResourceTypeIPAddressAllocation, "query2", store, startTime.Add(-time.Second))
+	// The store not embedding ResourceStore is not resynced.
+	resyncer.register(&Service{}, ResourceTypeSubnet, "query3", &storePathCollector{}, startTime)
+
+	require.Len(t, resyncer.targets, 1)
+	assert.Len(t, resyncer.targets[0].queries, 2)
+	assert.Equal(t, startTime.Add(-time.Second), resyncer.targets[0].lastSearch)
+}
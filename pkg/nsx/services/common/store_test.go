@@ -364,6 +364,13 @@ func (f *fakeStore) IsPolicyAPI() bool {
 	return f.isPolicyAPI
 }
 
+func TestSearchCollector(t *testing.T) {
+	var collector SearchCollector
+	assert.Empty(t, collector.ListIndexFuncValues(TagScopeNamespace))
+	assert.NoError(t, collector.Apply(&model.VpcSubnet{}))
+	assert.True(t, collector.IsPolicyAPI())
+}
+
 type fakeMPQueryClient struct{}
 
 func (*fakeMPQueryClient) List(_ string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (mp_model.SearchResponse, error) {
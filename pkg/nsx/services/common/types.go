@@ -51,6 +51,7 @@ const (
 	TagScopeSelectorHash               string = "nsx-op/selector_hash"
 	TagScopeNSXServiceAccountCRName    string = "nsx-op/nsx_service_account_name"
 	TagScopeNSXServiceAccountCRUID     string = "nsx-op/nsx_service_account_uid"
+	TagScopeNSXServiceAccountCredMode  string = "nsx-op/nsx_service_account_credential_mode"
 	TagScopeNSXShareCreatedFor         string = "nsx-op/nsx_share_created_for"
 	TagScopeSubnetPortCRName           string = "nsx-op/subnetport_name"
 	TagScopeSubnetPortCRUID            string = "nsx-op/subnetport_uid"
@@ -84,6 +85,7 @@ const (
 	TagScopeSubnetBindingCRUID         string = "nsx-op/subnetbinding_uid"
 	TagScopeSubnetIPReservationCRUID   string = "nsx-op/subnetipreservation_uid"
 	TagScopeSubnetIPReservationCRName  string = "nsx-op/subnetipreservation_name"
+	TagScopeSegmentProfiles            string = "nsx-op/segment_profiles"
 	TagValueGroupScope                 string = "scope"
 	TagValueGroupSource                string = "source"
 	TagValueGroupDestination           string = "destination"
@@ -98,6 +100,8 @@ const (
 	AnnotationDefaultNetworkConfig     string = "nsx.vmware.com/default"
 	AnnotationAttachmentRef            string = "nsx.vmware.com/attachment_ref"
 	AnnotationAssociatedResource       string = "nsx.vmware.com/associated-resource"
+	AnnotationRefreshSharedSubnet      string = "nsx.vmware.com/refresh-shared-subnet"
+	AnnotationRotateCert               string = "nsx.vmware.com/rotate-cert"
 	AnnotationReconfigureNic           string = "nsx/reconfigure-nic"
 	AnnotationPodMAC                   string = "nsx.vmware.com/mac"
 	AnnotationAttachment               string = "nsx.vmware.com/attachment"
@@ -106,6 +110,22 @@ const (
 	TagScopePodUID                     string = "nsx-op/pod_uid"
 	TagScopeStatefulSetName            string = "nsx-op/sts_name"
 	TagScopeStatefulSetUID             string = "nsx-op/sts_uid"
+	TagScopeServiceName                string = "nsx-op/service_name"
+	TagScopeServiceUID                 string = "nsx-op/service_uid"
+	// Annotations to pin the MAC address and IP addresses of the Pod, the IP addresses are separated by comma.
+	AnnotationPodStaticMAC string = "nsx.vmware.com/static-mac"
+	AnnotationPodStaticIPs string = "nsx.vmware.com/static-ips"
+	// Annotation to preserve the IP addresses of the SubnetPort when it is migrated to another Subnet, value: "true".
+	AnnotationPreserveIPOnMigration string = "nsx.vmware.com/preserve-ip-on-migration"
+	// TagScopePodStaticAddress tags the SubnetPort of the Pod with pinned addresses, the SubnetPort is retained after
+	// the Pod is deleted and taken over by the Pod which replaces it with the same pinned addresses.
+	TagScopePodStaticAddress string = "nsx-op/pod_static_address"
+	// Annotations to limit the bandwidth of the Pod, which are the same as the ones of the CNI bandwidth plugin.
+	AnnotationPodIngressBandwidth string = "kubernetes.io/ingress-bandwidth"
+	AnnotationPodEgressBandwidth  string = "kubernetes.io/egress-bandwidth"
+	// TagScopePortBandwidth tags the QoS profile created for the bandwidth limits and the SubnetPorts bound to it,
+	// so that the QoS profile is shared by the SubnetPorts with the same limits and removed when it is not used.
+	TagScopePortBandwidth string = "nsx-op/port_bandwidth"
 
 	// Tags and annotations for DNS record use case.
 	TagScopeDNSRecordFor                string = "nsx-op/dns_for" // value: gateway, service, xxroutes
@@ -122,6 +142,11 @@ const (
 	AnnotationDNSHostnameSourceKey      string = "nsx.vmware.com/gateway-hostname-source"
 	AnnotationsDNSSkip                  string = "nsx.vmware.com/skip"
 
+	// Annotations for Service LoadBalancer realized with the NSX VPC load balancer.
+	AnnotationLBStaticIP           string = "nsx.vmware.com/load-balancer-ip"        // value: static VIP, takes precedence over spec.loadBalancerIP
+	AnnotationLBPersistenceProfile string = "nsx.vmware.com/lb-persistence-profile"  // value: ID of an LB persistence profile under /infra
+	AnnotationLBHealthCheckProfile string = "nsx.vmware.com/lb-health-check-profile" // value: comma-separated IDs of LB monitor profiles under /infra
+
 	// TagScopePodIndex is the NSX tag scope for Pod label apps.kubernetes.io/pod-index when synced onto the port (not set in BuildBasicTags).
 	TagScopePodIndex   string = "apps.kubernetes.io/pod-index"
 	ValueMajorVersion  string = "1"
@@ -185,6 +210,7 @@ var (
 
 var (
 	ResourceType                                 = "resource_type"
+	LastModifiedTime                             = "_last_modified_time"
 	ResourceTypeInfra                            = "Infra"
 	ResourceTypeDomain                           = "Domain"
 	ResourceTypeSecurityPolicy                   = "SecurityPolicy"
@@ -237,6 +263,26 @@ var (
 	ResourceTypeStaticIpAddressReservation       = "StaticIpAddressReservation"
 	ResourceTypeProjectDnsRecord                 = "ProjectDnsRecord"
 
+	// Segment profiles and the binding maps of VpcSubnet and VpcSubnetPort
+	ResourceTypeSegmentSecurityProfile                 = "SegmentSecurityProfile"
+	ResourceTypeSpoofGuardProfile                      = "SpoofGuardProfile"
+	ResourceTypeIPDiscoveryProfile                     = "IPDiscoveryProfile"
+	ResourceTypeMacDiscoveryProfile                    = "MacDiscoveryProfile"
+	ResourceTypeQoSProfile                             = "QoSProfile"
+	ResourceTypeSegmentSecurityProfileBindingMap       = "SegmentSecurityProfileBindingMap"
+	ResourceTypeSegmentDiscoveryProfileBindingMap      = "SegmentDiscoveryProfileBindingMap"
+	ResourceTypeSegmentQosProfileBindingMap            = "SegmentQosProfileBindingMap"
+	ResourceTypePortSecurityProfileBindingMap          = "PortSecurityProfileBindingMap"
+	ResourceTypePortDiscoveryProfileBindingMap         = "PortDiscoveryProfileBindingMap"
+	ResourceTypePortQosProfileBindingMap               = "PortQosProfileBindingMap"
+	ResourceTypeChildSegmentSecurityProfileBindingMap  = "ChildSegmentSecurityProfileBindingMap"
+	ResourceTypeChildSegmentDiscoveryProfileBindingMap = "ChildSegmentDiscoveryProfileBindingMap"
+	ResourceTypeChildSegmentQosProfileBindingMap       = "ChildSegmentQosProfileBindingMap"
+	ResourceTypeChildPortSecurityProfileBindingMap     = "ChildPortSecurityProfileBindingMap"
+	ResourceTypeChildPortDiscoveryProfileBindingMap    = "ChildPortDiscoveryProfileBindingMap"
+	ResourceTypeChildPortQosProfileBindingMap          = "ChildPortQosProfileBindingMap"
+	ResourceTypeChildQoSProfile                        = "ChildQoSProfile"
+
 	// ResourceTypeClusterControlPlane is used by NSXServiceAccountController
 	ResourceTypeClusterControlPlane = "clustercontrolplane"
 	// ResourceTypePrincipalIdentity is used by NSXServiceAccountController, and it is MP resource type.
@@ -361,6 +361,111 @@ func WrapStaticIpAddressReservation(ipr *model.StaticIpAddressReservation) (*dat
 	return dataValue.(*data.StructValue), nil
 }
 
+func WrapSegmentSecurityProfileBindingMap(bindingMap *model.SegmentSecurityProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypeSegmentSecurityProfileBindingMap
+	childSegmentSecurityProfileBindingMap := model.ChildSegmentSecurityProfileBindingMap{
+		Id:                               bindingMap.Id,
+		MarkedForDelete:                  bindingMap.MarkedForDelete,
+		ResourceType:                     ResourceTypeChildSegmentSecurityProfileBindingMap,
+		SegmentSecurityProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childSegmentSecurityProfileBindingMap, childSegmentSecurityProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapSegmentDiscoveryProfileBindingMap(bindingMap *model.SegmentDiscoveryProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypeSegmentDiscoveryProfileBindingMap
+	childSegmentDiscoveryProfileBindingMap := model.ChildSegmentDiscoveryProfileBindingMap{
+		Id:                                bindingMap.Id,
+		MarkedForDelete:                   bindingMap.MarkedForDelete,
+		ResourceType:                      ResourceTypeChildSegmentDiscoveryProfileBindingMap,
+		SegmentDiscoveryProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childSegmentDiscoveryProfileBindingMap, childSegmentDiscoveryProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapSegmentQosProfileBindingMap(bindingMap *model.SegmentQosProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypeSegmentQosProfileBindingMap
+	childSegmentQosProfileBindingMap := model.ChildSegmentQosProfileBindingMap{
+		Id:                          bindingMap.Id,
+		MarkedForDelete:             bindingMap.MarkedForDelete,
+		ResourceType:                ResourceTypeChildSegmentQosProfileBindingMap,
+		SegmentQosProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childSegmentQosProfileBindingMap, childSegmentQosProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapPortSecurityProfileBindingMap(bindingMap *model.PortSecurityProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypePortSecurityProfileBindingMap
+	childPortSecurityProfileBindingMap := model.ChildPortSecurityProfileBindingMap{
+		Id:                            bindingMap.Id,
+		MarkedForDelete:               bindingMap.MarkedForDelete,
+		ResourceType:                  ResourceTypeChildPortSecurityProfileBindingMap,
+		PortSecurityProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childPortSecurityProfileBindingMap, childPortSecurityProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapPortDiscoveryProfileBindingMap(bindingMap *model.PortDiscoveryProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypePortDiscoveryProfileBindingMap
+	childPortDiscoveryProfileBindingMap := model.ChildPortDiscoveryProfileBindingMap{
+		Id:                             bindingMap.Id,
+		MarkedForDelete:                bindingMap.MarkedForDelete,
+		ResourceType:                   ResourceTypeChildPortDiscoveryProfileBindingMap,
+		PortDiscoveryProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childPortDiscoveryProfileBindingMap, childPortDiscoveryProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapPortQosProfileBindingMap(bindingMap *model.PortQosProfileBindingMap) (*data.StructValue, error) {
+	bindingMap.ResourceType = &ResourceTypePortQosProfileBindingMap
+	childPortQosProfileBindingMap := model.ChildPortQosProfileBindingMap{
+		Id:                       bindingMap.Id,
+		MarkedForDelete:          bindingMap.MarkedForDelete,
+		ResourceType:             ResourceTypeChildPortQosProfileBindingMap,
+		PortQosProfileBindingMap: bindingMap,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childPortQosProfileBindingMap, childPortQosProfileBindingMap.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func WrapQosProfile(profile *model.QosProfile) (*data.StructValue, error) {
+	profile.ResourceType = &ResourceTypeQoSProfile
+	childQosProfile := model.ChildQosProfile{
+		Id:              profile.Id,
+		MarkedForDelete: profile.MarkedForDelete,
+		ResourceType:    ResourceTypeChildQoSProfile,
+		QosProfile:      profile,
+	}
+	dataValue, errors := NewConverter().ConvertToVapi(childQosProfile, childQosProfile.GetType__())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
 func buildInfraFromChildren(children []*data.StructValue) *model.Infra {
 	// This is the outermost layer of the hierarchy infra client.
 	// It doesn't need ID field.
@@ -362,6 +362,22 @@ func (s *DNSRecordService) ListRecordOwnerResource() map[string]sets.Set[types.N
 	return s.DNSRecordStore.GroupRecordsByResourceKind()
 }
 
+// ListRecordPathsToDeleteByOwnerNN returns the paths of the records DeleteRecordByOwnerNN would delete for kind/ns/name,
+// the records still referred by other owners are retagged instead and not returned.
+func (s *DNSRecordService) ListRecordPathsToDeleteByOwnerNN(kind, namespace, name string) ([]string, error) {
+	_, toDelete, err := s.calculateRecordsForDeletion(kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, rec := range toDelete {
+		if rec.Path != nil {
+			paths = append(paths, *rec.Path)
+		}
+	}
+	return paths, nil
+}
+
 // ListReferredGatewayNN returns Gateway NNs referenced by store index.
 func (s *DNSRecordService) ListReferredGatewayNN() sets.Set[types.NamespacedName] {
 	gatewaySet := sets.New[types.NamespacedName]()
@@ -75,4 +75,5 @@ type DNSRecordProvider interface {
 	ValidateEndpointsByZone(namespace string, owner *ResourceRef, eps []*extdns.Endpoint) ([]EndpointRow, map[string]string, error)
 	ListReferredGatewayNN() sets.Set[types.NamespacedName]
 	ListRecordOwnerResource() map[string]sets.Set[types.NamespacedName]
+	ListRecordPathsToDeleteByOwnerNN(kind, namespace, name string) ([]string, error)
 }
@@ -4,15 +4,20 @@ import (
 	"context"
 	"crypto/sha1" // #nosec G505: not used for security
 	"fmt"
+	"slices"
 	"sort"
 
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
 	"github.com/vmware/go-vmware-nsxt/common"
 	"github.com/vmware/go-vmware-nsxt/containerinventory"
 	"gopkg.in/yaml.v2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/controllers/networkinfo"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
@@ -131,6 +136,97 @@ func (s *InventoryService) BuildPod(pod *corev1.Pod) (retry bool) {
 	return
 }
 
+func (s *InventoryService) BuildVirtualMachine(vm *vmv1alpha1.VirtualMachine) (retry bool) {
+	log.Trace("Add VirtualMachine", "VirtualMachine", vm.Name, "Namespace", vm.Namespace)
+	retry = false
+	// Keep the services related to this VirtualMachine from the pendingAdd or inventory store.
+	var containerApplicationIds []string
+	if s.pendingAdd[string(vm.UID)] != nil {
+		containerApplicationInstance := s.pendingAdd[string(vm.UID)].(*containerinventory.ContainerApplicationInstance)
+		containerApplicationIds = containerApplicationInstance.ContainerApplicationIds
+	}
+
+	preContainerApplicationInstance := s.ApplicationInstanceStore.GetByKey(string(vm.UID))
+	if preContainerApplicationInstance != nil {
+		if len(containerApplicationIds) == 0 {
+			containerApplicationIds = preContainerApplicationInstance.(*containerinventory.ContainerApplicationInstance).ContainerApplicationIds
+		}
+		preContainerApplicationInstance = *preContainerApplicationInstance.(*containerinventory.ContainerApplicationInstance)
+	}
+	namespace, err := s.GetNamespace(vm.Namespace)
+	if err != nil {
+		retry = true
+		log.Error(err, "Failed to build VirtualMachine", "VirtualMachine", vm.Name, "Namespace", vm.Namespace)
+		return
+	}
+	subnetPorts, err := s.getVirtualMachineSubnetPorts(vm)
+	if err != nil {
+		retry = true
+		log.Error(err, "Failed to list SubnetPorts for VirtualMachine", "VirtualMachine", vm.Name, "Namespace", vm.Namespace)
+		return
+	}
+	clusterNodeId := ""
+	if node := s.getVirtualMachineNode(vm); node != nil {
+		clusterNodeId = string(node.UID)
+	}
+
+	status := getVirtualMachineStatus(vm)
+
+	// Create network errors from the SubnetPorts which are not ready
+	// Initialize as an empty slice to ensure NSX receives [] instead of null when clearing errors
+	networkErrors := make([]common.NetworkError, 0)
+	networkStatus := NetworkStatusHealthy
+	uniqueErrors := make(map[string]bool)
+	for _, subnetPort := range subnetPorts {
+		for _, condition := range subnetPort.Status.Conditions {
+			if condition.Type != v1alpha1.Ready || condition.Status == corev1.ConditionTrue {
+				continue
+			}
+			networkStatus = NetworkStatusUnhealthy
+			if condition.Message != "" && !uniqueErrors[condition.Message] {
+				uniqueErrors[condition.Message] = true
+				networkErrors = append(networkErrors, common.NetworkError{
+					ErrorMessage: condition.Message,
+				})
+			}
+		}
+	}
+
+	originProperties := []common.KeyValuePair{
+		{
+			Key:   OriginPropertyKind,
+			Value: OriginPropertyVirtualMachine,
+		},
+	}
+	if ips := getVirtualMachineIPs(vm, subnetPorts); len(ips) > 0 {
+		originProperties = append(originProperties, common.KeyValuePair{
+			Key:   OriginPropertyIP,
+			Value: getVirtualMachineIPsValue(ips),
+		})
+	}
+
+	containerApplicationInstance := containerinventory.ContainerApplicationInstance{
+		DisplayName:             vm.Name,
+		ResourceType:            string(ContainerApplicationInstance),
+		Tags:                    GetTagsFromLabels(vm.Labels),
+		ClusterNodeId:           clusterNodeId,
+		ContainerApplicationIds: containerApplicationIds,
+		ContainerClusterId:      util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId:      string(namespace.UID),
+		ExternalId:              string(vm.UID),
+		NetworkErrors:           networkErrors,
+		NetworkStatus:           networkStatus,
+		OriginProperties:        originProperties,
+		Status:                  status,
+	}
+	log.Trace("Build VirtualMachine", "current instance", containerApplicationInstance, "pre instance", preContainerApplicationInstance)
+	operation, _ := s.compareAndMergeUpdate(preContainerApplicationInstance, containerApplicationInstance)
+	if operation != operationNone {
+		s.pendingAdd[containerApplicationInstance.ExternalId] = &containerApplicationInstance
+	}
+	return
+}
+
 func (s *InventoryService) GetNamespace(namespace string) (*corev1.Namespace, error) {
 	ns := &corev1.Namespace{}
 	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: namespace}, ns)
@@ -205,6 +301,142 @@ func (s *InventoryService) BuildIngress(ingress *networkingv1.Ingress) (retry bo
 	return
 }
 
+func (s *InventoryService) BuildGateway(gateway *gatewayv1.Gateway) (retry bool) {
+	log.Trace("Add Gateway", "Name", gateway.Name, "Namespace", gateway.Namespace)
+	namespace, err := s.GetNamespace(gateway.Namespace)
+	retry = true
+	if err != nil {
+		log.Error(err, "Cannot find namespace for Gateway", "Gateway", gateway.Name, "Namespace", gateway.Namespace)
+		return
+	}
+	spec, err := yaml.Marshal(gateway.Spec)
+	if err != nil {
+		log.Error(err, "Failed to dump spec for Gateway", "Gateway", gateway.Name, "Namespace", gateway.Namespace)
+		return
+	}
+	appIDs, err := s.getGatewayAppIds(gateway)
+	if err != nil {
+		log.Error(err, "Failed to list HTTPRoutes for Gateway", "Gateway", gateway.Name, "Namespace", gateway.Namespace)
+		return
+	}
+
+	preGateway := s.IngressPolicyStore.GetByKey(string(gateway.UID))
+	if preGateway != nil {
+		preGateway = *preGateway.(*containerinventory.ContainerIngressPolicy)
+	}
+
+	networkErrors, networkStatus := getNetworkErrorsFromConditions(gateway.Status.Conditions,
+		string(gatewayv1.GatewayConditionAccepted), string(gatewayv1.GatewayConditionProgrammed))
+
+	containerIngress := containerinventory.ContainerIngressPolicy{
+		DisplayName:        gateway.Name,
+		ResourceType:       string(ContainerIngressPolicy),
+		Tags:               GetTagsFromLabels(gateway.Labels),
+		ContainerClusterId: util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId: string(namespace.UID),
+		ExternalId:         string(gateway.UID),
+		NetworkErrors:      networkErrors,
+		NetworkStatus:      networkStatus,
+		OriginProperties: []common.KeyValuePair{
+			{
+				Key:   OriginPropertyKind,
+				Value: OriginPropertyGateway,
+			},
+		},
+		Spec: string(spec),
+	}
+	if len(appIDs) > 0 {
+		containerIngress.ContainerApplicationIds = appIDs
+	}
+	log.Trace("Build Gateway", "current instance", containerIngress, "pre instance", preGateway)
+	operation, _ := s.compareAndMergeUpdate(preGateway, containerIngress)
+	if operation != operationNone {
+		s.pendingAdd[containerIngress.ExternalId] = &containerIngress
+	}
+	retry = false
+	return
+}
+
+func (s *InventoryService) BuildHTTPRoute(route *gatewayv1.HTTPRoute) (retry bool) {
+	log.Trace("Add HTTPRoute", "Name", route.Name, "Namespace", route.Namespace)
+	namespace, err := s.GetNamespace(route.Namespace)
+	retry = true
+	if err != nil {
+		log.Error(err, "Cannot find namespace for HTTPRoute", "HTTPRoute", route.Name, "Namespace", route.Namespace)
+		return
+	}
+	spec, err := yaml.Marshal(route.Spec)
+	if err != nil {
+		log.Error(err, "Failed to dump spec for HTTPRoute", "HTTPRoute", route.Name, "Namespace", route.Namespace)
+		return
+	}
+
+	preRoute := s.IngressPolicyStore.GetByKey(string(route.UID))
+	if preRoute != nil {
+		preRoute = *preRoute.(*containerinventory.ContainerIngressPolicy)
+	}
+
+	// Collect the conditions reported by all the parent Gateways
+	var conditions []metav1.Condition
+	for _, parent := range route.Status.Parents {
+		conditions = append(conditions, parent.Conditions...)
+	}
+	networkErrors, networkStatus := getNetworkErrorsFromConditions(conditions,
+		string(gatewayv1.RouteConditionAccepted), string(gatewayv1.RouteConditionResolvedRefs))
+
+	containerIngress := containerinventory.ContainerIngressPolicy{
+		DisplayName:        route.Name,
+		ResourceType:       string(ContainerIngressPolicy),
+		Tags:               GetTagsFromLabels(route.Labels),
+		ContainerClusterId: util.GetClusterUUID(s.NSXConfig.Cluster).String(),
+		ContainerProjectId: string(namespace.UID),
+		ExternalId:         string(route.UID),
+		NetworkErrors:      networkErrors,
+		NetworkStatus:      networkStatus,
+		OriginProperties: []common.KeyValuePair{
+			{
+				Key:   OriginPropertyKind,
+				Value: OriginPropertyHTTPRoute,
+			},
+		},
+		Spec: string(spec),
+	}
+	appIDs := s.getServiceAppIds(getHTTPRouteServices(route))
+	if len(appIDs) > 0 {
+		containerIngress.ContainerApplicationIds = appIDs
+	}
+	log.Trace("Build HTTPRoute", "current instance", containerIngress, "pre instance", preRoute)
+	operation, _ := s.compareAndMergeUpdate(preRoute, containerIngress)
+	if operation != operationNone {
+		s.pendingAdd[containerIngress.ExternalId] = &containerIngress
+	}
+	retry = false
+	return
+}
+
+// getNetworkErrorsFromConditions builds the network errors from the given types of conditions which are not True.
+func getNetworkErrorsFromConditions(conditions []metav1.Condition, conditionTypes ...string) ([]common.NetworkError, string) {
+	// Initialize as empty slice to ensure NSX receives [] instead of null when clearing errors
+	networkErrors := make([]common.NetworkError, 0)
+	networkStatus := NetworkStatusHealthy
+	// Use a map to track unique error messages
+	uniqueErrors := make(map[string]bool)
+	for _, condition := range conditions {
+		if !slices.Contains(conditionTypes, condition.Type) || condition.Status == metav1.ConditionTrue {
+			continue
+		}
+		networkStatus = NetworkStatusUnhealthy
+		errorMessage := condition.Type + ":" + condition.Message
+		if !uniqueErrors[errorMessage] {
+			uniqueErrors[errorMessage] = true
+			networkErrors = append(networkErrors, common.NetworkError{
+				ErrorMessage: errorMessage,
+			})
+		}
+	}
+	return networkErrors, networkStatus
+}
+
 func (s *InventoryService) BuildInventoryCluster() containerinventory.ContainerCluster {
 	scope := containerinventory.DiscoveredResourceScope{
 		ScopeId:   util.GetClusterUUID(s.NSXConfig.Cluster).String(),
@@ -365,7 +597,9 @@ func (s *InventoryService) BuildService(service *corev1.Service) (retry bool) {
 	status, netStatus := s.determineServiceStatus(podIDs, hasAddr, service.Annotations, &networkErrors, uniqueErrors)
 
 	// Update the Pods' service IDs, which are related to this service
-	retry = s.synchronizeServiceIDsWithApplicationInstances(podIDs, service)
+	if !s.dryRun {
+		retry = s.synchronizeServiceIDsWithApplicationInstances(podIDs, service)
+	}
 
 	serviceType := "ClusterIP"
 	if string(service.Spec.Type) != "" {
@@ -80,10 +80,11 @@ func compareContainerApplicationInstance(pre interface{}, cur interface{}, prope
 	if pre == nil || !reflect.DeepEqual(preAppInstance.NetworkErrors, curAppInstance.NetworkErrors) {
 		property["network_errors"] = curAppInstance.NetworkErrors
 	}
-	if pre == nil && len(curAppInstance.OriginProperties) == 1 {
+	if pre == nil && len(curAppInstance.OriginProperties) > 0 {
 		property["origin_properties"] = curAppInstance.OriginProperties
 	} else if pre != nil && !reflect.DeepEqual(preAppInstance.OriginProperties, curAppInstance.OriginProperties) {
-		if isIPChanged(preAppInstance, curAppInstance) {
+		// VirtualMachine IPs are sorted when building, so any difference is a real change.
+		if isVirtualMachineInstance(&curAppInstance) || isIPChanged(preAppInstance, curAppInstance) {
 			property["origin_properties"] = curAppInstance.OriginProperties
 		}
 	}
@@ -115,6 +116,10 @@ func compareContainerIngressPolicy(pre interface{}, cur interface{}, property ma
 	if pre == nil || !reflect.DeepEqual(preIngressPolicy.ContainerApplicationIds, curIngressPolicy.ContainerApplicationIds) {
 		property["container_application_ids"] = curIngressPolicy.ContainerApplicationIds
 	}
+	if (pre == nil && len(curIngressPolicy.OriginProperties) > 0) ||
+		(pre != nil && !reflect.DeepEqual(preIngressPolicy.OriginProperties, curIngressPolicy.OriginProperties)) {
+		property["origin_properties"] = curIngressPolicy.OriginProperties
+	}
 }
 
 func isIPChanged(pre containerinventory.ContainerApplicationInstance, cur containerinventory.ContainerApplicationInstance) bool {
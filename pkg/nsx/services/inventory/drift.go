@@ -0,0 +1,286 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+// DriftItem is an inventory object which is different between NSX and Kubernetes.
+type DriftItem struct {
+	ExternalId string `json:"externalId"`
+	// Key is the namespace/name of the Kubernetes object.
+	Key string `json:"key"`
+	// Properties are the mismatched NSX properties.
+	Properties []string `json:"properties,omitempty"`
+
+	keyType InventoryType
+}
+
+// TypeDrift is the drift of one NSX inventory type.
+type TypeDrift struct {
+	// Missing objects exist in Kubernetes but not in NSX.
+	Missing []DriftItem `json:"missing,omitempty"`
+	// Stale objects exist in NSX but not in Kubernetes.
+	Stale []DriftItem `json:"stale,omitempty"`
+	// Mismatched objects exist in both but some properties are different.
+	Mismatched []DriftItem `json:"mismatched,omitempty"`
+}
+
+// DriftReport is the drift between the NSX inventory stores and Kubernetes, keyed by NSX inventory type.
+type DriftReport map[InventoryType]*TypeDrift
+
+func (r DriftReport) typeDrift(resourceType InventoryType) *TypeDrift {
+	if r[resourceType] == nil {
+		r[resourceType] = &TypeDrift{}
+	}
+	return r[resourceType]
+}
+
+// Count returns the number of the drifted objects.
+func (r DriftReport) Count() int {
+	count := 0
+	for _, drift := range r {
+		count += len(drift.Missing) + len(drift.Stale) + len(drift.Mismatched)
+	}
+	return count
+}
+
+// InventoryKeys returns the keys to sync for repairing the drift.
+func (r DriftReport) InventoryKeys() []InventoryKey {
+	var keys []InventoryKey
+	for _, drift := range r {
+		for _, items := range [][]DriftItem{drift.Missing, drift.Stale, drift.Mismatched} {
+			for _, item := range items {
+				keys = append(keys, InventoryKey{InventoryType: item.keyType, ExternalId: item.ExternalId, Key: item.Key})
+			}
+		}
+	}
+	return keys
+}
+
+// DetectDrift diffs every inventory store against the Kubernetes objects without changing anything.
+// The expected NSX objects are built in dry run mode, so the caller must make sure no inventory sync
+// is running at the same time.
+func (s *InventoryService) DetectDrift(ctx context.Context) (DriftReport, error) {
+	requestBuffer, pendingAdd, pendingDelete := s.requestBuffer, s.pendingAdd, s.pendingDelete
+	s.requestBuffer = make([]containerinventory.ContainerInventoryObject, 0)
+	s.pendingAdd = make(map[string]interface{})
+	s.pendingDelete = make(map[string]interface{})
+	s.dryRun = true
+	defer func() {
+		s.requestBuffer, s.pendingAdd, s.pendingDelete = requestBuffer, pendingAdd, pendingDelete
+		s.dryRun = false
+	}()
+
+	report := DriftReport{}
+	existing := sets.New[string]()
+	check := func(keyType InventoryType, resourceType InventoryType, obj client.Object, build func() bool) {
+		externalId := string(obj.GetUID())
+		existing.Insert(externalId)
+		key, _ := cache.MetaNamespaceKeyFunc(obj)
+		start := len(s.requestBuffer)
+		if retry := build(); retry {
+			log.Info("Skip drift detection for inventory object", "type", keyType, "key", key)
+			return
+		}
+		for _, request := range s.requestBuffer[start:] {
+			// Skip the updates for the related objects, e.g. the service ids of Pods updated by Service.
+			if request.ContainerObject["external_id"] != externalId {
+				continue
+			}
+			item := DriftItem{ExternalId: externalId, Key: key, keyType: keyType}
+			switch request.ObjectUpdateType {
+			case operationCreate:
+				report.typeDrift(resourceType).Missing = append(report.typeDrift(resourceType).Missing, item)
+			case operationUpdate:
+				for property := range request.ContainerObject {
+					if property != "external_id" && property != "resource_type" {
+						item.Properties = append(item.Properties, property)
+					}
+				}
+				sort.Strings(item.Properties)
+				report.typeDrift(resourceType).Mismatched = append(report.typeDrift(resourceType).Mismatched, item)
+			}
+		}
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := s.Client.List(ctx, namespaces); err != nil {
+		return nil, fmt.Errorf("failed to list Namespaces: %w", err)
+	}
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		check(ContainerProject, ContainerProject, namespace, func() bool { return s.BuildNamespace(namespace) })
+	}
+	nodes := &corev1.NodeList{}
+	if err := s.Client.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list Nodes: %w", err)
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		check(ContainerClusterNode, ContainerClusterNode, node, func() bool { return s.BuildNode(node) })
+	}
+	pods := &corev1.PodList{}
+	if err := s.Client.List(ctx, pods); err != nil {
+		return nil, fmt.Errorf("failed to list Pods: %w", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		check(ContainerApplicationInstance, ContainerApplicationInstance, pod, func() bool { return s.BuildPod(pod) })
+	}
+	vms := &vmv1alpha1.VirtualMachineList{}
+	if err := s.listOptional(ctx, vms); err != nil {
+		return nil, fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+	for i := range vms.Items {
+		vm := &vms.Items[i]
+		check(VirtualMachine, ContainerApplicationInstance, vm, func() bool { return s.BuildVirtualMachine(vm) })
+	}
+	services := &corev1.ServiceList{}
+	if err := s.Client.List(ctx, services); err != nil {
+		return nil, fmt.Errorf("failed to list Services: %w", err)
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		check(ContainerApplication, ContainerApplication, service, func() bool { return s.BuildService(service) })
+	}
+	networkPolicies := &networkingv1.NetworkPolicyList{}
+	if err := s.Client.List(ctx, networkPolicies); err != nil {
+		return nil, fmt.Errorf("failed to list NetworkPolicies: %w", err)
+	}
+	for i := range networkPolicies.Items {
+		networkPolicy := &networkPolicies.Items[i]
+		check(ContainerNetworkPolicy, ContainerNetworkPolicy, networkPolicy, func() bool { return s.BuildNetworkPolicy(networkPolicy) })
+	}
+	ingresses := &networkingv1.IngressList{}
+	if err := s.Client.List(ctx, ingresses); err != nil {
+		return nil, fmt.Errorf("failed to list Ingresses: %w", err)
+	}
+	for i := range ingresses.Items {
+		ingress := &ingresses.Items[i]
+		check(ContainerIngressPolicy, ContainerIngressPolicy, ingress, func() bool { return s.BuildIngress(ingress) })
+	}
+	gateways := &gatewayv1.GatewayList{}
+	if err := s.listOptional(ctx, gateways); err != nil {
+		return nil, fmt.Errorf("failed to list Gateways: %w", err)
+	}
+	for i := range gateways.Items {
+		gateway := &gateways.Items[i]
+		check(Gateway, ContainerIngressPolicy, gateway, func() bool { return s.BuildGateway(gateway) })
+	}
+	routes := &gatewayv1.HTTPRouteList{}
+	if err := s.listOptional(ctx, routes); err != nil {
+		return nil, fmt.Errorf("failed to list HTTPRoutes: %w", err)
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		check(HTTPRoute, ContainerIngressPolicy, route, func() bool { return s.BuildHTTPRoute(route) })
+	}
+
+	s.detectStaleObjects(report, existing)
+	return report, nil
+}
+
+// listOptional lists the objects whose CRD may not be installed in the cluster.
+func (s *InventoryService) listOptional(ctx context.Context, list client.ObjectList) error {
+	if err := s.Client.List(ctx, list); err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *InventoryService) detectStaleObjects(report DriftReport, existing sets.Set[string]) {
+	namespaceName := func(projectId string) string {
+		if projectId == "" {
+			return ""
+		}
+		project := s.ProjectStore.GetByKey(projectId)
+		if project == nil {
+			return ""
+		}
+		return project.(*containerinventory.ContainerProject).DisplayName
+	}
+	addStale := func(resourceType InventoryType, keyType InventoryType, externalId, namespace, name string) {
+		if existing.Has(externalId) {
+			return
+		}
+		key := name
+		if namespace != "" {
+			key = namespace + "/" + name
+		}
+		drift := report.typeDrift(resourceType)
+		drift.Stale = append(drift.Stale, DriftItem{ExternalId: externalId, Key: key, keyType: keyType})
+	}
+
+	for _, obj := range s.ProjectStore.List() {
+		project := obj.(*containerinventory.ContainerProject)
+		addStale(ContainerProject, ContainerProject, project.ExternalId, "", project.DisplayName)
+	}
+	for _, obj := range s.ClusterNodeStore.List() {
+		node := obj.(*containerinventory.ContainerClusterNode)
+		addStale(ContainerClusterNode, ContainerClusterNode, node.ExternalId, "", node.DisplayName)
+	}
+	for _, obj := range s.ApplicationInstanceStore.List() {
+		instance := obj.(*containerinventory.ContainerApplicationInstance)
+		keyType := ContainerApplicationInstance
+		if isVirtualMachineInstance(instance) {
+			keyType = VirtualMachine
+		}
+		addStale(ContainerApplicationInstance, keyType, instance.ExternalId, namespaceName(instance.ContainerProjectId), instance.DisplayName)
+	}
+	for externalId, obj := range s.stalePods {
+		instance := obj.(*containerinventory.ContainerApplicationInstance)
+		addStale(ContainerApplicationInstance, ContainerApplicationInstance, externalId, "", instance.DisplayName)
+	}
+	for _, obj := range s.ApplicationStore.List() {
+		application := obj.(*containerinventory.ContainerApplication)
+		addStale(ContainerApplication, ContainerApplication, application.ExternalId, namespaceName(application.ContainerProjectId), application.DisplayName)
+	}
+	for _, obj := range s.NetworkPolicyStore.List() {
+		networkPolicy := obj.(*containerinventory.ContainerNetworkPolicy)
+		addStale(ContainerNetworkPolicy, ContainerNetworkPolicy, networkPolicy.ExternalId, namespaceName(networkPolicy.ContainerProjectId), networkPolicy.DisplayName)
+	}
+	for _, obj := range s.IngressPolicyStore.List() {
+		ingressPolicy := obj.(*containerinventory.ContainerIngressPolicy)
+		keyType := ContainerIngressPolicy
+		switch getIngressPolicyKind(ingressPolicy) {
+		case OriginPropertyGateway:
+			keyType = Gateway
+		case OriginPropertyHTTPRoute:
+			keyType = HTTPRoute
+		}
+		addStale(ContainerIngressPolicy, keyType, ingressPolicy.ExternalId, namespaceName(ingressPolicy.ContainerProjectId), ingressPolicy.DisplayName)
+	}
+}
+
+// RefreshInventoryStores reloads all the inventory stores from NSX, so that the objects changed in NSX
+// out of band are taken into account in the next drift detection.
+func (s *InventoryService) RefreshInventoryStores() error {
+	refreshed := NewInventoryService(s.Service)
+	if err := refreshed.SyncInventoryStoreByType(util.GetClusterUUID(s.NSXConfig.Cluster).String()); err != nil {
+		return err
+	}
+	s.ApplicationInstanceStore = refreshed.ApplicationInstanceStore
+	s.ApplicationStore = refreshed.ApplicationStore
+	s.ProjectStore = refreshed.ProjectStore
+	s.ClusterNodeStore = refreshed.ClusterNodeStore
+	s.NetworkPolicyStore = refreshed.NetworkPolicyStore
+	s.IngressPolicyStore = refreshed.IngressPolicyStore
+	for externalId, instance := range refreshed.stalePods {
+		s.stalePods[externalId] = instance
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestDetectDrift(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "ns-uid", Labels: map[string]string{"env": "test"}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns1", UID: "pod-uid"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}}},
+	}
+
+	t.Run("MissingStaleAndMismatched", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				if ns, ok := obj.(*corev1.Namespace); ok {
+					namespace.DeepCopyInto(ns)
+				}
+				return nil
+			}).AnyTimes()
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				switch l := list.(type) {
+				case *corev1.NamespaceList:
+					l.Items = []corev1.Namespace{*namespace}
+				case *corev1.PodList:
+					l.Items = []corev1.Pod{*pod}
+				case *gatewayv1.GatewayList, *gatewayv1.HTTPRouteList:
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gatewayv1.GroupName}}
+				}
+				return nil
+			}).AnyTimes()
+
+		// The Namespace was synced to NSX before its labels are changed.
+		oldNamespace := namespace.DeepCopy()
+		oldNamespace.Labels = nil
+		inventoryService.BuildNamespace(oldNamespace)
+		inventoryService.ProjectStore.Add(inventoryService.pendingAdd["ns-uid"].(*containerinventory.ContainerProject))
+		inventoryService.ClusterNodeStore.Add(&containerinventory.ContainerClusterNode{
+			DisplayName:  "stale-node",
+			ExternalId:   "node-uid",
+			ResourceType: string(ContainerClusterNode),
+		})
+		inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+			DisplayName:        "stale-vm",
+			ExternalId:         "vm-uid",
+			ResourceType:       string(ContainerApplicationInstance),
+			ContainerProjectId: "ns-uid",
+			OriginProperties:   []common.KeyValuePair{{Key: OriginPropertyKind, Value: OriginPropertyVirtualMachine}},
+		})
+		// Pending requests must be kept after drift detection.
+		pendingRequests := len(inventoryService.requestBuffer)
+
+		report, err := inventoryService.DetectDrift(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 4, report.Count())
+		assert.Equal(t, []DriftItem{{ExternalId: "ns-uid", Key: "ns1", Properties: []string{"tags"}, keyType: ContainerProject}}, report[ContainerProject].Mismatched)
+		assert.Equal(t, []DriftItem{{ExternalId: "pod-uid", Key: "ns1/pod1", keyType: ContainerApplicationInstance}}, report[ContainerApplicationInstance].Missing)
+		assert.Equal(t, []DriftItem{{ExternalId: "vm-uid", Key: "ns1/stale-vm", keyType: VirtualMachine}}, report[ContainerApplicationInstance].Stale)
+		assert.Equal(t, []DriftItem{{ExternalId: "node-uid", Key: "stale-node", keyType: ContainerClusterNode}}, report[ContainerClusterNode].Stale)
+
+		keys := report.InventoryKeys()
+		assert.ElementsMatch(t, []InventoryKey{
+			{InventoryType: ContainerProject, ExternalId: "ns-uid", Key: "ns1"},
+			{InventoryType: ContainerApplicationInstance, ExternalId: "pod-uid", Key: "ns1/pod1"},
+			{InventoryType: VirtualMachine, ExternalId: "vm-uid", Key: "ns1/stale-vm"},
+			{InventoryType: ContainerClusterNode, ExternalId: "node-uid", Key: "stale-node"},
+		}, keys)
+
+		assert.Equal(t, pendingRequests, len(inventoryService.requestBuffer))
+		assert.Contains(t, inventoryService.pendingAdd, "ns-uid")
+		assert.NotContains(t, inventoryService.pendingAdd, "pod-uid")
+		assert.False(t, inventoryService.dryRun)
+	})
+
+	t.Run("ListFailure", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("list failed"))
+		report, err := inventoryService.DetectDrift(context.TODO())
+		assert.ErrorContains(t, err, "list failed")
+		assert.Nil(t, report)
+		assert.False(t, inventoryService.dryRun)
+	})
+}
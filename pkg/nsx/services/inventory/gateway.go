@@ -0,0 +1,168 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func (s *InventoryService) IsGatewayDeleted(namespace, name, externalId string, gateway *gatewayv1.Gateway) bool {
+	if gateway == nil {
+		gateway = &gatewayv1.Gateway{}
+	}
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, gateway)
+	if apierrors.IsNotFound(err) ||
+		((err == nil) && (string(gateway.UID) != externalId)) {
+		return true
+	} else {
+		log.Error(err, "Check Gateway deleted", "Gateway", name, "Namespace", namespace, "External id", externalId)
+		return false
+	}
+}
+
+func (s *InventoryService) IsHTTPRouteDeleted(namespace, name, externalId string, route *gatewayv1.HTTPRoute) bool {
+	if route == nil {
+		route = &gatewayv1.HTTPRoute{}
+	}
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, route)
+	if apierrors.IsNotFound(err) ||
+		((err == nil) && (string(route.UID) != externalId)) {
+		return true
+	} else {
+		log.Error(err, "Check HTTPRoute deleted", "HTTPRoute", name, "Namespace", namespace, "External id", externalId)
+		return false
+	}
+}
+
+func (s *InventoryService) SyncGateway(name string, namespace string, key InventoryKey) *InventoryKey {
+	gateway := &gatewayv1.Gateway{}
+	externalId := key.ExternalId
+	if deleted := s.IsGatewayDeleted(namespace, name, externalId, gateway); deleted {
+		err := s.DeleteResource(externalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Delete Gateway ContainerIngressPolicy Resource error", "key", key)
+			return &key
+		}
+	} else if gateway.UID == types.UID(externalId) {
+		retry := s.BuildGateway(gateway)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(errors.New("no gateway found"), "Unexpected error is found while processing Gateway", "key", key)
+	}
+	return nil
+}
+
+func (s *InventoryService) SyncHTTPRoute(name string, namespace string, key InventoryKey) *InventoryKey {
+	route := &gatewayv1.HTTPRoute{}
+	externalId := key.ExternalId
+	if deleted := s.IsHTTPRouteDeleted(namespace, name, externalId, route); deleted {
+		err := s.DeleteResource(externalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Delete HTTPRoute ContainerIngressPolicy Resource error", "key", key)
+			return &key
+		}
+	} else if route.UID == types.UID(externalId) {
+		retry := s.BuildHTTPRoute(route)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(errors.New("no httproute found"), "Unexpected error is found while processing HTTPRoute", "key", key)
+	}
+	return nil
+}
+
+// getHTTPRouteServices collects the Services referred by the HTTPRoute backendRefs.
+func getHTTPRouteServices(route *gatewayv1.HTTPRoute) sets.Set[types.NamespacedName] {
+	serviceSet := sets.New[types.NamespacedName]()
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			ref := backendRef.BackendObjectReference
+			// Only core Services can be resolved to ContainerApplications.
+			if ref.Group != nil && *ref.Group != "" {
+				continue
+			}
+			if ref.Kind != nil && *ref.Kind != "Service" {
+				continue
+			}
+			namespace := route.Namespace
+			if ref.Namespace != nil {
+				namespace = string(*ref.Namespace)
+			}
+			serviceSet.Insert(types.NamespacedName{Namespace: namespace, Name: string(ref.Name)})
+		}
+	}
+	return serviceSet
+}
+
+// getServiceAppIds resolves the Services to the ContainerApplication ids, the Services not found are skipped.
+func (s *InventoryService) getServiceAppIds(serviceSet sets.Set[types.NamespacedName]) []string {
+	result := []string{}
+	for serviceKey := range serviceSet {
+		service := &corev1.Service{}
+		err := s.Client.Get(context.TODO(), serviceKey, service)
+		if err != nil {
+			log.Error(err, "Failed to get service", "service", serviceKey)
+			continue
+		}
+		result = append(result, string(service.UID))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// isHTTPRouteAttachedToGateway checks if the Gateway is one of the HTTPRoute parentRefs.
+func isHTTPRouteAttachedToGateway(route *gatewayv1.HTTPRoute, gateway *gatewayv1.Gateway) bool {
+	for _, parentRef := range route.Spec.ParentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gatewayv1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+			continue
+		}
+		namespace := route.Namespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		if namespace == gateway.Namespace && string(parentRef.Name) == gateway.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// getGatewayAppIds collects the ContainerApplication ids from the backendRefs of all the HTTPRoutes attached to the Gateway.
+func (s *InventoryService) getGatewayAppIds(gateway *gatewayv1.Gateway) ([]string, error) {
+	routeList := &gatewayv1.HTTPRouteList{}
+	if err := s.Client.List(context.TODO(), routeList); err != nil {
+		return nil, err
+	}
+	serviceSet := sets.New[types.NamespacedName]()
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if isHTTPRouteAttachedToGateway(route, gateway) {
+			serviceSet = serviceSet.Union(getHTTPRouteServices(route))
+		}
+	}
+	return s.getServiceAppIds(serviceSet), nil
+}
+
+// getIngressPolicyKind returns the kind of the Kubernetes object from which the ContainerIngressPolicy is built,
+// empty kind means the ContainerIngressPolicy is built from an Ingress.
+func getIngressPolicyKind(ingressPolicy *containerinventory.ContainerIngressPolicy) string {
+	for _, prop := range ingressPolicy.OriginProperties {
+		if prop.Key == OriginPropertyKind {
+			return prop.Value
+		}
+	}
+	return ""
+}
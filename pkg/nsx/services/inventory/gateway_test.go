@@ -0,0 +1,282 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func newTestHTTPRoute(name, gatewayName string, services ...string) *gatewayv1.HTTPRoute {
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name + "-uid"),
+		},
+	}
+	route.Spec.ParentRefs = []gatewayv1.ParentReference{{Name: gatewayv1.ObjectName(gatewayName)}}
+	rule := gatewayv1.HTTPRouteRule{}
+	for _, service := range services {
+		rule.BackendRefs = append(rule.BackendRefs, gatewayv1.HTTPBackendRef{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(service)},
+			},
+		})
+	}
+	route.Spec.Rules = []gatewayv1.HTTPRouteRule{rule}
+	return route
+}
+
+func mockGatewayObjectsGet(namespace *corev1.Namespace) func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+	return func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			namespace.DeepCopyInto(o)
+		case *corev1.Service:
+			if key.Name == "missing" {
+				return apierrors.NewNotFound(schema.GroupResource{Resource: "services"}, key.Name)
+			}
+			o.UID = types.UID(key.Name + "-uid")
+		}
+		return nil
+	}
+}
+
+func TestGetHTTPRouteServices(t *testing.T) {
+	route := newTestHTTPRoute("route", "gateway", "svc1", "svc2", "svc1")
+	route.Spec.Rules[0].BackendRefs = append(route.Spec.Rules[0].BackendRefs,
+		gatewayv1.HTTPBackendRef{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name:      "svc3",
+			Namespace: ptr.To(gatewayv1.Namespace("other")),
+		}}},
+		gatewayv1.HTTPBackendRef{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name:  "bucket",
+			Group: ptr.To(gatewayv1.Group("storage.example.com")),
+			Kind:  ptr.To(gatewayv1.Kind("Bucket")),
+		}}},
+	)
+	services := getHTTPRouteServices(route)
+	assert.Equal(t, 3, services.Len())
+	assert.True(t, services.Has(types.NamespacedName{Namespace: "default", Name: "svc1"}))
+	assert.True(t, services.Has(types.NamespacedName{Namespace: "default", Name: "svc2"}))
+	assert.True(t, services.Has(types.NamespacedName{Namespace: "other", Name: "svc3"}))
+}
+
+func TestIsHTTPRouteAttachedToGateway(t *testing.T) {
+	gateway := &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"}}
+	assert.True(t, isHTTPRouteAttachedToGateway(newTestHTTPRoute("route", "gateway"), gateway))
+	assert.False(t, isHTTPRouteAttachedToGateway(newTestHTTPRoute("route", "other-gateway"), gateway))
+
+	route := newTestHTTPRoute("route", "gateway")
+	route.Namespace = "app"
+	assert.False(t, isHTTPRouteAttachedToGateway(route, gateway))
+	route.Spec.ParentRefs[0].Namespace = ptr.To(gatewayv1.Namespace("default"))
+	assert.True(t, isHTTPRouteAttachedToGateway(route, gateway))
+	route.Spec.ParentRefs[0].Kind = ptr.To(gatewayv1.Kind("Service"))
+	assert.False(t, isHTTPRouteAttachedToGateway(route, gateway))
+}
+
+func TestBuildGateway(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "namespace-uid"}}
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "default",
+			UID:       "gateway-uid",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "nsx",
+			Listeners:        []gatewayv1.Listener{{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType}},
+		},
+	}
+
+	t.Run("NormalFlow", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockGatewayObjectsGet(namespace)).AnyTimes()
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				routeList := list.(*gatewayv1.HTTPRouteList)
+				routeList.Items = []gatewayv1.HTTPRoute{
+					*newTestHTTPRoute("route1", "gateway", "svc1"),
+					*newTestHTTPRoute("route2", "gateway", "svc2", "missing"),
+					*newTestHTTPRoute("route3", "other-gateway", "svc3"),
+				}
+				return nil
+			})
+
+		gw := gateway.DeepCopy()
+		gw.Status.Conditions = []metav1.Condition{
+			{Type: string(gatewayv1.GatewayConditionAccepted), Status: metav1.ConditionTrue},
+			{Type: string(gatewayv1.GatewayConditionProgrammed), Status: metav1.ConditionFalse, Message: "no address assigned"},
+		}
+		retry := inventoryService.BuildGateway(gw)
+		assert.False(t, retry)
+		ingressPolicy := inventoryService.pendingAdd["gateway-uid"].(*containerinventory.ContainerIngressPolicy)
+		assert.Equal(t, string(ContainerIngressPolicy), ingressPolicy.ResourceType)
+		assert.Equal(t, "namespace-uid", ingressPolicy.ContainerProjectId)
+		assert.Equal(t, []string{"svc1-uid", "svc2-uid"}, ingressPolicy.ContainerApplicationIds)
+		assert.Equal(t, NetworkStatusUnhealthy, ingressPolicy.NetworkStatus)
+		assert.Equal(t, []common.NetworkError{{ErrorMessage: "Programmed:no address assigned"}}, ingressPolicy.NetworkErrors)
+		assert.Equal(t, OriginPropertyGateway, getIngressPolicyKind(ingressPolicy))
+		assert.Contains(t, ingressPolicy.Spec, "gatewayclassname: nsx")
+	})
+
+	t.Run("NamespaceNotFound", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("not found"))
+		retry := inventoryService.BuildGateway(gateway)
+		assert.True(t, retry)
+	})
+
+	t.Run("ListHTTPRoutesFailure", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockGatewayObjectsGet(namespace))
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("list failed"))
+		retry := inventoryService.BuildGateway(gateway)
+		assert.True(t, retry)
+		assert.NotContains(t, inventoryService.pendingAdd, "gateway-uid")
+	})
+}
+
+func TestBuildHTTPRoute(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "namespace-uid"}}
+
+	t.Run("NormalFlow", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockGatewayObjectsGet(namespace)).AnyTimes()
+
+		route := newTestHTTPRoute("route", "gateway", "svc2", "svc1")
+		route.Status.Parents = []gatewayv1.RouteParentStatus{{
+			ParentRef: gatewayv1.ParentReference{Name: "gateway"},
+			Conditions: []metav1.Condition{
+				{Type: string(gatewayv1.RouteConditionAccepted), Status: metav1.ConditionTrue},
+				{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionTrue},
+			},
+		}}
+		retry := inventoryService.BuildHTTPRoute(route)
+		assert.False(t, retry)
+		ingressPolicy := inventoryService.pendingAdd["route-uid"].(*containerinventory.ContainerIngressPolicy)
+		assert.Equal(t, []string{"svc1-uid", "svc2-uid"}, ingressPolicy.ContainerApplicationIds)
+		assert.Equal(t, NetworkStatusHealthy, ingressPolicy.NetworkStatus)
+		assert.Empty(t, ingressPolicy.NetworkErrors)
+		assert.Equal(t, OriginPropertyHTTPRoute, getIngressPolicyKind(ingressPolicy))
+
+		// No update is sent if nothing is changed.
+		inventoryService.IngressPolicyStore.Add(ingressPolicy)
+		inventoryService.pendingAdd = make(map[string]interface{})
+		inventoryService.requestBuffer = nil
+		retry = inventoryService.BuildHTTPRoute(route)
+		assert.False(t, retry)
+		assert.NotContains(t, inventoryService.pendingAdd, "route-uid")
+	})
+
+	t.Run("RouteNotResolved", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(mockGatewayObjectsGet(namespace)).AnyTimes()
+
+		route := newTestHTTPRoute("route", "gateway", "missing")
+		route.Status.Parents = []gatewayv1.RouteParentStatus{{
+			ParentRef: gatewayv1.ParentReference{Name: "gateway"},
+			Conditions: []metav1.Condition{
+				{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: metav1.ConditionFalse, Message: "backend not found"},
+			},
+		}}
+		retry := inventoryService.BuildHTTPRoute(route)
+		assert.False(t, retry)
+		ingressPolicy := inventoryService.pendingAdd["route-uid"].(*containerinventory.ContainerIngressPolicy)
+		assert.Nil(t, ingressPolicy.ContainerApplicationIds)
+		assert.Equal(t, NetworkStatusUnhealthy, ingressPolicy.NetworkStatus)
+		assert.Equal(t, []common.NetworkError{{ErrorMessage: "ResolvedRefs:backend not found"}}, ingressPolicy.NetworkErrors)
+	})
+}
+
+func TestSyncGatewayAndHTTPRoute(t *testing.T) {
+	t.Run("GatewayDeleted", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(apierrors.NewNotFound(schema.GroupResource{Resource: "gateways"}, "gateway"))
+		inventoryService.IngressPolicyStore.Add(&containerinventory.ContainerIngressPolicy{
+			DisplayName:        "gateway",
+			ExternalId:         "gateway-uid",
+			ResourceType:       string(ContainerIngressPolicy),
+			ContainerProjectId: "namespace-uid",
+		})
+		key := InventoryKey{InventoryType: Gateway, ExternalId: "gateway-uid", Key: "default/gateway"}
+		retryKey := inventoryService.SyncGateway("gateway", "default", key)
+		assert.Nil(t, retryKey)
+		assert.Contains(t, inventoryService.pendingDelete, "gateway-uid")
+	})
+
+	t.Run("HTTPRouteBuildRetry", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				obj.(*gatewayv1.HTTPRoute).UID = "route-uid"
+				return nil
+			})
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "BuildHTTPRoute", func(_ *InventoryService, _ *gatewayv1.HTTPRoute) bool {
+			return true
+		})
+		defer patches.Reset()
+		key := InventoryKey{InventoryType: HTTPRoute, ExternalId: "route-uid", Key: "default/route"}
+		retryKey := inventoryService.SyncHTTPRoute("route", "default", key)
+		assert.Equal(t, &key, retryKey)
+	})
+}
+
+func TestCleanStaleInventoryGatewayIngressPolicy(t *testing.T) {
+	inventoryService, _ := createService(t)
+	inventoryService.ProjectStore.Add(&containerinventory.ContainerProject{
+		DisplayName:  "default",
+		ExternalId:   "namespace-uid",
+		ResourceType: string(ContainerProject),
+	})
+	inventoryService.IngressPolicyStore.Add(&containerinventory.ContainerIngressPolicy{
+		DisplayName:        "gateway",
+		ExternalId:         "gateway-uid",
+		ResourceType:       string(ContainerIngressPolicy),
+		ContainerProjectId: "namespace-uid",
+		OriginProperties:   []common.KeyValuePair{{Key: OriginPropertyKind, Value: OriginPropertyGateway}},
+	})
+	inventoryService.IngressPolicyStore.Add(&containerinventory.ContainerIngressPolicy{
+		DisplayName:        "route",
+		ExternalId:         "route-uid",
+		ResourceType:       string(ContainerIngressPolicy),
+		ContainerProjectId: "namespace-uid",
+		OriginProperties:   []common.KeyValuePair{{Key: OriginPropertyKind, Value: OriginPropertyHTTPRoute}},
+	})
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "IsIngressDeleted", func(_ *InventoryService, _, _, _ string, _ *networkingv1.Ingress) bool {
+		assert.Fail(t, "IsIngressDeleted should not be called for Gateway API objects")
+		return false
+	})
+	patches.ApplyMethod(reflect.TypeOf(inventoryService), "IsGatewayDeleted", func(_ *InventoryService, _, _, _ string, _ *gatewayv1.Gateway) bool {
+		return true
+	})
+	patches.ApplyMethod(reflect.TypeOf(inventoryService), "IsHTTPRouteDeleted", func(_ *InventoryService, _, _, _ string, _ *gatewayv1.HTTPRoute) bool {
+		return false
+	})
+	defer patches.Reset()
+
+	err := inventoryService.CleanStaleInventoryIngressPolicy()
+	assert.Nil(t, err)
+	assert.Contains(t, inventoryService.pendingDelete, "gateway-uid")
+	assert.NotContains(t, inventoryService.pendingDelete, "route-uid")
+}
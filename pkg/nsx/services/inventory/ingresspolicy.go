@@ -110,26 +110,41 @@ func (s *InventoryService) IsIngressDeleted(namespace, name, externalId string,
 
 func (s *InventoryService) CleanStaleInventoryIngressPolicy() error {
 	log.Trace("Clean stale InventoryIngressPolicy")
-	containerIngressPolicies := s.IngressPolicyStore.List()
-	for _, ingressPolicy := range containerIngressPolicies {
+	for _, ingress := range s.listStaleInventoryIngressPolicies() {
+		err := s.DeleteResource(ingress.ExternalId, ContainerIngressPolicy)
+		if err != nil {
+			log.Error(err, "Clean stale InventoryIngressPolicy", "External Id", ingress.ExternalId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryIngressPolicies() []*containerinventory.ContainerIngressPolicy {
+	var staleIngressPolicies []*containerinventory.ContainerIngressPolicy
+	for _, ingressPolicy := range s.IngressPolicyStore.List() {
 		ingress := ingressPolicy.(*containerinventory.ContainerIngressPolicy)
 		project := s.ProjectStore.GetByKey(ingress.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale InventoryIngressPolicy", "Project Id", ingress.ContainerProjectId,
 				"Ingress name", ingress.DisplayName, "External Id", ingress.ExternalId)
-			err := s.DeleteResource(ingress.ExternalId, ContainerIngressPolicy)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryIngressPolicy", "External Id", ingress.ExternalId)
-				return err
-			}
-		} else if s.IsIngressDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress.DisplayName, ingress.ExternalId, nil) {
+			staleIngressPolicies = append(staleIngressPolicies, ingress)
+		} else if s.isIngressPolicyObjectDeleted(project.(*containerinventory.ContainerProject).DisplayName, ingress) {
 			log.Info("Clean stale InventoryIngressPolicy", "Name", ingress.DisplayName, "External Id", ingress.ExternalId)
-			err := s.DeleteResource(ingress.ExternalId, ContainerIngressPolicy)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryIngressPolicy", "External Id", ingress.ExternalId)
-				return err
-			}
+			staleIngressPolicies = append(staleIngressPolicies, ingress)
 		}
 	}
-	return nil
+	return staleIngressPolicies
+}
+
+// isIngressPolicyObjectDeleted checks if the Ingress, Gateway or HTTPRoute of the ContainerIngressPolicy is deleted.
+func (s *InventoryService) isIngressPolicyObjectDeleted(namespace string, ingressPolicy *containerinventory.ContainerIngressPolicy) bool {
+	switch getIngressPolicyKind(ingressPolicy) {
+	case OriginPropertyGateway:
+		return s.IsGatewayDeleted(namespace, ingressPolicy.DisplayName, ingressPolicy.ExternalId, nil)
+	case OriginPropertyHTTPRoute:
+		return s.IsHTTPRouteDeleted(namespace, ingressPolicy.DisplayName, ingressPolicy.ExternalId, nil)
+	default:
+		return s.IsIngressDeleted(namespace, ingressPolicy.DisplayName, ingressPolicy.ExternalId, nil)
+	}
 }
@@ -36,6 +36,9 @@ type InventoryService struct {
 	pendingDelete map[string]interface{}
 
 	stalePods map[string]interface{}
+	// dryRun skips updating the service IDs of the Pods when building Services for drift detection, the
+	// other built objects are only buffered in requestBuffer and pendingAdd, which DetectDrift swaps out.
+	dryRun bool
 }
 
 func InitializeService(service commonservice.Service, cleanup bool) (*InventoryService, error) {
@@ -181,6 +184,11 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 			if retryKey != nil {
 				retryKeys.Insert(*retryKey)
 			}
+		case VirtualMachine:
+			retryKey := s.SyncVirtualMachine(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
 		case ContainerProject:
 			retryKey := s.SyncContainerProject(name, key)
 			if retryKey != nil {
@@ -196,6 +204,16 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 			if retryKey != nil {
 				retryKeys.Insert(*retryKey)
 			}
+		case Gateway:
+			retryKey := s.SyncGateway(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
+		case HTTPRoute:
+			retryKey := s.SyncHTTPRoute(name, namespace, key)
+			if retryKey != nil {
+				retryKeys.Insert(*retryKey)
+			}
 		case ContainerClusterNode:
 			retryKey := s.SyncContainerClusterNode(name, key)
 			if retryKey != nil {
@@ -217,6 +235,38 @@ func (s *InventoryService) SyncInventoryObject(bufferedKeys sets.Set[InventoryKe
 	return retryKeys, err
 }
 
+// ListStaleInventoryObjects returns the inventory objects the stale inventory object cleanup would delete, each one
+// is formatted as "<resource type>/<external id>".
+func (s *InventoryService) ListStaleInventoryObjects() []string {
+	var objects []string
+	for _, applicationInstance := range s.listStaleInventoryApplicationInstances() {
+		objects = append(objects, inventoryObjectKey(ContainerApplicationInstance, applicationInstance.ExternalId))
+	}
+	for externalId := range s.stalePods {
+		objects = append(objects, inventoryObjectKey(ContainerApplicationInstance, externalId))
+	}
+	for _, project := range s.listStaleInventoryContainerProjects() {
+		objects = append(objects, inventoryObjectKey(ContainerProject, project.ExternalId))
+	}
+	for _, application := range s.listStaleInventoryApplications() {
+		objects = append(objects, inventoryObjectKey(ContainerApplication, application.ExternalId))
+	}
+	for _, node := range s.listStaleInventoryClusterNodes() {
+		objects = append(objects, inventoryObjectKey(ContainerClusterNode, node.ExternalId))
+	}
+	for _, ingressPolicy := range s.listStaleInventoryIngressPolicies() {
+		objects = append(objects, inventoryObjectKey(ContainerIngressPolicy, ingressPolicy.ExternalId))
+	}
+	for _, networkPolicy := range s.listStaleInventoryNetworkPolicies() {
+		objects = append(objects, inventoryObjectKey(ContainerNetworkPolicy, networkPolicy.ExternalId))
+	}
+	return objects
+}
+
+func inventoryObjectKey(resourceType InventoryType, externalId string) string {
+	return string(resourceType) + "/" + externalId
+}
+
 func (s *InventoryService) DeleteResource(externalId string, resourceType InventoryType) error {
 	log.Info("Delete inventory resource", "resource_type", resourceType, "external_id", externalId)
 	switch resourceType {
@@ -71,19 +71,26 @@ func (s *InventoryService) initContainerProject(clusterId string) error {
 
 func (s *InventoryService) CleanStaleInventoryContainerProject() error {
 	log.Trace("Clean stale InventoryContainerProject")
-	containerProjects := s.ProjectStore.List()
-	for _, containerProject := range containerProjects {
+	for _, project := range s.listStaleInventoryContainerProjects() {
+		err := s.DeleteResource(project.ExternalId, ContainerProject)
+		if err != nil {
+			log.Error(err, "Failed to delete stale container project", "Name", project.DisplayName, "ExternalId", project.ExternalId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryContainerProjects() []*containerinventory.ContainerProject {
+	var staleProjects []*containerinventory.ContainerProject
+	for _, containerProject := range s.ProjectStore.List() {
 		project := containerProject.(*containerinventory.ContainerProject)
 		// Check if the namespace still exists in the K8s cluster
 		if s.IsNamespaceDeleted(project.DisplayName, project.ExternalId) {
-			// Namespace doesn't exist or its ID changed - delete the stale container project
+			// Namespace doesn't exist or its ID changed - the container project is stale
 			log.Info("Found stale container project", "Name", project.DisplayName, "ExternalId", project.ExternalId)
-			err := s.DeleteResource(project.ExternalId, ContainerProject)
-			if err != nil {
-				log.Error(err, "Failed to delete stale container project", "Name", project.DisplayName, "ExternalId", project.ExternalId)
-				return err
-			}
+			staleProjects = append(staleProjects, project)
 		}
 	}
-	return nil
+	return staleProjects
 }
@@ -72,26 +72,29 @@ func (s *InventoryService) SyncContainerNetworkPolicy(name, namespace string, ke
 
 func (s *InventoryService) CleanStaleInventoryNetworkPolicy() error {
 	log.Trace("Clean stale InventoryNetworkPolicy")
-	containerNetworkPolicies := s.NetworkPolicyStore.List()
-	for _, networkPolicy := range containerNetworkPolicies {
+	for _, networkPolicyObj := range s.listStaleInventoryNetworkPolicies() {
+		err := s.DeleteResource(networkPolicyObj.ExternalId, ContainerNetworkPolicy)
+		if err != nil {
+			log.Error(err, "Clean stale InventoryNetworkPolicy", "External Id", networkPolicyObj.ExternalId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryNetworkPolicies() []*containerinventory.ContainerNetworkPolicy {
+	var staleNetworkPolicies []*containerinventory.ContainerNetworkPolicy
+	for _, networkPolicy := range s.NetworkPolicyStore.List() {
 		networkPolicyObj := networkPolicy.(*containerinventory.ContainerNetworkPolicy)
 		project := s.ProjectStore.GetByKey(networkPolicyObj.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale InventoryNetworkPolicy", "Project Id", networkPolicyObj.ContainerProjectId,
 				"NetworkPolicy name", networkPolicyObj.DisplayName, "External Id", networkPolicyObj.ExternalId)
-			err := s.DeleteResource(networkPolicyObj.ExternalId, ContainerNetworkPolicy)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryNetworkPolicy", "External Id", networkPolicyObj.ExternalId)
-				return err
-			}
+			staleNetworkPolicies = append(staleNetworkPolicies, networkPolicyObj)
 		} else if s.IsNetworkPolicyDeleted(project.(*containerinventory.ContainerProject).DisplayName, networkPolicyObj.DisplayName, networkPolicyObj.ExternalId) {
 			log.Info("Clean stale InventoryNetworkPolicy", "Name", networkPolicyObj.DisplayName, "External Id", networkPolicyObj.ExternalId)
-			err := s.DeleteResource(networkPolicyObj.ExternalId, ContainerNetworkPolicy)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryNetworkPolicy", "External Id", networkPolicyObj.ExternalId)
-				return err
-			}
+			staleNetworkPolicies = append(staleNetworkPolicies, networkPolicyObj)
 		}
 	}
-	return nil
+	return staleNetworkPolicies
 }
@@ -67,17 +67,24 @@ func (s *InventoryService) initContainerClusterNode(clusterId string) error {
 
 func (s *InventoryService) CleanStaleInventoryClusterNode() error {
 	log.Trace("Clean stale InventoryClusterNode")
-	inventoryNodes := s.ClusterNodeStore.List()
-	for _, inventoryNode := range inventoryNodes {
+	for _, inventoryNode := range s.listStaleInventoryClusterNodes() {
+		log.Info("Cleaning stale InventoryClusterNode", "Name", inventoryNode.DisplayName, "External Id", inventoryNode.ExternalId)
+		err := s.DeleteResource(inventoryNode.ExternalId, ContainerClusterNode)
+		if err != nil {
+			log.Error(err, "Clean stale InventoryClusterNode", "External Id", inventoryNode.ExternalId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryClusterNodes() []*containerinventory.ContainerClusterNode {
+	var staleNodes []*containerinventory.ContainerClusterNode
+	for _, inventoryNode := range s.ClusterNodeStore.List() {
 		inventoryNode := inventoryNode.(*containerinventory.ContainerClusterNode)
 		if s.isClusterNodeDeleted(inventoryNode.DisplayName, inventoryNode.ExternalId) {
-			log.Info("Cleaning stale InventoryClusterNode", "Name", inventoryNode.DisplayName, "External Id", inventoryNode.ExternalId)
-			err := s.DeleteResource(inventoryNode.ExternalId, ContainerClusterNode)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryClusterNode", "External Id", inventoryNode.ExternalId)
-				return err
-			}
+			staleNodes = append(staleNodes, inventoryNode)
 		}
 	}
-	return nil
+	return staleNodes
 }
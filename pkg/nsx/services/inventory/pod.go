@@ -106,30 +106,38 @@ func (s *InventoryService) DeleteStalePods() error {
 
 func (s *InventoryService) CleanStaleInventoryApplicationInstance() error {
 	log.Info("Clean stale InventoryApplicationInstance")
-	containerApplicationInstances := s.ApplicationInstanceStore.List()
-	for _, applicationInstance := range containerApplicationInstances {
+	for _, applicationInstance := range s.listStaleInventoryApplicationInstances() {
+		err := s.DeleteResource(applicationInstance.ExternalId, ContainerApplicationInstance)
+		if err != nil {
+			log.Error(err, "Clean stale InventoryApplicationInstance", "External Id", applicationInstance.ExternalId)
+			return err
+		}
+	}
+	err := s.DeleteStalePods()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryApplicationInstances() []*containerinventory.ContainerApplicationInstance {
+	var staleInstances []*containerinventory.ContainerApplicationInstance
+	for _, applicationInstance := range s.ApplicationInstanceStore.List() {
 		applicationInstance := applicationInstance.(*containerinventory.ContainerApplicationInstance)
 		project := s.ProjectStore.GetByKey(applicationInstance.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale ContainerApplicationInstance", "Project Id", applicationInstance.ContainerProjectId,
 				"Pod name", applicationInstance.DisplayName, "External Id", applicationInstance.ExternalId)
-			err := s.DeleteResource(applicationInstance.ExternalId, ContainerApplicationInstance)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryApplicationInstance", "External Id", applicationInstance.ExternalId)
-				return err
+			staleInstances = append(staleInstances, applicationInstance)
+		} else if isVirtualMachineInstance(applicationInstance) {
+			if s.IsVirtualMachineDeleted(project.(*containerinventory.ContainerProject).DisplayName, applicationInstance.DisplayName, applicationInstance.ExternalId) {
+				log.Info("Clean stale VirtualMachine", "Name", applicationInstance.DisplayName, "External Id", applicationInstance.ExternalId)
+				staleInstances = append(staleInstances, applicationInstance)
 			}
 		} else if s.IsPodDeleted(project.(*containerinventory.ContainerProject).DisplayName, applicationInstance.DisplayName, applicationInstance.ExternalId) {
 			log.Info("Clean stale pod", "Name", applicationInstance.DisplayName, "External Id", applicationInstance.ExternalId)
-			err := s.DeleteResource(applicationInstance.ExternalId, ContainerApplicationInstance)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryApplicationInstance", "External Id", applicationInstance.ExternalId)
-				return err
-			}
+			staleInstances = append(staleInstances, applicationInstance)
 		}
 	}
-	err := s.DeleteStalePods()
-	if err != nil {
-		return err
-	}
-	return nil
+	return staleInstances
 }
@@ -68,28 +68,30 @@ func (s *InventoryService) SyncContainerApplication(name, namespace string, key
 
 func (s *InventoryService) CleanStaleInventoryApplication() error {
 	log.Trace("Clean stale InventoryApplication")
-	inventoryApplications := s.ApplicationStore.List()
-	for _, inventoryApplication := range inventoryApplications {
+	for _, inventoryApplication := range s.listStaleInventoryApplications() {
+		s.removeDeletedServiceIDFromApplicationInstances(inventoryApplication.ExternalId)
+		err := s.DeleteResource(inventoryApplication.ExternalId, ContainerApplication)
+		if err != nil {
+			log.Error(err, "Clean stale InventoryApplication", "External Id", inventoryApplication.ExternalId)
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *InventoryService) listStaleInventoryApplications() []*containerinventory.ContainerApplication {
+	var staleApplications []*containerinventory.ContainerApplication
+	for _, inventoryApplication := range s.ApplicationStore.List() {
 		inventoryApplication := inventoryApplication.(*containerinventory.ContainerApplication)
 		project := s.ProjectStore.GetByKey(inventoryApplication.ContainerProjectId)
 		if project == nil {
 			log.Info("Cannot find ContainerProject by id, so clean up stale ContainerApplication", "Project Id",
 				inventoryApplication.ContainerProjectId, "Application name", inventoryApplication.DisplayName, "External Id", inventoryApplication.ExternalId)
-			s.removeDeletedServiceIDFromApplicationInstances(inventoryApplication.ExternalId)
-			err := s.DeleteResource(inventoryApplication.ExternalId, ContainerApplication)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryApplication", "External Id", inventoryApplication.ExternalId)
-				return err
-			}
+			staleApplications = append(staleApplications, inventoryApplication)
 		} else if s.isApplicationDeleted(project.(*containerinventory.ContainerProject).DisplayName, inventoryApplication.DisplayName, inventoryApplication.ExternalId) {
 			log.Info("Clean stale inventoryApplication", "Name", inventoryApplication.DisplayName, "External Id", inventoryApplication.ExternalId)
-			s.removeDeletedServiceIDFromApplicationInstances(inventoryApplication.ExternalId)
-			err := s.DeleteResource(inventoryApplication.ExternalId, ContainerApplication)
-			if err != nil {
-				log.Error(err, "Clean stale InventoryApplication", "External Id", inventoryApplication.ExternalId)
-				return err
-			}
+			staleApplications = append(staleApplications, inventoryApplication)
 		}
 	}
-	return nil
+	return staleApplications
 }
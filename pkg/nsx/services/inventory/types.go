@@ -22,6 +22,13 @@ const (
 	// typically mapping to Kubernetes network policies.
 	ContainerNetworkPolicy InventoryType = "ContainerNetworkPolicy"
 	ContainerIngressPolicy InventoryType = "ContainerIngressPolicy"
+	// VirtualMachine is the key type for VM Service VirtualMachines. They are reported
+	// to NSX as ContainerApplicationInstance and stored in ApplicationInstanceStore.
+	VirtualMachine InventoryType = "VirtualMachine"
+	// Gateway and HTTPRoute are the key types for Gateway API objects. They are reported
+	// to NSX as ContainerIngressPolicy and stored in IngressPolicyStore.
+	Gateway   InventoryType = "Gateway"
+	HTTPRoute InventoryType = "HTTPRoute"
 
 	InventoryClusterTypeSupervisor = "SupervisorCluster"
 	InventoryClusterCNIType        = "NCP"
@@ -48,6 +55,14 @@ const (
 	InventoryStatusDown    = "DOWN"
 	InventoryStatusUnknown = "UNKNOWN"
 
+	// OriginPropertyKind distinguishes VirtualMachine instances from Pod instances
+	// in ContainerApplicationInstance origin properties.
+	OriginPropertyKind           = "kind"
+	OriginPropertyIP             = "ip"
+	OriginPropertyVirtualMachine = "VirtualMachine"
+	OriginPropertyGateway        = "Gateway"
+	OriginPropertyHTTPRoute      = "HTTPRoute"
+
 	NcpLbError        = "ncp/error.loadbalancer"
 	NcpLbPortError    = "ncp/error.loadbalancer.unrealized_ports"
 	NcpLbEpError      = "ncp/error.loadbalancer_endpoints"
@@ -0,0 +1,128 @@
+package inventory
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	ctrcommon "github.com/vmware-tanzu/nsx-operator/pkg/controllers/common"
+)
+
+func (s *InventoryService) IsVirtualMachineDeleted(namespace, name, externalId string) bool {
+	vm := &vmv1alpha1.VirtualMachine{}
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, vm)
+	if apierrors.IsNotFound(err) ||
+		((err == nil) && (string(vm.UID) != externalId)) {
+		return true
+	} else {
+		return false
+	}
+}
+
+func (s *InventoryService) SyncVirtualMachine(name string, namespace string, key InventoryKey) *InventoryKey {
+	vm := &vmv1alpha1.VirtualMachine{}
+	err := s.Client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, vm)
+	externalId := key.ExternalId
+	if s.IsVirtualMachineDeleted(namespace, name, externalId) {
+		err = s.DeleteResource(externalId, ContainerApplicationInstance)
+		if err != nil {
+			log.Error(err, "Delete VirtualMachine ContainerApplicationInstance Resource error", "key", key)
+			return &key
+		}
+	} else if err == nil {
+		retry := s.BuildVirtualMachine(vm)
+		if retry {
+			return &key
+		}
+	} else {
+		log.Error(err, "Unexpected error is found while processing VirtualMachine")
+	}
+	return nil
+}
+
+// getVirtualMachineSubnetPorts returns the SubnetPorts attached to the VirtualMachine
+// through the attachment_ref annotation.
+func (s *InventoryService) getVirtualMachineSubnetPorts(vm *vmv1alpha1.VirtualMachine) ([]v1alpha1.SubnetPort, error) {
+	subnetPortList := &v1alpha1.SubnetPortList{}
+	if err := s.Client.List(context.TODO(), subnetPortList, client.InNamespace(vm.Namespace)); err != nil {
+		return nil, err
+	}
+	var subnetPorts []v1alpha1.SubnetPort
+	for _, subnetPort := range subnetPortList.Items {
+		vmName, _, err := ctrcommon.GetVirtualMachineNameForSubnetPort(&subnetPort)
+		if err != nil || vmName != vm.Name {
+			continue
+		}
+		subnetPorts = append(subnetPorts, subnetPort)
+	}
+	return subnetPorts, nil
+}
+
+// getVirtualMachineIPs collects the realized IPs of the VirtualMachine SubnetPorts,
+// and falls back to the VM status IP if no SubnetPort IP is realized yet.
+func getVirtualMachineIPs(vm *vmv1alpha1.VirtualMachine, subnetPorts []v1alpha1.SubnetPort) []string {
+	var ips []string
+	for _, subnetPort := range subnetPorts {
+		for _, ipAddress := range subnetPort.Status.NetworkInterfaceConfig.IPAddresses {
+			ip := ipAddress.IPAddress
+			if ipAddr, _, err := net.ParseCIDR(ip); err == nil {
+				ip = ipAddr.String()
+			}
+			if ip != "" {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	if len(ips) == 0 && vm.Status.VmIp != "" {
+		ips = append(ips, vm.Status.VmIp)
+	}
+	sort.Strings(ips)
+	return ips
+}
+
+// isVirtualMachineInstance checks if the ContainerApplicationInstance is built from a VirtualMachine.
+func isVirtualMachineInstance(instance *containerinventory.ContainerApplicationInstance) bool {
+	for _, prop := range instance.OriginProperties {
+		if prop.Key == OriginPropertyKind && prop.Value == OriginPropertyVirtualMachine {
+			return true
+		}
+	}
+	return false
+}
+
+func getVirtualMachineStatus(vm *vmv1alpha1.VirtualMachine) string {
+	switch vm.Status.PowerState {
+	case vmv1alpha1.VirtualMachinePoweredOn:
+		return InventoryStatusUp
+	case vmv1alpha1.VirtualMachinePoweredOff, vmv1alpha1.VirtualMachineSuspended:
+		return InventoryStatusDown
+	}
+	return InventoryStatusUnknown
+}
+
+func getVirtualMachineIPsValue(ips []string) string {
+	return strings.Join(ips, ",")
+}
+
+// getVirtualMachineNode gets the Node where the VirtualMachine is running, a nil Node
+// is returned if the VM is not placed yet or the host is not a Node in the cluster.
+func (s *InventoryService) getVirtualMachineNode(vm *vmv1alpha1.VirtualMachine) *corev1.Node {
+	if vm.Status.Host == "" {
+		return nil
+	}
+	node := &corev1.Node{}
+	if err := s.Client.Get(context.TODO(), types.NamespacedName{Name: vm.Status.Host}, node); err != nil {
+		log.Debug("Cannot find Node for VirtualMachine", "VirtualMachine", vm.Name, "Namespace", vm.Namespace, "Host", vm.Status.Host, "error", err)
+		return nil
+	}
+	return node
+}
@@ -0,0 +1,257 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	vmv1alpha1 "github.com/vmware-tanzu/vm-operator/api/v1alpha1"
+	"github.com/vmware/go-vmware-nsxt/common"
+	"github.com/vmware/go-vmware-nsxt/containerinventory"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	servicecommon "github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+func newVirtualMachineSubnetPort(name, vmName, ip string, ready bool) v1alpha1.SubnetPort {
+	subnetPort := v1alpha1.SubnetPort{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{servicecommon.AnnotationAttachmentRef: "virtualmachine/" + vmName + "/" + name},
+		},
+	}
+	if ip != "" {
+		subnetPort.Status.NetworkInterfaceConfig.IPAddresses = []v1alpha1.NetworkInterfaceIPAddress{{IPAddress: ip}}
+	}
+	if ready {
+		subnetPort.Status.Conditions = []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: corev1.ConditionTrue, Message: "SubnetPort has been successfully created/updated"}}
+	} else {
+		subnetPort.Status.Conditions = []v1alpha1.Condition{{Type: v1alpha1.Ready, Status: corev1.ConditionFalse, Message: "failed to create SubnetPort"}}
+	}
+	return subnetPort
+}
+
+func TestBuildVirtualMachine(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+			UID:  "222222222",
+		},
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "esx-host",
+			UID:  "111111111",
+		},
+	}
+	testVM := &vmv1alpha1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-vm",
+			Namespace: "default",
+			UID:       "vm-uid-123",
+			Labels:    map[string]string{"app": "inventory"},
+		},
+		Status: vmv1alpha1.VirtualMachineStatus{
+			Host:       "esx-host",
+			PowerState: vmv1alpha1.VirtualMachinePoweredOn,
+			VmIp:       "10.0.0.100",
+		},
+	}
+	mockGet := func(obj client.Object) error {
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			namespace.DeepCopyInto(o)
+		case *corev1.Node:
+			node.DeepCopyInto(o)
+		}
+		return nil
+	}
+
+	t.Run("NormalFlow", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				return mockGet(obj)
+			}).Times(2)
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				subnetPortList := list.(*v1alpha1.SubnetPortList)
+				subnetPortList.Items = []v1alpha1.SubnetPort{
+					newVirtualMachineSubnetPort("eth1", "test-vm", "192.168.1.2/24", true),
+					newVirtualMachineSubnetPort("eth0", "test-vm", "192.168.1.1/24", true),
+					newVirtualMachineSubnetPort("eth0", "other-vm", "192.168.1.3/24", true),
+				}
+				return nil
+			})
+
+		applicationInstance := &containerinventory.ContainerApplicationInstance{}
+		applicationInstance.ContainerApplicationIds = []string{"app-id-123"}
+		inventoryService.pendingAdd[string(testVM.UID)] = applicationInstance
+		retry := inventoryService.BuildVirtualMachine(testVM)
+
+		assert.False(t, retry)
+		assert.Contains(t, inventoryService.pendingAdd, "vm-uid-123")
+		applicationInstance = inventoryService.pendingAdd["vm-uid-123"].(*containerinventory.ContainerApplicationInstance)
+		assert.Equal(t, []string{"app-id-123"}, applicationInstance.ContainerApplicationIds)
+		assert.Equal(t, string(ContainerApplicationInstance), applicationInstance.ResourceType)
+		assert.Equal(t, string(namespace.UID), applicationInstance.ContainerProjectId)
+		assert.Equal(t, string(node.UID), applicationInstance.ClusterNodeId)
+		assert.Equal(t, clusterUUID, applicationInstance.ContainerClusterId)
+		assert.Equal(t, InventoryStatusUp, applicationInstance.Status)
+		assert.Equal(t, NetworkStatusHealthy, applicationInstance.NetworkStatus)
+		assert.Empty(t, applicationInstance.NetworkErrors)
+		assert.Contains(t, applicationInstance.OriginProperties, common.KeyValuePair{Key: OriginPropertyKind, Value: OriginPropertyVirtualMachine})
+		assert.Contains(t, applicationInstance.OriginProperties, common.KeyValuePair{Key: OriginPropertyIP, Value: "192.168.1.1,192.168.1.2"})
+		assert.True(t, isVirtualMachineInstance(applicationInstance))
+	})
+
+	t.Run("SubnetPortNotReady", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				return mockGet(obj)
+			}).Times(2)
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				subnetPortList := list.(*v1alpha1.SubnetPortList)
+				subnetPortList.Items = []v1alpha1.SubnetPort{
+					newVirtualMachineSubnetPort("eth0", "test-vm", "", false),
+				}
+				return nil
+			})
+
+		vm := testVM.DeepCopy()
+		vm.Status.PowerState = vmv1alpha1.VirtualMachinePoweredOff
+		retry := inventoryService.BuildVirtualMachine(vm)
+
+		assert.False(t, retry)
+		applicationInstance := inventoryService.pendingAdd["vm-uid-123"].(*containerinventory.ContainerApplicationInstance)
+		assert.Equal(t, InventoryStatusDown, applicationInstance.Status)
+		assert.Equal(t, NetworkStatusUnhealthy, applicationInstance.NetworkStatus)
+		assert.Equal(t, []common.NetworkError{{ErrorMessage: "failed to create SubnetPort"}}, applicationInstance.NetworkErrors)
+		// Fall back to the VM status IP when no SubnetPort IP is realized.
+		assert.Contains(t, applicationInstance.OriginProperties, common.KeyValuePair{Key: OriginPropertyIP, Value: "10.0.0.100"})
+	})
+
+	t.Run("NamespaceNotFound", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("not found"))
+		retry := inventoryService.BuildVirtualMachine(testVM)
+		assert.True(t, retry)
+	})
+
+	t.Run("ListSubnetPortsFailure", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("list failed"))
+		retry := inventoryService.BuildVirtualMachine(testVM)
+		assert.True(t, retry)
+		assert.NotContains(t, inventoryService.pendingAdd, "vm-uid-123")
+	})
+
+	t.Run("NodeNotFound", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				return mockGet(obj)
+			})
+		k8sClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("node not found"))
+		retry := inventoryService.BuildVirtualMachine(testVM)
+		assert.False(t, retry)
+		applicationInstance := inventoryService.pendingAdd["vm-uid-123"].(*containerinventory.ContainerApplicationInstance)
+		assert.Equal(t, "", applicationInstance.ClusterNodeId)
+	})
+}
+
+func TestGetVirtualMachineStatus(t *testing.T) {
+	tests := []struct {
+		powerState vmv1alpha1.VirtualMachinePowerState
+		expected   string
+	}{
+		{vmv1alpha1.VirtualMachinePoweredOn, InventoryStatusUp},
+		{vmv1alpha1.VirtualMachinePoweredOff, InventoryStatusDown},
+		{vmv1alpha1.VirtualMachineSuspended, InventoryStatusDown},
+		{"", InventoryStatusUnknown},
+	}
+	for _, tt := range tests {
+		vm := &vmv1alpha1.VirtualMachine{Status: vmv1alpha1.VirtualMachineStatus{PowerState: tt.powerState}}
+		assert.Equal(t, tt.expected, getVirtualMachineStatus(vm))
+	}
+}
+
+func TestSyncVirtualMachine(t *testing.T) {
+	key := InventoryKey{InventoryType: VirtualMachine, ExternalId: "vm-uid", Key: "default/test-vm"}
+
+	t.Run("VirtualMachineDeleted", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(apierrors.NewNotFound(schema.GroupResource{Resource: "virtualmachines"}, "test-vm")).Times(2)
+		inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+			DisplayName:        "test-vm",
+			ExternalId:         "vm-uid",
+			ResourceType:       string(ContainerApplicationInstance),
+			ContainerProjectId: "project-uid",
+		})
+		retryKey := inventoryService.SyncVirtualMachine("test-vm", "default", key)
+		assert.Nil(t, retryKey)
+		assert.Contains(t, inventoryService.pendingDelete, "vm-uid")
+	})
+
+	t.Run("BuildRetry", func(t *testing.T) {
+		inventoryService, k8sClient := createService(t)
+		k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+				obj.(*vmv1alpha1.VirtualMachine).UID = "vm-uid"
+				return nil
+			}).Times(2)
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "BuildVirtualMachine", func(_ *InventoryService, _ *vmv1alpha1.VirtualMachine) bool {
+			return true
+		})
+		defer patches.Reset()
+		retryKey := inventoryService.SyncVirtualMachine("test-vm", "default", key)
+		assert.Equal(t, &key, retryKey)
+	})
+}
+
+func TestCleanStaleInventoryVirtualMachine(t *testing.T) {
+	inventoryService, _ := createService(t)
+	inventoryService.ProjectStore.Add(&containerinventory.ContainerProject{
+		DisplayName:  "default",
+		ExternalId:   "project-uid",
+		ResourceType: string(ContainerProject),
+	})
+	inventoryService.ApplicationInstanceStore.Add(&containerinventory.ContainerApplicationInstance{
+		DisplayName:        "test-vm",
+		ExternalId:         "vm-uid",
+		ResourceType:       string(ContainerApplicationInstance),
+		ContainerProjectId: "project-uid",
+		OriginProperties:   []common.KeyValuePair{{Key: OriginPropertyKind, Value: OriginPropertyVirtualMachine}},
+	})
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(inventoryService), "IsPodDeleted", func(_ *InventoryService, _ string, _ string, _ string) bool {
+		assert.Fail(t, "IsPodDeleted should not be called for VirtualMachine")
+		return true
+	})
+	patches.ApplyMethod(reflect.TypeOf(inventoryService), "IsVirtualMachineDeleted", func(_ *InventoryService, namespace string, name string, _ string) bool {
+		assert.Equal(t, "default", namespace)
+		assert.Equal(t, "test-vm", name)
+		return true
+	})
+	defer patches.Reset()
+
+	err := inventoryService.CleanStaleInventoryApplicationInstance()
+	assert.Nil(t, err)
+	assert.Contains(t, inventoryService.pendingDelete, "vm-uid")
+}
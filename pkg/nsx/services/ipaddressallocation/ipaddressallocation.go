@@ -26,6 +26,8 @@ type IPAddressAllocationService struct {
 	ipAddressAllocationStore *IPAddressAllocationStore
 	VPCService               common.VPCServiceProvider
 	builder                  *common.PolicyTreeBuilder[*model.VpcIpAddressAllocation]
+	// batchWriter coalesces the writes of the VpcIpAddressAllocations if it is enabled.
+	batchWriter *common.PolicyTreeBatchWriter[*model.VpcIpAddressAllocation]
 }
 
 func InitializeIPAddressAllocation(service common.Service, vpcService common.VPCServiceProvider, includeNCP bool) (*IPAddressAllocationService,
@@ -36,7 +38,8 @@ func InitializeIPAddressAllocation(service common.Service, vpcService common.VPC
 	wgDone := make(chan bool)
 	fatalErrors := make(chan error)
 
-	ipAddressAllocationService := &IPAddressAllocationService{Service: service, VPCService: vpcService, builder: builder}
+	ipAddressAllocationService := &IPAddressAllocationService{Service: service, VPCService: vpcService, builder: builder,
+		batchWriter: common.NewBatchWriterByConfig(builder, service)}
 	ipAddressAllocationService.ipAddressAllocationStore = buildIPAddressAllocationStore()
 
 	if includeNCP {
@@ -187,7 +190,13 @@ func (service *IPAddressAllocationService) DeleteIPAddressAllocationByNSXResourc
 	if err != nil {
 		return err
 	}
-	err = service.NSXClient.IPAddressAllocationClient.Delete(vpcResourceInfo.OrgID, vpcResourceInfo.ProjectID, vpcResourceInfo.VPCID, *nsxIPAddressAllocation.Id)
+	if service.batchWriter != nil {
+		deletedIPAddressAllocation := *nsxIPAddressAllocation
+		deletedIPAddressAllocation.MarkedForDelete = &MarkedForDelete
+		err = service.batchWriter.Write(&deletedIPAddressAllocation)
+	} else {
+		err = service.NSXClient.IPAddressAllocationClient.Delete(vpcResourceInfo.OrgID, vpcResourceInfo.ProjectID, vpcResourceInfo.VPCID, *nsxIPAddressAllocation.Id)
+	}
 	if err != nil {
 		return err
 	}
@@ -204,8 +213,15 @@ func (service *IPAddressAllocationService) Apply(nsxIPAddressAllocation *model.V
 		log.Error(err, "Failed to list VPCInfo for IPAddressAllocation")
 		return err
 	}
-	errPatch := service.NSXClient.IPAddressAllocationClient.Patch(VPCInfo[0].OrgID, VPCInfo[0].ProjectID, VPCInfo[0].ID, *nsxIPAddressAllocation.Id, *nsxIPAddressAllocation)
-	errPatch = nsxutil.TransNSXApiError(errPatch)
+	var errPatch error
+	if service.batchWriter != nil {
+		batchedIPAddressAllocation := *nsxIPAddressAllocation
+		batchedIPAddressAllocation.Path = String(service.builder.LeafPath(fmt.Sprintf(common.VPCKey, VPCInfo[0].OrgID, VPCInfo[0].ProjectID, VPCInfo[0].ID), nsxIPAddressAllocation))
+		errPatch = service.batchWriter.Write(&batchedIPAddressAllocation)
+	} else {
+		errPatch = service.NSXClient.IPAddressAllocationClient.Patch(VPCInfo[0].OrgID, VPCInfo[0].ProjectID, VPCInfo[0].ID, *nsxIPAddressAllocation.Id, *nsxIPAddressAllocation)
+		errPatch = nsxutil.TransNSXApiError(errPatch)
+	}
 	if errPatch != nil {
 		// not return err, try to get it from nsx, in case if cidr not realized at the first time
 		// so it can be patched in the next time and reacquire cidr
@@ -54,13 +54,19 @@ func NewIPBlocksInfoSyncTask(syncInterval time.Duration, retryInterval time.Dura
 }
 
 func InitializeIPBlocksInfoService(service common.Service, subnetService common.SubnetServiceProvider) *IPBlocksInfoService {
-	ipBlocksInfoService := &IPBlocksInfoService{
+	ipBlocksInfoService := NewIPBlocksInfoService(service, subnetService)
+	go ipBlocksInfoService.StartPeriodicSync()
+	return ipBlocksInfoService
+}
+
+// NewIPBlocksInfoService creates the IPBlocksInfoService without starting the periodic sync, which updates the
+// IPBlocksInfo CR.
+func NewIPBlocksInfoService(service common.Service, subnetService common.SubnetServiceProvider) *IPBlocksInfoService {
+	return &IPBlocksInfoService{
 		Service:       service,
 		SyncTask:      NewIPBlocksInfoSyncTask(syncInterval, retryInterval),
 		subnetService: subnetService,
 	}
-	go ipBlocksInfoService.StartPeriodicSync()
-	return ipBlocksInfoService
 }
 
 func (s *IPBlocksInfoService) StartPeriodicSync() {
@@ -15,6 +15,8 @@ var (
 	tagScopeNamespace               = common.TagScopeNamespace
 	tagScopeNSXServiceAccountCRName = common.TagScopeNSXServiceAccountCRName
 	tagScopeNSXServiceAccountCRUID  = common.TagScopeNSXServiceAccountCRUID
+	// tagScopeNSXServiceAccountCredMode is only tagged on the CCP in Token credential mode
+	tagScopeNSXServiceAccountCredMode = common.TagScopeNSXServiceAccountCredMode
 )
 
 func (s *NSXServiceAccountService) buildBasicTags(obj *v1alpha1.NSXServiceAccount) []model.Tag {
@@ -33,3 +35,15 @@ func (s *NSXServiceAccountService) buildBasicTags(obj *v1alpha1.NSXServiceAccoun
 		Tag:   &uid,
 	}}
 }
+
+func (s *NSXServiceAccountService) buildCCPTags(obj *v1alpha1.NSXServiceAccount) []model.Tag {
+	tags := s.buildBasicTags(obj)
+	if IsTokenCredentialMode(obj) {
+		credentialMode := string(v1alpha1.NSXServiceAccountCredentialModeToken)
+		tags = append(tags, model.Tag{
+			Scope: &tagScopeNSXServiceAccountCredMode,
+			Tag:   &credentialMode,
+		})
+	}
+	return tags
+}
@@ -54,7 +54,9 @@ var (
 	readerRole      = "cluster_info_reader"
 
 	antreaClusterResourceType = "AntreaClusterControlPlane"
-	revision1                 = int64(1)
+	// principalIdentityPathPrefix is the MP API path of the PIs, the PIs have no policy path.
+	principalIdentityPathPrefix = "/api/v1/trust-management/principal-identities/"
+	revision1                   = int64(1)
 
 	proxyLabels = map[string]string{"mgmt-proxy.antrea-nsx.vmware.com": ""}
 )
@@ -63,6 +65,7 @@ type NSXServiceAccountService struct {
 	common.Service
 	PrincipalIdentityStore   *PrincipalIdentityStore
 	ClusterControlPlaneStore *ClusterControlPlaneStore
+	tokenCache               tokenCredentialCache
 }
 
 // InitializeNSXServiceAccount sync NSX resources
@@ -117,6 +120,20 @@ func (s *NSXServiceAccountService) CreateOrUpdateNSXServiceAccount(ctx context.C
 		return err
 	}
 
+	// create role binding, CCP and token Secret in Token credential mode
+	if IsTokenCredentialMode(obj) {
+		clusterId, err := s.createRoleBindingAndCCP(normalizedClusterName, vpcPath, nil, obj)
+		if err != nil {
+			return err
+		}
+		if err := s.RefreshServiceAccountToken(ctx, obj); err != nil {
+			return err
+		}
+		s.setRealizedStatus(obj, clusterId, normalizedClusterName, vpcPath, proxyEndpoints)
+		obj.Status.Secrets = []v1alpha1.NSXSecret{{Name: obj.Name + TokenSecretSuffix, Namespace: obj.Namespace}}
+		return s.Client.Status().Update(ctx, obj)
+	}
+
 	// generate certificate
 	subject := util.DefaultSubject
 	subject.CommonName = normalizedClusterName
@@ -128,6 +145,10 @@ func (s *NSXServiceAccountService) CreateOrUpdateNSXServiceAccount(ctx context.C
 	if err != nil {
 		return err
 	}
+	certNotAfter, err := parseCertNotAfter([]byte(cert))
+	if err != nil {
+		return err
+	}
 
 	// create PI and CCP
 	clusterId, err := s.createPIAndCCP(normalizedClusterName, vpcPath, cert, nil, obj)
@@ -163,25 +184,36 @@ func (s *NSXServiceAccountService) CreateOrUpdateNSXServiceAccount(ctx context.C
 	}
 
 	// update NSXServiceAccountStatus
+	s.setRealizedStatus(obj, clusterId, normalizedClusterName, vpcPath, proxyEndpoints)
+	obj.Status.Secrets = []v1alpha1.NSXSecret{{
+		Name:      secretName,
+		Namespace: secretNamespace,
+	}}
+	setCertStatus(&obj.Status, certNotAfter, false)
+	return s.Client.Status().Update(ctx, obj)
+}
+
+func (s *NSXServiceAccountService) setRealizedStatus(obj *v1alpha1.NSXServiceAccount, clusterId, normalizedClusterName, vpcPath string, proxyEndpoints v1alpha1.NSXProxyEndpoint) {
 	obj.Status.Phase = v1alpha1.NSXServiceAccountPhaseRealized
 	obj.Status.Reason = "Success"
 	obj.Status.Conditions = GenerateNSXServiceAccountConditions(obj.Status.Conditions, obj.Generation, metav1.ConditionTrue, v1alpha1.ConditionReasonRealizationSuccess, "Success.")
 	obj.Status.NSXManagers = s.NSXConfig.NsxApiManagers
 	obj.Status.ClusterID = clusterId
 	obj.Status.ClusterName = normalizedClusterName
-	obj.Status.Secrets = []v1alpha1.NSXSecret{{
-		Name:      secretName,
-		Namespace: secretNamespace,
-	}}
 	obj.Status.VPCPath = vpcPath
 	obj.Status.ProxyEndpoints = proxyEndpoints
-	return s.Client.Status().Update(ctx, obj)
 }
 
 // RestoreRealizedNSXServiceAccount checks if PI/CCP is created on NSXT for a realized NSXServiceAccount. If both PI/CCP
 // is missing, restore PI/CCP from realized NSXServiceAccount and Secret.
+// In Token credential mode, the role binding and CCP are restored from realized NSXServiceAccount.
 func (s *NSXServiceAccountService) RestoreRealizedNSXServiceAccount(ctx context.Context, obj *v1alpha1.NSXServiceAccount) error {
 	normalizedClusterName := obj.Status.ClusterName
+	if IsTokenCredentialMode(obj) {
+		existingClusterId := obj.Status.ClusterID
+		_, err := s.createRoleBindingAndCCP(normalizedClusterName, obj.Status.VPCPath, &existingClusterId, obj)
+		return err
+	}
 
 	// check PI and CCP is missing
 	hasPI := len(s.PrincipalIdentityStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(obj.UID))) > 0
@@ -282,6 +314,11 @@ func (s *NSXServiceAccountService) createPIAndCCP(normalizedClusterName string,
 		}
 	}
 
+	return s.createCCP(normalizedClusterName, vpcPath, &cert, existingClusterId, obj)
+}
+
+// createCCP creates the ClusterControlPlane, cert is nil in Token credential mode.
+func (s *NSXServiceAccountService) createCCP(normalizedClusterName string, vpcPath string, cert *string, existingClusterId *string, obj *v1alpha1.NSXServiceAccount) (string, error) {
 	// create ClusterControlPlane
 	hasCCP := len(s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(obj.UID))) > 0
 	clusterId := ""
@@ -289,10 +326,10 @@ func (s *NSXServiceAccountService) createPIAndCCP(normalizedClusterName string,
 		ccp, err := s.NSXClient.ClusterControlPlanesClient.Update(siteId, enforcementpointId, normalizedClusterName, model.ClusterControlPlane{
 			Revision:     &revision1,
 			ResourceType: &antreaClusterResourceType,
-			Certificate:  &cert,
+			Certificate:  cert,
 			VhcPath:      &vpcPath,
 			NodeId:       existingClusterId,
-			Tags:         s.buildBasicTags(obj),
+			Tags:         s.buildCCPTags(obj),
 		})
 		err = nsxutil.TransNSXApiError(err)
 		if err != nil {
@@ -338,30 +375,47 @@ func (s *NSXServiceAccountService) getProxyEndpoints(ctx context.Context) (v1alp
 
 func (s *NSXServiceAccountService) DeleteNSXServiceAccount(ctx context.Context, namespacedName types.NamespacedName, uid types.UID) error {
 	isDeleteSecret := false
+	isFound := false
 	nsxsa := &v1alpha1.NSXServiceAccount{}
 	if err := s.Client.Get(ctx, namespacedName, nsxsa); err != nil {
 		isDeleteSecret = true
 	} else if uid == nsxsa.UID {
 		isDeleteSecret = true
+		isFound = true
 	}
 
 	clusterName := s.getClusterName(namespacedName.Namespace, namespacedName.Name)
 	normalizedClusterName := util.NormalizeId(clusterName)
 	// delete Secret
 	if isDeleteSecret {
-		secretName := namespacedName.Name + SecretSuffix
 		secretNamespace := namespacedName.Namespace
-		if err := s.Client.Delete(ctx, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: secretNamespace}}); err != nil && !errors.IsNotFound(err) {
-			log.Error(err, "failed to delete", "secret", secretName, "namespace", secretNamespace)
-			return err
+		for _, secretName := range []string{namespacedName.Name + SecretSuffix, namespacedName.Name + TokenSecretSuffix} {
+			if err := s.Client.Delete(ctx, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: secretNamespace}}); err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "failed to delete", "secret", secretName, "namespace", secretNamespace)
+				return err
+			}
 		}
 	}
 
 	isDeleteCCP := true
 	isDeletePI := true
+	ccps := s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(uid))
 	if !isDeleteSecret {
 		isDeletePI = len(s.PrincipalIdentityStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(uid))) > 0
-		isDeleteCCP = len(s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(uid))) > 0
+		isDeleteCCP = len(ccps) > 0
+	}
+	// delete role binding in Token credential mode. The credential mode is unknown if neither the NSXServiceAccount
+	// nor its CCP is found, as the role binding is created before the CCP.
+	isTokenMode := true
+	if isFound {
+		isTokenMode = IsTokenCredentialMode(nsxsa)
+	} else if len(ccps) > 0 {
+		isTokenMode = s.isTokenCredentialCCP(*ccps[0].(*model.ClusterControlPlane).Id)
+	}
+	if isTokenMode {
+		if err := s.deleteRoleBinding(namespacedName.Namespace, namespacedName.Name, uid); err != nil {
+			return err
+		}
 	}
 	// delete ClusterControlPlane
 	if isDeleteCCP {
@@ -396,7 +450,7 @@ func (s *NSXServiceAccountService) DeleteNSXServiceAccount(ctx context.Context,
 // ValidateAndUpdateRealizedNSXServiceAccount checks CA is up-to-date and client cert needs rotation
 // ca is nil means no need to update CA
 // Client cert rotation requires NSXT 4.1.3
-// It also updates NSXServiceAccount.Status.NSXRestoreStatus
+// It also updates NSXServiceAccount.Status.NSXRestoreStatus and the client cert status
 func (s *NSXServiceAccountService) ValidateAndUpdateRealizedNSXServiceAccount(ctx context.Context, obj *v1alpha1.NSXServiceAccount, ca []byte,
 	nsxRestoreStatus *v1alpha1.NSXRestoreStatus) error {
 
@@ -406,11 +460,23 @@ func (s *NSXServiceAccountService) ValidateAndUpdateRealizedNSXServiceAccount(ct
 	secretNamespace := obj.Namespace
 	isUpdated := false
 	secret := &v1.Secret{}
-	isCheckCert := s.NSXClient.NSXCheckVersion(nsx.ServiceAccountCertRotation) && obj.Spec.EnableCertRotation
-	if ca != nil || isCheckCert {
-		if err := s.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: secretNamespace}, secret); err != nil {
+	// the token Secret is refreshed with the CA in Token credential mode
+	if IsTokenCredentialMode(obj) {
+		if err := s.RefreshServiceAccountToken(ctx, obj); err != nil {
 			return err
 		}
+		ca = nil
+	}
+	// the client cert expiry is reported in the status regardless of the cert rotation settings
+	isCertMode := !IsTokenCredentialMode(obj)
+	isCheckCert := isCertMode && s.NSXClient.NSXCheckVersion(nsx.ServiceAccountCertRotation) && obj.Spec.EnableCertRotation
+	if ca != nil || isCertMode {
+		if err := s.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: secretNamespace}, secret); err != nil {
+			if ca != nil || isCheckCert {
+				return err
+			}
+			log.Error(err, "Failed to get Secret to check the client cert expiry", "namespace", secretNamespace, "name", secretName)
+		}
 	}
 
 	// check CA is up-to-date
@@ -427,60 +493,186 @@ func (s *NSXServiceAccountService) ValidateAndUpdateRealizedNSXServiceAccount(ct
 	}
 
 	// check client cert need rotation
-	if isCheckCert {
-		oldCert := secret.Data[SecretCertName]
-		certBlock, _ := pem.Decode(oldCert)
-		if certBlock == nil {
-			return fmt.Errorf("missing client cert")
-		}
-		if oldCertObj, err := x509.ParseCertificate(certBlock.Bytes); err != nil {
-			return err
-		} else if time.Now().AddDate(0, 0, util.DefaultRotateDays).After(oldCertObj.NotAfter) {
-			isUpdated = true
-			// generate certificate
-			subject := util.DefaultSubject
-			subject.CommonName = normalizedClusterName
-			cert, key, err := util.GenerateCertificate(&subject, util.DefaultValidDaysWithRotation)
-			if err != nil {
+	var certNotAfter *time.Time
+	isCertRotated := false
+	oldCert := secret.Data[SecretCertName]
+	if isCertMode {
+		notAfter, err := parseCertNotAfter(oldCert)
+		if err != nil {
+			if isCheckCert {
 				return err
 			}
-			// update PI and CCP cert
-			if err = s.updatePIAndCCPCert(normalizedClusterName, string(obj.UID), cert); err != nil {
-				return err
+			log.Error(err, "Failed to parse client cert expiry", "namespace", secretNamespace, "name", secretName)
+		} else {
+			if isCheckCert && time.Now().Add(s.getCertRotationLeadTime()).After(notAfter) {
+				isUpdated = true
+				isCertRotated = true
+				if notAfter, err = s.rotateClientCert(normalizedClusterName, obj, secret); err != nil {
+					return err
+				}
 			}
-			secret.Data[SecretCertName] = []byte(cert)
-			secret.Data[SecretKeyName] = []byte(key)
+			certNotAfter = &notAfter
 		}
 	}
 
 	if isUpdated {
 		log.Info("Update realized NSXServiceAccount", "namespace", obj.Namespace, "name", obj.Name)
 		if err := s.Client.Update(ctx, secret); err != nil {
+			if isCertRotated {
+				s.rollbackClientCert(normalizedClusterName, obj, oldCert)
+			}
 			return err
 		}
 	}
 
+	oldStatus := obj.Status.DeepCopy()
+	isStatusUpdated := false
+	// update client cert status
+	if certNotAfter != nil {
+		isStatusUpdated = setCertStatus(&obj.Status, *certNotAfter, isCertRotated)
+	}
 	// update NSX Restore Status
 	if nsxRestoreStatus != nil && nsxRestoreStatus.Status == mpmodel.GlobalRestoreStatus_VALUE_SUCCESS &&
 		!reflect.DeepEqual(nsxRestoreStatus, obj.Status.NSXRestoreStatus) {
 
 		log.Info("Updating NSX restore status to NSXServiceAccount", "namespace", obj.Namespace, "name", obj.Name, "nsxRestoreStatus", nsxRestoreStatus)
-		oldNSXRestoreStatus := obj.Status.NSXRestoreStatus
 		obj.Status.NSXRestoreStatus = nsxRestoreStatus
-		err := retry.OnError(retry.DefaultRetry, func(err error) bool {
-			return err != nil
-		}, func() error {
-			return s.Client.Status().Update(ctx, obj)
-		})
-		if err != nil {
-			obj.Status.NSXRestoreStatus = oldNSXRestoreStatus
-			log.Error(err, "failed to update NSXServiceAccount with nsxRestoreStatus", "namespace", obj.Namespace, "name", obj.Name, "nsxRestoreStatus", nsxRestoreStatus)
+		isStatusUpdated = true
+	}
+	if isStatusUpdated {
+		if err := s.updateStatus(ctx, obj); err != nil {
+			obj.Status = *oldStatus
+			log.Error(err, "failed to update NSXServiceAccount status", "namespace", obj.Namespace, "name", obj.Name, "nsxRestoreStatus", nsxRestoreStatus)
 			return err
 		}
 	}
 	return nil
 }
 
+// RotateNSXServiceAccountCert rotates the client cert immediately regardless of its expiry time.
+// Client cert rotation requires NSXT 4.1.3
+func (s *NSXServiceAccountService) RotateNSXServiceAccountCert(ctx context.Context, obj *v1alpha1.NSXServiceAccount) error {
+	if IsTokenCredentialMode(obj) {
+		log.Info("Skip client cert rotation of NSXServiceAccount in Token credential mode", "namespace", obj.Namespace, "name", obj.Name)
+		return nil
+	}
+	if !s.NSXClient.NSXCheckVersion(nsx.ServiceAccountCertRotation) {
+		return fmt.Errorf("NSX version check failed, client cert rotation is not supported")
+	}
+	secret := &v1.Secret{}
+	if err := s.Client.Get(ctx, types.NamespacedName{Name: obj.Name + SecretSuffix, Namespace: obj.Namespace}, secret); err != nil {
+		return err
+	}
+	normalizedClusterName := util.NormalizeId(s.getClusterName(obj.Namespace, obj.Name))
+	oldCert := secret.Data[SecretCertName]
+	certNotAfter, err := s.rotateClientCert(normalizedClusterName, obj, secret)
+	if err != nil {
+		return err
+	}
+	log.Info("Rotate client cert of NSXServiceAccount on demand", "namespace", obj.Namespace, "name", obj.Name, "certNotAfter", certNotAfter)
+	if err := s.Client.Update(ctx, secret); err != nil {
+		s.rollbackClientCert(normalizedClusterName, obj, oldCert)
+		return err
+	}
+	oldStatus := obj.Status.DeepCopy()
+	setCertStatus(&obj.Status, certNotAfter, true)
+	if err := s.updateStatus(ctx, obj); err != nil {
+		obj.Status = *oldStatus
+		return err
+	}
+	return nil
+}
+
+// NeedCertRotation returns true if the client cert in the status expires within the rotation lead time.
+func (s *NSXServiceAccountService) NeedCertRotation(obj *v1alpha1.NSXServiceAccount) bool {
+	return obj.Spec.EnableCertRotation && obj.Status.CertNotAfter != nil &&
+		time.Now().Add(s.getCertRotationLeadTime()).After(obj.Status.CertNotAfter.Time)
+}
+
+func (s *NSXServiceAccountService) getCertRotationLeadTime() time.Duration {
+	leadDays := s.NSXConfig.CertRotationLeadDays
+	if leadDays <= 0 {
+		leadDays = util.DefaultRotateDays
+	}
+	return time.Duration(leadDays) * 24 * time.Hour
+}
+
+// rotateClientCert generates a new client cert, updates it to PI and CCP and sets it to the Secret data.
+// It returns the expiry time of the new client cert.
+func (s *NSXServiceAccountService) rotateClientCert(normalizedClusterName string, obj *v1alpha1.NSXServiceAccount, secret *v1.Secret) (time.Time, error) {
+	// generate certificate
+	subject := util.DefaultSubject
+	subject.CommonName = normalizedClusterName
+	validDays := util.DefaultValidDays
+	if obj.Spec.EnableCertRotation {
+		validDays = util.DefaultValidDaysWithRotation
+	}
+	cert, key, err := util.GenerateCertificate(&subject, validDays)
+	if err != nil {
+		return time.Time{}, err
+	}
+	certNotAfter, err := parseCertNotAfter([]byte(cert))
+	if err != nil {
+		return time.Time{}, err
+	}
+	// update PI and CCP cert
+	if err = s.updatePIAndCCPCert(normalizedClusterName, string(obj.UID), cert); err != nil {
+		return time.Time{}, err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[SecretCertName] = []byte(cert)
+	secret.Data[SecretKeyName] = []byte(key)
+	return certNotAfter, nil
+}
+
+// rollbackClientCert restores the previous client cert to PI and CCP if the Secret failed to be updated with the
+// rotated cert, otherwise the client cert kept in the Secret is no longer accepted by NSX.
+func (s *NSXServiceAccountService) rollbackClientCert(normalizedClusterName string, obj *v1alpha1.NSXServiceAccount, oldCert []byte) {
+	if len(oldCert) == 0 {
+		return
+	}
+	if err := s.updatePIAndCCPCert(normalizedClusterName, string(obj.UID), string(oldCert)); err != nil {
+		log.Error(err, "Failed to roll back client cert of NSXServiceAccount", "namespace", obj.Namespace, "name", obj.Name)
+	}
+}
+
+func (s *NSXServiceAccountService) updateStatus(ctx context.Context, obj *v1alpha1.NSXServiceAccount) error {
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return err != nil
+	}, func() error {
+		return s.Client.Status().Update(ctx, obj)
+	})
+}
+
+// setCertStatus sets the client cert expiry time and the rotation time to the status, returns true if the status is changed.
+func setCertStatus(status *v1alpha1.NSXServiceAccountStatus, certNotAfter time.Time, isRotated bool) bool {
+	isChanged := false
+	if status.CertNotAfter == nil || !status.CertNotAfter.Time.Equal(certNotAfter) {
+		status.CertNotAfter = &metav1.Time{Time: certNotAfter}
+		isChanged = true
+	}
+	if isRotated {
+		now := metav1.Now()
+		status.LastCertRotationTime = &now
+		isChanged = true
+	}
+	return isChanged
+}
+
+func parseCertNotAfter(cert []byte) (time.Time, error) {
+	certBlock, _ := pem.Decode(cert)
+	if certBlock == nil {
+		return time.Time{}, fmt.Errorf("missing client cert")
+	}
+	certObj, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return certObj.NotAfter, nil
+}
+
 func (s *NSXServiceAccountService) updatePIAndCCPCert(normalizedClusterName, uid, cert string) error {
 	hasPI := len(s.PrincipalIdentityStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, uid)) > 0
 	hasCCP := len(s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, uid)) > 0
@@ -550,6 +742,38 @@ func (s *NSXServiceAccountService) ListNSXServiceAccountRealization() sets.Set[s
 	return uidSet
 }
 
+// ListNSXServiceAccountResourcePaths returns the paths of the PI and CCP created for the NSXServiceAccount UID.
+func (s *NSXServiceAccountService) ListNSXServiceAccountResourcePaths(uid string) []string {
+	var paths []string
+	for _, obj := range s.PrincipalIdentityStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, uid) {
+		pi := obj.(*mpmodel.PrincipalIdentity)
+		if pi.Id != nil {
+			paths = append(paths, principalIdentityPathPrefix+*pi.Id)
+		}
+	}
+	for _, obj := range s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, uid) {
+		ccp := obj.(*model.ClusterControlPlane)
+		if ccp.Path != nil {
+			paths = append(paths, *ccp.Path)
+		}
+	}
+	return paths
+}
+
+// IsRealizedNSXServiceAccountMissing returns true if RestoreRealizedNSXServiceAccount would restore the PI/CCP of the
+// realized NSXServiceAccount, i.e. the CCP is missing in Token credential mode, or both PI/CCP are missing.
+func (s *NSXServiceAccountService) IsRealizedNSXServiceAccountMissing(obj *v1alpha1.NSXServiceAccount) bool {
+	normalizedClusterName := obj.Status.ClusterName
+	hasCCP := len(s.ClusterControlPlaneStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(obj.UID))) > 0 ||
+		s.ClusterControlPlaneStore.GetByKey(normalizedClusterName) != nil
+	if IsTokenCredentialMode(obj) {
+		return !hasCCP
+	}
+	hasPI := len(s.PrincipalIdentityStore.GetByIndex(common.TagScopeNSXServiceAccountCRUID, string(obj.UID))) > 0 ||
+		s.PrincipalIdentityStore.GetByKey(normalizedClusterName) != nil
+	return !hasPI && !hasCCP
+}
+
 func (s *NSXServiceAccountService) GetNSXServiceAccountNameByUID(uid string) (namespacedName types.NamespacedName) {
 	objs, err := s.PrincipalIdentityStore.ByIndex(common.TagScopeNSXServiceAccountCRUID, uid)
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
@@ -695,6 +696,11 @@ func TestNSXServiceAccountService_CreateOrUpdateNSXServiceAccount(t *testing.T)
 				for i := range actualCR.Status.Conditions {
 					actualCR.Status.Conditions[i].LastTransitionTime = metav1.Time{}
 				}
+				if tt.wantSecret {
+					require.NotNil(t, actualCR.Status.CertNotAfter)
+					assert.True(t, time.Now().AddDate(0, 0, util.DefaultValidDays-1).Before(actualCR.Status.CertNotAfter.Time))
+					actualCR.Status.CertNotAfter = nil
+				}
 				assert.Equal(t, tt.expectedCR.Status, actualCR.Status)
 			}
 			if !tt.wantErr {
@@ -1357,6 +1363,7 @@ func TestNSXServiceAccountService_ValidateAndUpdateRealizedNSXServiceAccount(t *
 		args                     args
 		wantNewCA                bool
 		wantNewCert              bool
+		wantCertNotAfter         bool
 		wantErr                  bool
 		expectedNSXRestoreStatus *v1alpha1.NSXRestoreStatus
 	}{
@@ -1589,6 +1596,50 @@ func TestNSXServiceAccountService_ValidateAndUpdateRealizedNSXServiceAccount(t *
 			wantNewCert: true,
 			wantErr:     false,
 		},
+		{
+			name: "SetCertNotAfterWithoutRotation",
+			prepareFunc: func(t *testing.T, s *NSXServiceAccountService, ctx context.Context, obj *v1alpha1.NSXServiceAccount) *gomonkey.Patches {
+				assert.NoError(t, s.Client.Create(ctx, &v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      obj.Status.Secrets[0].Name,
+						Namespace: obj.Status.Secrets[0].Namespace,
+					},
+					Data: map[string][]byte{SecretCertName: []byte(cert), SecretKeyName: []byte("fakeKey")},
+				}))
+				patches := gomonkey.ApplyMethodSeq(s.NSXClient, "NSXCheckVersion", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{false},
+					Times:  1,
+				}})
+				return patches
+			},
+			args: args{
+				obj: &v1alpha1.NSXServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "name1",
+						Namespace: "ns1",
+						UID:       "00000000-0000-0000-0000-000000000001",
+					},
+					Spec: v1alpha1.NSXServiceAccountSpec{
+						VPCName: "vpc1",
+					},
+					Status: v1alpha1.NSXServiceAccountStatus{
+						Phase:       v1alpha1.NSXServiceAccountPhaseRealized,
+						VPCPath:     "/orgs/default/projects/k8scl-one:test/vpcs/vpc1",
+						ClusterID:   "clusterId1",
+						ClusterName: "k8scl-one_test-ns1-name1",
+						Secrets: []v1alpha1.NSXSecret{{
+							Name:      "name1" + SecretSuffix,
+							Namespace: "ns1",
+						}},
+					},
+				},
+				ca: nil,
+			},
+			wantNewCA:        false,
+			wantNewCert:      false,
+			wantCertNotAfter: true,
+			wantErr:          false,
+		},
 		{
 			name: "AddNSXRestoreStatus",
 			prepareFunc: func(t *testing.T, s *NSXServiceAccountService, ctx context.Context, obj *v1alpha1.NSXServiceAccount) *gomonkey.Patches {
@@ -1780,8 +1831,140 @@ func TestNSXServiceAccountService_ValidateAndUpdateRealizedNSXServiceAccount(t *
 				Name:      tt.args.obj.Name,
 			}, nsxsa))
 			assert.Equal(t, nsxsa.Status.NSXRestoreStatus, tt.expectedNSXRestoreStatus)
+			assert.Equal(t, tt.wantNewCert, nsxsa.Status.LastCertRotationTime != nil)
+			assert.Equal(t, tt.wantNewCert || tt.wantCertNotAfter, nsxsa.Status.CertNotAfter != nil)
+			if tt.wantCertNotAfter {
+				certNotAfter, err := parseCertNotAfter([]byte(cert))
+				require.NoError(t, err)
+				assert.True(t, certNotAfter.Equal(nsxsa.Status.CertNotAfter.Time))
+			}
+		})
+	}
+}
+
+func TestNSXServiceAccountService_CertRotation(t *testing.T) {
+	subject := util.DefaultSubject
+	subject.CommonName = "k8scl-one_test-ns1-name1"
+	cert, _, _ := util.GenerateCertificate(&subject, 30)
+	certNotAfter, err := parseCertNotAfter([]byte(cert))
+	require.NoError(t, err)
+
+	setUp := func(t *testing.T, ctx context.Context) (*NSXServiceAccountService, *v1alpha1.NSXServiceAccount, *gomonkey.Patches) {
+		s := &NSXServiceAccountService{Service: newFakeCommonService()}
+		s.SetUpStore()
+		obj := &v1alpha1.NSXServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name1",
+				Namespace: "ns1",
+				UID:       "00000000-0000-0000-0000-000000000001",
+			},
+			Spec: v1alpha1.NSXServiceAccountSpec{
+				VPCName:            "vpc1",
+				EnableCertRotation: true,
+			},
+			Status: v1alpha1.NSXServiceAccountStatus{
+				Phase:       v1alpha1.NSXServiceAccountPhaseRealized,
+				ClusterName: "k8scl-one_test-ns1-name1",
+				Secrets:     []v1alpha1.NSXSecret{{Name: "name1" + SecretSuffix, Namespace: "ns1"}},
+			},
+		}
+		assert.NoError(t, s.Client.Create(ctx, obj))
+		assert.NoError(t, s.Client.Create(ctx, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "name1" + SecretSuffix, Namespace: "ns1"},
+			Data:       map[string][]byte{SecretCertName: []byte(cert), SecretKeyName: []byte("fakeKey")},
+		}))
+		patches := gomonkey.ApplyMethod(s.NSXClient, "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+			return true
 		})
+		patches.ApplyPrivateMethod(s, "updatePIAndCCPCert", func(_ *NSXServiceAccountService, _, _, _ string) error {
+			return nil
+		})
+		return s, obj, patches
+	}
+	getSecretCertNotAfter := func(t *testing.T, ctx context.Context, s *NSXServiceAccountService) time.Time {
+		secret := &v1.Secret{}
+		require.NoError(t, s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1" + SecretSuffix}, secret))
+		notAfter, err := parseCertNotAfter(secret.Data[SecretCertName])
+		require.NoError(t, err)
+		return notAfter
 	}
+
+	t.Run("NotInLeadTime", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, patches := setUp(t, ctx)
+		defer patches.Reset()
+
+		assert.NoError(t, s.ValidateAndUpdateRealizedNSXServiceAccount(ctx, obj, nil, nil))
+		assert.True(t, certNotAfter.Equal(getSecretCertNotAfter(t, ctx, s)))
+		require.NotNil(t, obj.Status.CertNotAfter)
+		assert.True(t, certNotAfter.Equal(obj.Status.CertNotAfter.Time))
+		assert.Nil(t, obj.Status.LastCertRotationTime)
+		assert.False(t, s.NeedCertRotation(obj))
+	})
+
+	t.Run("InLeadTime", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, patches := setUp(t, ctx)
+		defer patches.Reset()
+		s.NSXConfig.CertRotationLeadDays = 60
+		obj.Status.CertNotAfter = &metav1.Time{Time: certNotAfter}
+		assert.True(t, s.NeedCertRotation(obj))
+
+		assert.NoError(t, s.ValidateAndUpdateRealizedNSXServiceAccount(ctx, obj, nil, nil))
+		newCertNotAfter := getSecretCertNotAfter(t, ctx, s)
+		assert.True(t, newCertNotAfter.After(certNotAfter))
+		assert.True(t, newCertNotAfter.Equal(obj.Status.CertNotAfter.Time))
+		assert.NotNil(t, obj.Status.LastCertRotationTime)
+	})
+
+	t.Run("RotateOnDemand", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, patches := setUp(t, ctx)
+		defer patches.Reset()
+		obj.Spec.EnableCertRotation = false
+
+		assert.NoError(t, s.RotateNSXServiceAccountCert(ctx, obj))
+		newCertNotAfter := getSecretCertNotAfter(t, ctx, s)
+		assert.True(t, time.Now().AddDate(0, 0, util.DefaultValidDays-1).Before(newCertNotAfter))
+		nsxsa := &v1alpha1.NSXServiceAccount{}
+		require.NoError(t, s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, nsxsa))
+		require.NotNil(t, nsxsa.Status.CertNotAfter)
+		assert.True(t, newCertNotAfter.Equal(nsxsa.Status.CertNotAfter.Time))
+		assert.NotNil(t, nsxsa.Status.LastCertRotationTime)
+	})
+
+	t.Run("RotateOnDemandSecretUpdateFailed", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, patches := setUp(t, ctx)
+		defer patches.Reset()
+		var nsxCerts []string
+		patches.ApplyPrivateMethod(s, "updatePIAndCCPCert", func(_ *NSXServiceAccountService, _, _, nsxCert string) error {
+			nsxCerts = append(nsxCerts, nsxCert)
+			return nil
+		})
+		patches.ApplyMethodFunc(s.Client, "Update", func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+			return errors.New("update failed")
+		})
+
+		assert.Error(t, s.RotateNSXServiceAccountCert(ctx, obj))
+		require.Len(t, nsxCerts, 2)
+		assert.NotEqual(t, cert, nsxCerts[0])
+		assert.Equal(t, cert, nsxCerts[1])
+		assert.Nil(t, obj.Status.LastCertRotationTime)
+	})
+
+	t.Run("RotateOnDemandUnsupported", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, patches := setUp(t, ctx)
+		defer patches.Reset()
+		patches.ApplyMethod(s.NSXClient, "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+			return false
+		})
+
+		assert.Error(t, s.RotateNSXServiceAccountCert(ctx, obj))
+		assert.True(t, certNotAfter.Equal(getSecretCertNotAfter(t, ctx, s)))
+		assert.Nil(t, obj.Status.LastCertRotationTime)
+	})
 }
 
 func TestNSXServiceAccountService_GetNSXRestoreStatus(t *testing.T) {
@@ -1969,6 +2152,9 @@ func TestNSXServiceAccountService_DeleteNSXServiceAccount(t *testing.T) {
 			commonService := newFakeCommonService()
 			s := &NSXServiceAccountService{Service: commonService}
 			s.SetUpStore()
+			s.NSXClient.Cluster = &nsx.Cluster{}
+			restPatches := (&fakeNSXRest{}).patch(s.NSXClient.Cluster)
+			defer restPatches.Reset()
 			patches := tt.prepareFunc(t, s, ctx)
 			defer patches.Reset()
 
@@ -0,0 +1,378 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsxserviceaccount
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+)
+
+// The OIDC endpoints and role bindings are not in the SDK, so they are managed with the NSX REST API.
+const (
+	oidcEndpointsURL = "api/v1/trust-management/oidc-uris"
+	roleBindingsURL  = "api/v1/aaa/role-bindings"
+	roleBindingURL   = "api/v1/aaa/role-bindings/%s"
+
+	oidcTypeGeneric           = "generic"
+	roleBindingTypeRemoteUser = "remote_user"
+	identitySourceTypeOIDC    = "OIDC"
+	// serviceAccountUserFormat is the username in the Kubernetes ServiceAccount tokens.
+	serviceAccountUserFormat = "system:serviceaccount:%s:%s"
+)
+
+const (
+	// #nosec G101: false positive triggered by variable name which includes "secret"
+	TokenSecretSuffix = "-nsx-token"
+	// #nosec G101: false positive triggered by variable name which includes "token"
+	SecretTokenName = "token"
+	// AnnotationTokenExpiration is the expiration time of the token in the token Secret in RFC3339 format.
+	AnnotationTokenExpiration = "nsx.vmware.com/token-expiration"
+
+	tokenExpirationSeconds int64 = 3600
+	// tokenRefreshLeadTime is longer than two GC intervals, so that the token is refreshed before it expires even if
+	// the NSXServiceAccount is not reconciled.
+	tokenRefreshLeadTime = 30 * time.Minute
+)
+
+type oidcEndpoint struct {
+	ID         string `json:"id,omitempty"`
+	OidcURI    string `json:"oidc_uri"`
+	OidcType   string `json:"oidc_type,omitempty"`
+	Thumbprint string `json:"thumbprint,omitempty"`
+}
+
+type oidcEndpointList struct {
+	Results []oidcEndpoint `json:"results"`
+}
+
+type role struct {
+	Role string `json:"role"`
+}
+
+type rolesForPath struct {
+	Path  string `json:"path"`
+	Roles []role `json:"roles"`
+}
+
+type roleBindingTag struct {
+	Scope string `json:"scope"`
+	Tag   string `json:"tag"`
+}
+
+type roleBinding struct {
+	ID                 string           `json:"id,omitempty"`
+	Name               string           `json:"name"`
+	Type               string           `json:"type"`
+	IdentitySourceType string           `json:"identity_source_type,omitempty"`
+	IdentitySourceID   string           `json:"identity_source_id,omitempty"`
+	RolesForPaths      []rolesForPath   `json:"roles_for_paths"`
+	Tags               []roleBindingTag `json:"tags,omitempty"`
+}
+
+type roleBindingList struct {
+	Results []roleBinding `json:"results"`
+}
+
+// IsTokenCredentialMode returns true if the NSXServiceAccount accesses NSX with the ServiceAccount tokens.
+func IsTokenCredentialMode(obj *v1alpha1.NSXServiceAccount) bool {
+	return obj.Spec.CredentialMode == v1alpha1.NSXServiceAccountCredentialModeToken
+}
+
+func getServiceAccountUser(namespace, name string) string {
+	return fmt.Sprintf(serviceAccountUserFormat, namespace, name)
+}
+
+// isTokenCredentialCCP returns true if the CCP is created for an NSXServiceAccount in Token credential mode.
+func (s *NSXServiceAccountService) isTokenCredentialCCP(normalizedClusterName string) bool {
+	ccpObj := s.ClusterControlPlaneStore.GetByKey(normalizedClusterName)
+	if ccpObj == nil {
+		return false
+	}
+	for _, tag := range ccpObj.(*model.ClusterControlPlane).Tags {
+		if tag.Scope != nil && *tag.Scope == tagScopeNSXServiceAccountCredMode && tag.Tag != nil {
+			return *tag.Tag == string(v1alpha1.NSXServiceAccountCredentialModeToken)
+		}
+	}
+	return false
+}
+
+// ensureServiceAccount creates the ServiceAccount with the same name as the NSXServiceAccount if it does not exist.
+// The ServiceAccount is not read from the cache, to avoid watching all the ServiceAccounts in the cluster.
+func (s *NSXServiceAccountService) ensureServiceAccount(ctx context.Context, obj *v1alpha1.NSXServiceAccount) (*v1.ServiceAccount, error) {
+	sa := &v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      obj.Name,
+			Namespace: obj.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: obj.APIVersion,
+				Kind:       obj.Kind,
+				Name:       obj.Name,
+				UID:        obj.UID,
+			}},
+		},
+	}
+	if err := s.Client.Create(ctx, sa); err != nil && !errors.IsAlreadyExists(err) {
+		log.Error(err, "Failed to create ServiceAccount", "namespace", obj.Namespace, "name", obj.Name)
+		return nil, err
+	}
+	return sa, nil
+}
+
+func needTokenRefresh(secret *v1.Secret, now time.Time) bool {
+	if len(secret.Data[SecretTokenName]) == 0 {
+		return true
+	}
+	expiration, err := time.Parse(time.RFC3339, secret.Annotations[AnnotationTokenExpiration])
+	if err != nil {
+		return true
+	}
+	return now.Add(tokenRefreshLeadTime).After(expiration)
+}
+
+// RefreshServiceAccountToken issues a bearer token of the ServiceAccount of the NSXServiceAccount in Token credential
+// mode with the TokenRequest API, and stores it with the NSX CA in the token Secret. The token is refreshed before it
+// expires, and the CA is updated with the token.
+func (s *NSXServiceAccountService) RefreshServiceAccountToken(ctx context.Context, obj *v1alpha1.NSXServiceAccount) error {
+	secret := &v1.Secret{}
+	secretName := obj.Name + TokenSecretSuffix
+	isFound := true
+	if err := s.Client.Get(ctx, types.NamespacedName{Namespace: obj.Namespace, Name: secretName}, secret); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		isFound = false
+	} else if !needTokenRefresh(secret, time.Now()) {
+		return nil
+	}
+
+	sa, err := s.ensureServiceAccount(ctx, obj)
+	if err != nil {
+		return err
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: ptr.To(tokenExpirationSeconds),
+		},
+	}
+	if audience := s.NSXConfig.K8sConfig.ServiceAccountTokenAudience; audience != "" {
+		tokenRequest.Spec.Audiences = []string{audience}
+	}
+	if err := s.Client.SubResource("token").Create(ctx, sa, tokenRequest); err != nil {
+		log.Error(err, "Failed to request ServiceAccount token", "namespace", obj.Namespace, "name", obj.Name)
+		return err
+	}
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[AnnotationTokenExpiration] = tokenRequest.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+	secret.Data = map[string][]byte{SecretTokenName: []byte(tokenRequest.Status.Token), CAName: s.NSXConfig.GetCACert()}
+	log.Info("Refresh ServiceAccount token", "namespace", obj.Namespace, "name", obj.Name, "expiration", secret.Annotations[AnnotationTokenExpiration])
+	if isFound {
+		return s.Client.Update(ctx, secret)
+	}
+	secret.Name = secretName
+	secret.Namespace = obj.Namespace
+	secret.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: obj.APIVersion,
+		Kind:       obj.Kind,
+		Name:       obj.Name,
+		UID:        obj.UID,
+	}}
+	return s.Client.Create(ctx, secret)
+}
+
+// createRoleBindingAndCCP realizes the NSXServiceAccount in Token credential mode. Instead of a PI with the client cert,
+// the ServiceAccount user of the OIDC endpoint is bound to the same roles, and the CCP is created without certificate.
+func (s *NSXServiceAccountService) createRoleBindingAndCCP(normalizedClusterName string, vpcPath string, existingClusterId *string, obj *v1alpha1.NSXServiceAccount) (string, error) {
+	oidcID, err := s.getOrCreateOIDCEndpoint()
+	if err != nil {
+		return "", err
+	}
+	if err := s.createRoleBindingIfNeeded(obj, oidcID, vpcPath); err != nil {
+		return "", err
+	}
+	return s.createCCP(normalizedClusterName, vpcPath, nil, existingClusterId, obj)
+}
+
+// tokenCredentialCache caches the OIDC endpoint and the role bindings found or created on NSX, so that the NSX REST API
+// is not called on each reconciliation of the realized NSXServiceAccounts. Like the PI and CCP stores, it is kept for
+// the lifetime of the process.
+type tokenCredentialCache struct {
+	lock           sync.Mutex
+	issuer         string
+	oidcEndpointID string
+	// roleBindingIDs is keyed by the ServiceAccount user.
+	roleBindingIDs map[string]string
+}
+
+func (c *tokenCredentialCache) getOIDCEndpointID(issuer string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.issuer != issuer {
+		return ""
+	}
+	return c.oidcEndpointID
+}
+
+func (c *tokenCredentialCache) setOIDCEndpointID(issuer, id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.issuer = issuer
+	c.oidcEndpointID = id
+}
+
+func (c *tokenCredentialCache) hasRoleBinding(user string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.roleBindingIDs[user]
+	return ok
+}
+
+func (c *tokenCredentialCache) setRoleBinding(user, id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.roleBindingIDs == nil {
+		c.roleBindingIDs = map[string]string{}
+	}
+	c.roleBindingIDs[user] = id
+}
+
+func (c *tokenCredentialCache) removeRoleBinding(user string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.roleBindingIDs, user)
+}
+
+// getOrCreateOIDCEndpoint registers the Kubernetes ServiceAccount issuer to NSX if it is not registered yet,
+// and returns the ID of the OIDC endpoint.
+func (s *NSXServiceAccountService) getOrCreateOIDCEndpoint() (string, error) {
+	issuer := s.NSXConfig.K8sConfig.ServiceAccountIssuer
+	if issuer == "" {
+		return "", fmt.Errorf("service_account_issuer is not configured for Token credential mode")
+	}
+	if id := s.tokenCache.getOIDCEndpointID(issuer); id != "" {
+		return id, nil
+	}
+	endpoints := oidcEndpointList{}
+	if err := s.NSXClient.Cluster.HttpGetAndDecode(oidcEndpointsURL, &endpoints); err != nil {
+		log.Error(err, "Failed to list OIDC endpoints")
+		return "", err
+	}
+	for _, endpoint := range endpoints.Results {
+		if endpoint.OidcURI == issuer {
+			s.tokenCache.setOIDCEndpointID(issuer, endpoint.ID)
+			return endpoint.ID, nil
+		}
+	}
+	log.Info("Register ServiceAccount issuer to NSX", "issuer", issuer)
+	resp, err := s.NSXClient.Cluster.HttpPost(oidcEndpointsURL, oidcEndpoint{
+		OidcURI:    issuer,
+		OidcType:   oidcTypeGeneric,
+		Thumbprint: s.NSXConfig.K8sConfig.ServiceAccountIssuerThumbprint,
+	})
+	if err != nil {
+		log.Error(err, "Failed to create OIDC endpoint", "issuer", issuer)
+		return "", err
+	}
+	id, _ := resp["id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("missing ID of OIDC endpoint %s", issuer)
+	}
+	s.tokenCache.setOIDCEndpointID(issuer, id)
+	return id, nil
+}
+
+func (s *NSXServiceAccountService) createRoleBindingIfNeeded(obj *v1alpha1.NSXServiceAccount, oidcID string, vpcPath string) error {
+	user := getServiceAccountUser(obj.Namespace, obj.Name)
+	if s.tokenCache.hasRoleBinding(user) {
+		return nil
+	}
+	existing, err := s.getRoleBinding(user)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		s.tokenCache.setRoleBinding(user, existing.ID)
+		return nil
+	}
+	var tags []roleBindingTag
+	for _, tag := range s.buildBasicTags(obj) {
+		tags = append(tags, roleBindingTag{Scope: *tag.Scope, Tag: *tag.Tag})
+	}
+	log.Info("Create role binding for ServiceAccount", "user", user)
+	resp, err := s.NSXClient.Cluster.HttpPost(roleBindingsURL, roleBinding{
+		Name:               user,
+		Type:               roleBindingTypeRemoteUser,
+		IdentitySourceType: identitySourceTypeOIDC,
+		IdentitySourceID:   oidcID,
+		RolesForPaths: []rolesForPath{{
+			Path:  readerPath,
+			Roles: []role{{Role: readerRole}},
+		}, {
+			Path:  vpcPath,
+			Roles: []role{{Role: vpcRole}},
+		}},
+		Tags: tags,
+	})
+	if err != nil {
+		log.Error(err, "Failed to create role binding", "user", user)
+		return err
+	}
+	id, _ := resp["id"].(string)
+	s.tokenCache.setRoleBinding(user, id)
+	return nil
+}
+
+func (s *NSXServiceAccountService) getRoleBinding(user string) (*roleBinding, error) {
+	bindings := roleBindingList{}
+	query := url.Values{}
+	query.Set("name", user)
+	query.Set("type", roleBindingTypeRemoteUser)
+	if err := s.NSXClient.Cluster.HttpGetAndDecode(roleBindingsURL+"?"+query.Encode(), &bindings); err != nil {
+		log.Error(err, "Failed to list role bindings", "user", user)
+		return nil, err
+	}
+	for i := range bindings.Results {
+		if bindings.Results[i].Name == user && bindings.Results[i].Type == roleBindingTypeRemoteUser {
+			return &bindings.Results[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// deleteRoleBinding deletes the role binding of the ServiceAccount user. The role binding tagged with another
+// NSXServiceAccount UID belongs to the recreated NSXServiceAccount with the same name and is kept.
+func (s *NSXServiceAccountService) deleteRoleBinding(namespace, name string, uid types.UID) error {
+	user := getServiceAccountUser(namespace, name)
+	binding, err := s.getRoleBinding(user)
+	if err != nil || binding == nil {
+		return err
+	}
+	for _, tag := range binding.Tags {
+		if tag.Scope == tagScopeNSXServiceAccountCRUID && tag.Tag != string(uid) {
+			log.Info("Skip deleting role binding of another NSXServiceAccount", "user", user, "uid", tag.Tag)
+			return nil
+		}
+	}
+	if err := s.NSXClient.Cluster.HttpDelete(fmt.Sprintf(roleBindingURL, binding.ID)); err != nil {
+		log.Error(err, "Failed to delete role binding", "user", user)
+		return err
+	}
+	s.tokenCache.removeRoleBinding(user)
+	return nil
+}
@@ -0,0 +1,285 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package nsxserviceaccount
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+)
+
+// fakeNSXRest records the NSX REST API calls of the Token credential mode.
+type fakeNSXRest struct {
+	oidcEndpoints []oidcEndpoint
+	roleBindings  []roleBinding
+	gets          int
+	posts         map[string]interface{}
+	deletes       []string
+}
+
+func (f *fakeNSXRest) patch(cluster *nsx.Cluster) *gomonkey.Patches {
+	f.posts = map[string]interface{}{}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(cluster), "HttpGetAndDecode", func(_ *nsx.Cluster, url string, result interface{}) error {
+		f.gets++
+		var data interface{}
+		switch {
+		case url == oidcEndpointsURL:
+			data = oidcEndpointList{Results: f.oidcEndpoints}
+		case strings.HasPrefix(url, roleBindingsURL+"?"):
+			data = roleBindingList{Results: f.roleBindings}
+		default:
+			return fmt.Errorf("unexpected url %s", url)
+		}
+		body, _ := json.Marshal(data)
+		return json.Unmarshal(body, result)
+	})
+	patches.ApplyMethod(reflect.TypeOf(cluster), "HttpPost", func(_ *nsx.Cluster, url string, requestBody interface{}) (map[string]interface{}, error) {
+		f.posts[url] = requestBody
+		return map[string]interface{}{"id": "id-" + url}, nil
+	})
+	patches.ApplyMethod(reflect.TypeOf(cluster), "HttpDelete", func(_ *nsx.Cluster, url string) error {
+		f.deletes = append(f.deletes, url)
+		return nil
+	})
+	return patches
+}
+
+func TestNSXServiceAccountService_TokenCredentialMode(t *testing.T) {
+	normalizedClusterName := "k8scl-one_test-ns1-name1"
+	vpcPath := "/orgs/default/projects/k8scl-one_test/vpcs/ns1-default-vpc"
+	clusterID := "clusterId1"
+	issuer := "https://kubernetes.default.svc.cluster.local"
+	user := "system:serviceaccount:ns1:name1"
+
+	setUp := func(t *testing.T, ctx context.Context, issuer string) (*NSXServiceAccountService, *v1alpha1.NSXServiceAccount, *fakeNSXRest, *gomonkey.Patches) {
+		s := &NSXServiceAccountService{Service: newFakeCommonService()}
+		s.SetUpStore()
+		s.NSXClient.Cluster = &nsx.Cluster{}
+		s.NSXConfig.K8sConfig = &config.K8sConfig{ServiceAccountIssuer: issuer}
+		obj := &v1alpha1.NSXServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name1",
+				Namespace: "ns1",
+				UID:       "00000000-0000-0000-0000-000000000001",
+			},
+			Spec: v1alpha1.NSXServiceAccountSpec{
+				CredentialMode: v1alpha1.NSXServiceAccountCredentialModeToken,
+			},
+		}
+		require.NoError(t, s.Client.Create(ctx, obj))
+		rest := &fakeNSXRest{}
+		patches := rest.patch(s.NSXClient.Cluster)
+		patches.ApplyMethod(s.NSXClient.ClusterControlPlanesClient, "Update", func(_ *fakeClusterControlPlanesClient, _ string, _ string, id string, ccp model.ClusterControlPlane) (model.ClusterControlPlane, error) {
+			ccp.Id = &id
+			ccp.NodeId = &clusterID
+			return ccp, nil
+		})
+		return s, obj, rest, patches
+	}
+
+	t.Run("CreateWithExistingOIDCEndpoint", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.oidcEndpoints = []oidcEndpoint{{ID: "oidc1", OidcURI: issuer}}
+
+		require.NoError(t, s.CreateOrUpdateNSXServiceAccount(ctx, obj))
+
+		assert.NotContains(t, rest.posts, oidcEndpointsURL)
+		require.Contains(t, rest.posts, roleBindingsURL)
+		binding := rest.posts[roleBindingsURL].(roleBinding)
+		assert.Equal(t, user, binding.Name)
+		assert.Equal(t, roleBindingTypeRemoteUser, binding.Type)
+		assert.Equal(t, "oidc1", binding.IdentitySourceID)
+		assert.Equal(t, []rolesForPath{{Path: readerPath, Roles: []role{{Role: readerRole}}}, {Path: vpcPath, Roles: []role{{Role: vpcRole}}}}, binding.RolesForPaths)
+
+		ccp := s.ClusterControlPlaneStore.GetByKey(normalizedClusterName).(*model.ClusterControlPlane)
+		assert.Nil(t, ccp.Certificate)
+		assert.True(t, s.isTokenCredentialCCP(normalizedClusterName))
+		assert.Empty(t, s.PrincipalIdentityStore.ListKeys())
+
+		nsxsa := &v1alpha1.NSXServiceAccount{}
+		require.NoError(t, s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, nsxsa))
+		assert.True(t, IsNSXServiceAccountRealized(&nsxsa.Status))
+		assert.Equal(t, clusterID, nsxsa.Status.ClusterID)
+		assert.Equal(t, []v1alpha1.NSXSecret{{Name: "name1" + TokenSecretSuffix, Namespace: "ns1"}}, nsxsa.Status.Secrets)
+		assert.Nil(t, nsxsa.Status.CertNotAfter)
+		err := s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1" + SecretSuffix}, &v1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+
+		require.NoError(t, s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, &v1.ServiceAccount{}))
+		secret := &v1.Secret{}
+		require.NoError(t, s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1" + TokenSecretSuffix}, secret))
+		assert.Equal(t, "fake-token", string(secret.Data[SecretTokenName]))
+		assert.Contains(t, secret.Data, CAName)
+		assert.NotEmpty(t, secret.Annotations[AnnotationTokenExpiration])
+		assert.Equal(t, obj.UID, secret.OwnerReferences[0].UID)
+	})
+
+	t.Run("CreateOIDCEndpoint", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+
+		require.NoError(t, s.CreateOrUpdateNSXServiceAccount(ctx, obj))
+
+		require.Contains(t, rest.posts, oidcEndpointsURL)
+		assert.Equal(t, issuer, rest.posts[oidcEndpointsURL].(oidcEndpoint).OidcURI)
+		assert.Equal(t, "id-"+oidcEndpointsURL, rest.posts[roleBindingsURL].(roleBinding).IdentitySourceID)
+	})
+
+	t.Run("MissingIssuer", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, "")
+		defer patches.Reset()
+
+		assert.Error(t, s.CreateOrUpdateNSXServiceAccount(ctx, obj))
+		assert.Empty(t, rest.posts)
+		assert.Nil(t, s.ClusterControlPlaneStore.GetByKey(normalizedClusterName))
+	})
+
+	t.Run("RestoreWithExistingRoleBinding", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.oidcEndpoints = []oidcEndpoint{{ID: "oidc1", OidcURI: issuer}}
+		rest.roleBindings = []roleBinding{{ID: "rb1", Name: user, Type: roleBindingTypeRemoteUser}}
+		obj.Status = v1alpha1.NSXServiceAccountStatus{
+			Phase:       v1alpha1.NSXServiceAccountPhaseRealized,
+			ClusterID:   clusterID,
+			ClusterName: normalizedClusterName,
+			VPCPath:     vpcPath,
+		}
+
+		require.NoError(t, s.RestoreRealizedNSXServiceAccount(ctx, obj))
+		assert.Empty(t, rest.posts)
+		assert.True(t, s.isTokenCredentialCCP(normalizedClusterName))
+		assert.Equal(t, 2, rest.gets)
+
+		// The OIDC endpoint and the role binding are cached.
+		require.NoError(t, s.RestoreRealizedNSXServiceAccount(ctx, obj))
+		assert.Empty(t, rest.posts)
+		assert.Equal(t, 2, rest.gets)
+	})
+
+	t.Run("SkipCertRotation", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, _, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		obj.Spec.EnableCertRotation = true
+
+		assert.NoError(t, s.RotateNSXServiceAccountCert(ctx, obj))
+		assert.NoError(t, s.ValidateAndUpdateRealizedNSXServiceAccount(ctx, obj, []byte("ca"), nil))
+		assert.Nil(t, obj.Status.LastCertRotationTime)
+		assert.False(t, s.NeedCertRotation(obj))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.oidcEndpoints = []oidcEndpoint{{ID: "oidc1", OidcURI: issuer}}
+		require.NoError(t, s.CreateOrUpdateNSXServiceAccount(ctx, obj))
+		rest.roleBindings = []roleBinding{{ID: "rb1", Name: user, Type: roleBindingTypeRemoteUser}}
+
+		require.NoError(t, s.DeleteNSXServiceAccount(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, obj.UID))
+		assert.Equal(t, []string{fmt.Sprintf(roleBindingURL, "rb1")}, rest.deletes)
+		assert.Nil(t, s.ClusterControlPlaneStore.GetByKey(normalizedClusterName))
+		err := s.Client.Get(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1" + TokenSecretSuffix}, &v1.Secret{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("DeleteCertificateModeSkipsRoleBinding", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		obj.Spec.CredentialMode = v1alpha1.NSXServiceAccountCredentialModeCertificate
+		require.NoError(t, s.Client.Update(ctx, obj))
+
+		require.NoError(t, s.DeleteNSXServiceAccount(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, obj.UID))
+		assert.Zero(t, rest.gets)
+		assert.Empty(t, rest.deletes)
+	})
+
+	t.Run("DeleteWithTokenCCP", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.oidcEndpoints = []oidcEndpoint{{ID: "oidc1", OidcURI: issuer}}
+		require.NoError(t, s.CreateOrUpdateNSXServiceAccount(ctx, obj))
+		rest.roleBindings = []roleBinding{{ID: "rb1", Name: user, Type: roleBindingTypeRemoteUser}}
+		// The NSXServiceAccount is deleted without the finalizer, the credential mode is read from the CCP.
+		require.NoError(t, s.Client.Delete(ctx, obj))
+
+		require.NoError(t, s.DeleteNSXServiceAccount(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, obj.UID))
+		assert.Equal(t, []string{fmt.Sprintf(roleBindingURL, "rb1")}, rest.deletes)
+	})
+
+	t.Run("RefreshToken", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, _, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		secretKey := types.NamespacedName{Namespace: "ns1", Name: "name1" + TokenSecretSuffix}
+		expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		require.NoError(t, s.Client.Create(ctx, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretKey.Name, Namespace: secretKey.Namespace,
+				Annotations: map[string]string{AnnotationTokenExpiration: expiration}},
+			Data: map[string][]byte{SecretTokenName: []byte("token1")},
+		}))
+
+		// The token is not refreshed before the refresh lead time.
+		require.NoError(t, s.RefreshServiceAccountToken(ctx, obj))
+		secret := &v1.Secret{}
+		require.NoError(t, s.Client.Get(ctx, secretKey, secret))
+		assert.Equal(t, "token1", string(secret.Data[SecretTokenName]))
+
+		secret.Annotations[AnnotationTokenExpiration] = time.Now().Add(tokenRefreshLeadTime / 2).UTC().Format(time.RFC3339)
+		require.NoError(t, s.Client.Update(ctx, secret))
+		require.NoError(t, s.RefreshServiceAccountToken(ctx, obj))
+		require.NoError(t, s.Client.Get(ctx, secretKey, secret))
+		assert.Equal(t, "fake-token", string(secret.Data[SecretTokenName]))
+		assert.NotEqual(t, expiration, secret.Annotations[AnnotationTokenExpiration])
+	})
+
+	t.Run("DeleteWithoutCCP", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.roleBindings = []roleBinding{{ID: "rb1", Name: user, Type: roleBindingTypeRemoteUser,
+			Tags: []roleBindingTag{{Scope: tagScopeNSXServiceAccountCRUID, Tag: string(obj.UID)}}}}
+		require.NoError(t, s.Client.Delete(ctx, obj))
+
+		require.NoError(t, s.DeleteNSXServiceAccount(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, obj.UID))
+		assert.Equal(t, []string{fmt.Sprintf(roleBindingURL, "rb1")}, rest.deletes)
+	})
+
+	t.Run("DeleteKeepsRoleBindingOfRecreated", func(t *testing.T) {
+		ctx := context.TODO()
+		s, obj, rest, patches := setUp(t, ctx, issuer)
+		defer patches.Reset()
+		rest.roleBindings = []roleBinding{{ID: "rb1", Name: user, Type: roleBindingTypeRemoteUser,
+			Tags: []roleBindingTag{{Scope: tagScopeNSXServiceAccountCRUID, Tag: "00000000-0000-0000-0000-000000000002"}}}}
+		require.NoError(t, s.Client.Delete(ctx, obj))
+
+		require.NoError(t, s.DeleteNSXServiceAccount(ctx, types.NamespacedName{Namespace: "ns1", Name: "name1"}, obj.UID))
+		assert.Empty(t, rest.deletes)
+	})
+}
@@ -0,0 +1,266 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package realizestate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	// realizationPollInterval is the interval to poll the realized state of the watched intent paths.
+	realizationPollInterval = 2 * time.Second
+	// realizationPollWorkers is the number of the VPCs or the intent paths polled concurrently.
+	realizationPollWorkers = 8
+	// RealizationTimeout is the time to wait for an intent path to be realized, it is about the same as the
+	// blocking check with util.NSXTRealizeRetry.
+	RealizationTimeout = time.Minute
+	// realizationResultTTL is the time to keep the result which is not consumed by the owner, e.g. the owner CR is
+	// deleted before it is requeued.
+	realizationResultTTL = 10 * time.Minute
+)
+
+const (
+	// RealizationKindPod is the kind of the Pods owning the SubnetPorts, which are also owned by the SubnetPort CRs.
+	RealizationKindPod = "Pod"
+	// RealizationKindSubnetSet is the kind of the SubnetSets owning the Subnets, which are also owned by the Subnet
+	// CRs.
+	RealizationKindSubnetSet = "SubnetSet"
+)
+
+// RealizationOwner is the CR owning the NSX resource of an intent path, Kind is the NSX resource type the
+// handler is registered with, or the RealizationKind of the owner if more than one kind of CRs own the NSX resource
+// type.
+type RealizationOwner struct {
+	Kind string
+	types.NamespacedName
+}
+
+// RealizationHandler is called with the owner when the intent path it watches is realized, realized with errors or
+// not realized in time.
+type RealizationHandler func(owner types.NamespacedName)
+
+// realizationEntry is an intent path being watched.
+type realizationEntry struct {
+	service    *RealizeStateService
+	intentPath string
+	extraIds   []string
+	owners     []RealizationOwner
+	deadline   time.Time
+	// done is set with the result err once the intent path is realized, realized with errors or timed out.
+	done     bool
+	err      error
+	finished time.Time
+}
+
+func (e *realizationEntry) addOwner(owner RealizationOwner) {
+	if !slices.Contains(e.owners, owner) {
+		e.owners = append(e.owners, owner)
+	}
+}
+
+// RealizationWatcher tracks the intent paths not realized yet and polls their realized state in the background, so
+// that the reconcilers return at once instead of blocking the workers while NSX realizes. The owner CRs are
+// requeued by the registered handlers when the result is known, and get the result with the next check.
+type RealizationWatcher struct {
+	lock      sync.Mutex
+	entries   map[string]*realizationEntry
+	handlers  map[string][]RealizationHandler
+	startOnce sync.Once
+}
+
+var defaultRealizationWatcher = newRealizationWatcher()
+
+func newRealizationWatcher() *RealizationWatcher {
+	return &RealizationWatcher{
+		entries:  make(map[string]*realizationEntry),
+		handlers: make(map[string][]RealizationHandler),
+	}
+}
+
+// RegisterRealizationHandler registers the handler to be called for the owners of the kind.
+func RegisterRealizationHandler(kind string, handler RealizationHandler) {
+	defaultRealizationWatcher.lock.Lock()
+	defer defaultRealizationWatcher.lock.Unlock()
+	defaultRealizationWatcher.handlers[kind] = append(defaultRealizationWatcher.handlers[kind], handler)
+}
+
+// StartRealizationWatcher polls the watched intent paths until stopCh is closed. It only starts once, the following
+// calls are ignored.
+func StartRealizationWatcher(stopCh <-chan struct{}) {
+	defaultRealizationWatcher.startOnce.Do(func() {
+		log.Info("Starting realization watcher", "interval", realizationPollInterval)
+		go wait.Until(defaultRealizationWatcher.poll, realizationPollInterval, stopCh)
+	})
+}
+
+// ForgetRealizeState drops the watched intent path and its result, it should be called after the NSX resource is
+// updated so that the result of the previous version is not returned.
+func ForgetRealizeState(intentPath string) {
+	defaultRealizationWatcher.forget(intentPath)
+}
+
+// CheckRealizeStateAsync checks the realize status of intentPath without waiting. nil is returned if it is realized,
+// and the RealizeStateError if it is realized with errors. Otherwise, the intent path is watched for the owner and a
+// RealizationPendingError is returned, the owner is requeued when it is realized, realized with errors or not
+// realized in time, and the result is returned by the next check.
+func (service *RealizeStateService) CheckRealizeStateAsync(intentPath string, extraIds []string, owner RealizationOwner) error {
+	return defaultRealizationWatcher.check(service, intentPath, extraIds, owner, time.Now())
+}
+
+// CheckWatchedRealizeState checks the realize status of intentPath like CheckRealizeStateAsync if it is watched,
+// watched is false and NSX is not checked if it is not watched.
+func CheckWatchedRealizeState(intentPath string, owner RealizationOwner) (watched bool, err error) {
+	return defaultRealizationWatcher.checkWatched(intentPath, owner)
+}
+
+func (w *RealizationWatcher) checkWatched(intentPath string, owner RealizationOwner) (bool, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	entry, found := w.entries[intentPath]
+	if !found {
+		return false, nil
+	}
+	if entry.done {
+		delete(w.entries, intentPath)
+		return true, entry.err
+	}
+	entry.addOwner(owner)
+	return true, nsxutil.NewRealizationPendingError(fmt.Sprintf("%s is being realized", intentPath))
+}
+
+func (w *RealizationWatcher) check(service *RealizeStateService, intentPath string, extraIds []string, owner RealizationOwner, now time.Time) error {
+	if watched, err := w.checkWatched(intentPath, owner); watched {
+		return err
+	}
+
+	err := service.checkRealizeState(intentPath, extraIds)
+	if err == nil || nsxutil.IsRealizeStateError(err) {
+		return err
+	}
+	log.Debug("Watching intent path not realized yet", "intentPath", intentPath, "owner", owner, "reason", err.Error())
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	entry, found := w.entries[intentPath]
+	if !found {
+		entry = &realizationEntry{
+			service:    service,
+			intentPath: intentPath,
+			extraIds:   extraIds,
+			deadline:   now.Add(RealizationTimeout),
+		}
+		w.entries[intentPath] = entry
+	}
+	entry.addOwner(owner)
+	return nsxutil.NewRealizationPendingError(fmt.Sprintf("%s is being realized", intentPath))
+}
+
+func (w *RealizationWatcher) forget(intentPath string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	delete(w.entries, intentPath)
+}
+
+func (w *RealizationWatcher) poll() {
+	w.pollAt(time.Now())
+}
+
+// pollAt checks the realized state of all the pending intent paths, and notifies the owners of the ones with the
+// result known. The results not consumed in realizationResultTTL are dropped.
+func (w *RealizationWatcher) pollAt(now time.Time) {
+	w.lock.Lock()
+	var pending []*realizationEntry
+	for intentPath, entry := range w.entries {
+		if !entry.done {
+			pending = append(pending, entry)
+		} else if now.Sub(entry.finished) > realizationResultTTL {
+			delete(w.entries, intentPath)
+		}
+	}
+	w.lock.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	results := checkPending(pending)
+	for i, entry := range pending {
+		err := results[i]
+		if err != nil && !nsxutil.IsRealizeStateError(err) {
+			if now.Before(entry.deadline) {
+				continue
+			}
+			err = fmt.Errorf("%s not realized in %s: %w", entry.intentPath, RealizationTimeout, err)
+		}
+		w.lock.Lock()
+		// Skip the entry forgotten or replaced during the poll.
+		if w.entries[entry.intentPath] != entry {
+			w.lock.Unlock()
+			continue
+		}
+		entry.done, entry.err, entry.finished = true, err, now
+		owners := slices.Clone(entry.owners)
+		w.lock.Unlock()
+		log.Info("Realization of intent path finished", "intentPath", entry.intentPath, "owners", owners, "error", err)
+		for _, owner := range owners {
+			w.notify(owner)
+		}
+	}
+}
+
+// checkPending returns the realized state of the pending entries. The entries under the same VPC are checked with one
+// search of the realized entities in the VPC, the others are checked one by one.
+func checkPending(pending []*realizationEntry) []error {
+	var batches [][]int
+	batchOfVPC := make(map[string]int)
+	for i, entry := range pending {
+		vpcPath := vpcPathOf(entry.intentPath)
+		if b, found := batchOfVPC[vpcPath]; found && vpcPath != "" {
+			batches[b] = append(batches[b], i)
+			continue
+		}
+		if vpcPath != "" {
+			batchOfVPC[vpcPath] = len(batches)
+		}
+		batches = append(batches, []int{i})
+	}
+
+	results := make([]error, len(pending))
+	workqueue.ParallelizeUntil(context.Background(), realizationPollWorkers, len(batches), func(b int) {
+		batch := batches[b]
+		if len(batch) > 1 {
+			first := pending[batch[0]]
+			vpcPath := vpcPathOf(first.intentPath)
+			entities, err := first.service.listRealizedEntitiesInVPC(vpcPath)
+			if err == nil {
+				for _, i := range batch {
+					results[i] = realizeStateOf(pending[i].intentPath, pending[i].extraIds, entities[pending[i].intentPath])
+				}
+				return
+			}
+			log.Error(err, "Failed to search realized entities, checking the intent paths one by one", "vpcPath", vpcPath)
+		}
+		for _, i := range batch {
+			results[i] = pending[i].service.checkRealizeState(pending[i].intentPath, pending[i].extraIds)
+		}
+	})
+	return results
+}
+
+func (w *RealizationWatcher) notify(owner RealizationOwner) {
+	w.lock.Lock()
+	handlers := slices.Clone(w.handlers[owner.Kind])
+	w.lock.Unlock()
+	for _, handler := range handlers {
+		handler(owner.NamespacedName)
+	}
+}
@@ -0,0 +1,229 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package realizestate
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+// fakeWatchedEntitiesClient returns the realized state set for each intent path, no result is returned for the
+// intent path without state.
+type fakeWatchedEntitiesClient struct {
+	lock   sync.Mutex
+	states map[string]string
+	calls  map[string]int
+}
+
+func (c *fakeWatchedEntitiesClient) List(intentPathParam string, _ *string) (model.GenericPolicyRealizedResourceListResult, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls[intentPathParam]++
+	state, found := c.states[intentPathParam]
+	if !found {
+		return model.GenericPolicyRealizedResourceListResult{}, nil
+	}
+	return model.GenericPolicyRealizedResourceListResult{
+		Results: []model.GenericPolicyRealizedResource{{Id: common.String("default"), State: common.String(state)}},
+	}, nil
+}
+
+func (c *fakeWatchedEntitiesClient) setState(intentPath, state string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.states[intentPath] = state
+}
+
+func TestRealizationWatcher(t *testing.T) {
+	client := &fakeWatchedEntitiesClient{states: make(map[string]string), calls: make(map[string]int)}
+	service := &RealizeStateService{Service: common.Service{NSXClient: &nsx.Client{RealizedEntitiesClient: client}}}
+	watcher := newRealizationWatcher()
+	var notified []types.NamespacedName
+	watcher.handlers[common.ResourceTypeStaticRoutes] = []RealizationHandler{func(owner types.NamespacedName) {
+		notified = append(notified, owner)
+	}}
+	owner := RealizationOwner{Kind: common.ResourceTypeStaticRoutes, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "sr1"}}
+	now := time.Now()
+
+	t.Run("return at once if realized", func(t *testing.T) {
+		client.setState("/realized", model.GenericPolicyRealizedResource_STATE_REALIZED)
+		assert.NoError(t, watcher.check(service, "/realized", nil, owner, now))
+		client.setState("/error", model.GenericPolicyRealizedResource_STATE_ERROR)
+		assert.True(t, nsxutil.IsRealizeStateError(watcher.check(service, "/error", nil, owner, now)))
+		assert.Empty(t, watcher.entries)
+	})
+
+	t.Run("requeue owner when realized", func(t *testing.T) {
+		notified = nil
+		client.setState("/pending", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		err := watcher.check(service, "/pending", nil, owner, now)
+		assert.True(t, nsxutil.IsRealizationPendingError(err))
+		// The owner checking again before the result is known is still pending.
+		err = watcher.check(service, "/pending", nil, owner, now)
+		assert.True(t, nsxutil.IsRealizationPendingError(err))
+		require.Len(t, watcher.entries["/pending"].owners, 1)
+
+		watcher.pollAt(now.Add(realizationPollInterval))
+		assert.Empty(t, notified)
+
+		client.setState("/pending", model.GenericPolicyRealizedResource_STATE_REALIZED)
+		watcher.pollAt(now.Add(2 * realizationPollInterval))
+		assert.Equal(t, []types.NamespacedName{owner.NamespacedName}, notified)
+		calls := client.calls["/pending"]
+		// The result is consumed by the requeued owner without checking NSX.
+		assert.NoError(t, watcher.check(service, "/pending", nil, owner, now))
+		assert.Equal(t, calls, client.calls["/pending"])
+		assert.Empty(t, watcher.entries)
+	})
+
+	t.Run("requeue owner when realized with errors", func(t *testing.T) {
+		notified = nil
+		client.setState("/failed", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, "/failed", nil, owner, now)))
+		client.setState("/failed", model.GenericPolicyRealizedResource_STATE_ERROR)
+		watcher.pollAt(now.Add(realizationPollInterval))
+		assert.Len(t, notified, 1)
+		assert.True(t, nsxutil.IsRealizeStateError(watcher.check(service, "/failed", nil, owner, now)))
+	})
+
+	t.Run("requeue owner when not realized in time", func(t *testing.T) {
+		notified = nil
+		client.setState("/timeout", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, "/timeout", nil, owner, now)))
+		watcher.pollAt(now.Add(RealizationTimeout))
+		assert.Len(t, notified, 1)
+		err := watcher.check(service, "/timeout", nil, owner, now)
+		assert.ErrorContains(t, err, "/timeout not realized in")
+		assert.False(t, nsxutil.IsRealizationPendingError(err))
+	})
+
+	t.Run("drop forgotten and expired results", func(t *testing.T) {
+		client.setState("/forgotten", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, "/forgotten", nil, owner, now)))
+		watcher.forget("/forgotten")
+		assert.Empty(t, watcher.entries)
+
+		client.setState("/expired", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, "/expired", nil, owner, now)))
+		client.setState("/expired", model.GenericPolicyRealizedResource_STATE_REALIZED)
+		watcher.pollAt(now)
+		require.True(t, watcher.entries["/expired"].done)
+		watcher.pollAt(now.Add(realizationResultTTL + time.Second))
+		assert.Empty(t, watcher.entries)
+	})
+}
+
+func TestRealizationWatcherPollByVPC(t *testing.T) {
+	client := &fakeWatchedEntitiesClient{states: make(map[string]string), calls: make(map[string]int)}
+	service := &RealizeStateService{Service: common.Service{NSXClient: &nsx.Client{RealizedEntitiesClient: client}}}
+	watcher := newRealizationWatcher()
+	notified := sets.New[string]()
+	watcher.handlers[common.ResourceTypeSubnetPort] = []RealizationHandler{func(owner types.NamespacedName) {
+		notified.Insert(owner.Name)
+	}}
+	vpcPath := "/orgs/default/projects/p1/vpcs/vpc-1"
+	portPaths := []string{vpcPath + "/subnets/subnet-1/ports/port-1", vpcPath + "/subnets/subnet-1/ports/port-2"}
+	now := time.Now()
+	for i, portPath := range portPaths {
+		client.setState(portPath, model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+		owner := RealizationOwner{Kind: common.ResourceTypeSubnetPort, NamespacedName: types.NamespacedName{Namespace: "ns", Name: fmt.Sprintf("port-%d", i+1)}}
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, portPath, nil, owner, now)))
+	}
+
+	var queries []string
+	var searchErr error
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&service.Service), "SearchResource",
+		func(_ *common.Service, _ string, query string, store common.Store, _ common.Filter) (uint64, error) {
+			queries = append(queries, query)
+			if searchErr != nil {
+				return 0, searchErr
+			}
+			entities := []model.GenericPolicyRealizedResource{
+				{Id: common.String("default"), State: common.String(model.GenericPolicyRealizedResource_STATE_REALIZED), IntentPaths: []string{portPaths[0]}},
+				{Id: common.String("default"), State: common.String(model.GenericPolicyRealizedResource_STATE_UNREALIZED), IntentPaths: []string{portPaths[1]}},
+			}
+			for _, entity := range entities {
+				dataValue, errs := common.NewConverter().ConvertToVapi(entity, model.GenericPolicyRealizedResourceBindingType())
+				require.Empty(t, errs)
+				if err := store.TransResourceToStore(dataValue.(*data.StructValue)); err != nil {
+					return 0, err
+				}
+			}
+			return uint64(len(entities)), nil
+		})
+	defer patches.Reset()
+
+	t.Run("poll the intent paths in a VPC with one search", func(t *testing.T) {
+		watcher.pollAt(now.Add(realizationPollInterval))
+		require.Len(t, queries, 1)
+		assert.Equal(t, "resource_type:GenericPolicyRealizedResource AND intent_paths:\\/orgs\\/default\\/projects\\/p1\\/vpcs\\/vpc-1*", queries[0])
+		assert.Equal(t, sets.New("port-1"), notified)
+		// The intent paths are not listed one by one after they are watched.
+		for _, portPath := range portPaths {
+			assert.Equal(t, 1, client.calls[portPath])
+		}
+	})
+
+	t.Run("check the intent paths one by one if the search fails", func(t *testing.T) {
+		searchErr = fmt.Errorf("search failed")
+		client.setState(portPaths[1], model.GenericPolicyRealizedResource_STATE_REALIZED)
+		assert.NoError(t, watcher.check(service, portPaths[0], nil, RealizationOwner{}, now))
+		assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, portPaths[0], nil,
+			RealizationOwner{Kind: common.ResourceTypeSubnetPort, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "port-1"}}, now)))
+		watcher.pollAt(now.Add(2 * realizationPollInterval))
+		assert.Len(t, queries, 2)
+		assert.True(t, notified.Has("port-2"))
+		assert.Equal(t, 2, client.calls[portPaths[1]])
+	})
+}
+
+func TestVPCPathOf(t *testing.T) {
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc-1", vpcPathOf("/orgs/default/projects/p1/vpcs/vpc-1/subnets/subnet-1"))
+	assert.Equal(t, "/orgs/default/projects/p1/vpcs/vpc-1", vpcPathOf("/orgs/default/projects/p1/vpcs/vpc-1"))
+	assert.Empty(t, vpcPathOf("/infra/domains/default/security-policies/sp-1"))
+}
+
+func TestCheckWatchedRealizeState(t *testing.T) {
+	client := &fakeWatchedEntitiesClient{states: make(map[string]string), calls: make(map[string]int)}
+	service := &RealizeStateService{Service: common.Service{NSXClient: &nsx.Client{RealizedEntitiesClient: client}}}
+	watcher := newRealizationWatcher()
+	owner := RealizationOwner{Kind: RealizationKindSubnetSet, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "subnetset-1"}}
+	portOwner := RealizationOwner{Kind: common.ResourceTypeSubnetPort, NamespacedName: types.NamespacedName{Namespace: "ns", Name: "port-1"}}
+	now := time.Now()
+
+	watched, err := watcher.checkWatched("/subnet", owner)
+	assert.False(t, watched)
+	assert.NoError(t, err)
+	assert.Empty(t, client.calls)
+
+	client.setState("/subnet", model.GenericPolicyRealizedResource_STATE_UNREALIZED)
+	assert.True(t, nsxutil.IsRealizationPendingError(watcher.check(service, "/subnet", nil, owner, now)))
+	watched, err = watcher.checkWatched("/subnet", portOwner)
+	assert.True(t, watched)
+	assert.True(t, nsxutil.IsRealizationPendingError(err))
+	assert.Equal(t, []RealizationOwner{owner, portOwner}, watcher.entries["/subnet"].owners)
+	assert.Equal(t, 1, client.calls["/subnet"])
+
+	client.setState("/subnet", model.GenericPolicyRealizedResource_STATE_ERROR)
+	watcher.pollAt(now.Add(realizationPollInterval))
+	watched, err = watcher.checkWatched("/subnet", owner)
+	assert.True(t, watched)
+	assert.True(t, nsxutil.IsRealizeStateError(err))
+	assert.Empty(t, watcher.entries)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
@@ -18,6 +19,9 @@ import (
 
 var log = logger.Log
 
+// resourceTypeRealizedEntity is the search resource type of the realized entities.
+const resourceTypeRealizedEntity = "GenericPolicyRealizedResource"
+
 type RealizeStateService struct {
 	common.Service
 }
@@ -37,53 +41,113 @@ func (service *RealizeStateService) CheckRealizeState(backoff wait.Backoff, inte
 		// Won't retry when realized state is `ERROR`.
 		return !nsxutil.IsRealizeStateError(err)
 	}, func() error {
-		results, err := service.NSXClient.RealizedEntitiesClient.List(intentPath, nil)
-		err = nsxutil.TransNSXApiError(err)
-		if err != nil {
-			return err
-		}
-		entitiesRealized := 0
-		extraIdsRealized := 0
-		for _, result := range results.Results {
-			if *result.State == model.GenericPolicyRealizedResource_STATE_REALIZED {
-				for _, id := range extraIds {
-					if *result.Id == id {
-						extraIdsRealized++
-					}
+		return service.checkRealizeState(intentPath, extraIds)
+	})
+}
+
+// checkRealizeState checks the realize status of intentPath once, an error is returned if it is not realized yet.
+func (service *RealizeStateService) checkRealizeState(intentPath string, extraIds []string) error {
+	results, err := service.NSXClient.RealizedEntitiesClient.List(intentPath, nil)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		return err
+	}
+	return realizeStateOf(intentPath, extraIds, results.Results)
+}
+
+// realizeStateOf returns the realize status of intentPath by its realized entities.
+func realizeStateOf(intentPath string, extraIds []string, results []model.GenericPolicyRealizedResource) error {
+	entitiesRealized := 0
+	extraIdsRealized := 0
+	for _, result := range results {
+		if *result.State == model.GenericPolicyRealizedResource_STATE_REALIZED {
+			for _, id := range extraIds {
+				if *result.Id == id {
+					extraIdsRealized++
 				}
-				entitiesRealized++
-				continue
 			}
-			if *result.State == model.GenericPolicyRealizedResource_STATE_ERROR {
-				log.Error(nil, "Found realized state with error", "result", result)
-				var errMsg []string
-				for _, alarm := range result.Alarms {
-					if alarm.Message != nil {
-						errMsg = append(errMsg, *alarm.Message)
-					}
-					if alarm.ErrorDetails != nil {
-						for _, relatedErr := range alarm.ErrorDetails.RelatedErrors {
-							if relatedErr.ErrorMessage != nil {
-								errMsg = append(errMsg, *relatedErr.ErrorMessage)
-							}
+			entitiesRealized++
+			continue
+		}
+		if *result.State == model.GenericPolicyRealizedResource_STATE_ERROR {
+			log.Error(nil, "Found realized state with error", "result", result)
+			var errMsg []string
+			for _, alarm := range result.Alarms {
+				if alarm.Message != nil {
+					errMsg = append(errMsg, *alarm.Message)
+				}
+				if alarm.ErrorDetails != nil {
+					for _, relatedErr := range alarm.ErrorDetails.RelatedErrors {
+						if relatedErr.ErrorMessage != nil {
+							errMsg = append(errMsg, *relatedErr.ErrorMessage)
 						}
 					}
-					if nsxutil.IsRetryRealizeError(alarm) {
-						return nsxutil.NewRetryRealizeError(fmt.Sprintf("%s not realized with errors: %s", intentPath, errMsg))
-					}
-					if nsxutil.IsIPAllocationError(alarm) {
-						return nsxutil.NewRealizeStateError(fmt.Sprintf("%s realized with errors: %s", intentPath, errMsg), nsxutil.IPAllocationErrorCode)
-					}
 				}
-				return nsxutil.NewRealizeStateError(fmt.Sprintf("%s realized with errors: %s", intentPath, errMsg), 0)
+				if nsxutil.IsRetryRealizeError(alarm) {
+					return nsxutil.NewRetryRealizeError(fmt.Sprintf("%s not realized with errors: %s", intentPath, errMsg))
+				}
+				if nsxutil.IsIPAllocationError(alarm) {
+					return nsxutil.NewRealizeStateError(fmt.Sprintf("%s realized with errors: %s", intentPath, errMsg), nsxutil.IPAllocationErrorCode)
+				}
 			}
+			return nsxutil.NewRealizeStateError(fmt.Sprintf("%s realized with errors: %s", intentPath, errMsg), 0)
 		}
-		// extraIdsRealized can be greater than extraIds length as id is not unique in result list.
-		if len(results.Results) != 0 && entitiesRealized == len(results.Results) && extraIdsRealized >= len(extraIds) {
-			return nil
-		}
-		return fmt.Errorf("%s not realized", intentPath)
-	})
+	}
+	// extraIdsRealized can be greater than extraIds length as id is not unique in result list.
+	if len(results) != 0 && entitiesRealized == len(results) && extraIdsRealized >= len(extraIds) {
+		return nil
+	}
+	return fmt.Errorf("%s not realized", intentPath)
+}
+
+// realizedEntityCollector collects the realized entities of a search by their intent paths.
+type realizedEntityCollector struct {
+	common.SearchCollector
+	entities map[string][]model.GenericPolicyRealizedResource
+}
+
+func (c *realizedEntityCollector) TransResourceToStore(entity *data.StructValue) error {
+	obj, errs := common.NewConverter().ConvertToGolang(entity, model.GenericPolicyRealizedResourceBindingType())
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	realized := obj.(model.GenericPolicyRealizedResource)
+	if realized.State == nil {
+		return nil
+	}
+	for _, intentPath := range realized.IntentPaths {
+		c.entities[intentPath] = append(c.entities[intentPath], realized)
+	}
+	return nil
+}
+
+// listRealizedEntitiesInVPC returns the realized entities of the intent paths under vpcPath by intent path with one
+// search, instead of listing them for each intent path.
+func (service *RealizeStateService) listRealizedEntitiesInVPC(vpcPath string) (map[string][]model.GenericPolicyRealizedResource, error) {
+	collector := &realizedEntityCollector{entities: make(map[string][]model.GenericPolicyRealizedResource)}
+	query := fmt.Sprintf("%s:%s AND intent_paths:%s*", common.ResourceType, resourceTypeRealizedEntity,
+		strings.ReplaceAll(vpcPath, "/", "\\/"))
+	if _, err := service.SearchResource(resourceTypeRealizedEntity, query, collector, nil); err != nil {
+		return nil, err
+	}
+	return collector.entities, nil
+}
+
+// vpcPathOf returns the path of the VPC the intent path is under, it is empty if the intent path is not a VPC
+// resource.
+func vpcPathOf(intentPath string) string {
+	idx := strings.Index(intentPath, "/vpcs/")
+	if idx < 0 {
+		return ""
+	}
+	rest := intentPath[idx+len("/vpcs/"):]
+	if end := strings.Index(rest, "/"); end >= 0 {
+		rest = rest[:end]
+	}
+	if rest == "" {
+		return ""
+	}
+	return intentPath[:idx+len("/vpcs/")] + rest
 }
 
 // GetPolicyInterfaceIPs returns all bare IPs (with CIDR prefix stripped) from the
@@ -241,12 +241,19 @@ func (service *SecurityPolicyService) CreateOrUpdateSecurityPolicy(obj interface
 		if err != nil {
 			return err
 		}
+		// The isolation section is still created or updated when the allow section is being realized.
+		var realizeErr error
 		for _, internalSecurityPolicy := range internalSecurityPolicies {
 			err = service.createOrUpdateVPCSecurityPolicy(internalSecurityPolicy, common.ResourceTypeNetworkPolicy)
+			if nsxutil.IsRealizationPendingError(err) {
+				realizeErr = err
+				continue
+			}
 			if err != nil {
 				return err
 			}
 		}
+		return realizeErr
 	case *v1alpha1.SecurityPolicy:
 		if IsVPCEnabled(service) {
 			err = service.createOrUpdateVPCSecurityPolicy(obj, common.ResourceTypeSecurityPolicy)
@@ -643,17 +650,24 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	finalRules := finalSecurityPolicy.Rules
 
 	if !isChanged && len(finalSecurityPolicy.Rules) == 0 && len(finalGroups) == 0 && len(finalShares) == 0 {
-		log.Info("SecurityPolicy, rules, groups and shares are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
-		return nil
+		// The stores are updated before the SecurityPolicy is realized, so the realization result is consumed here
+		// when the CR is requeued, and the SecurityPolicy realized with errors is patched again.
+		err = service.checkWatchedSecurityPolicyRealizationState(finalSecurityPolicy)
+		if err == nil || nsxutil.IsRealizationPendingError(err) {
+			log.Info("SecurityPolicy, rules, groups and shares are not changed, skip updating them", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
+			return err
+		}
+		log.Error(err, "SecurityPolicy failed to be realized, patch it again", "nsxSecurityPolicyId", finalSecurityPolicy.Id)
 	}
 	if !isDefaultProject {
 		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicy(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
 	} else {
 		finalGetNSXSecurityPolicy, err = service.createOrUpdateNSXSecurityPolicyForDefaultProject(finalSecurityPolicy, finalGroups, finalShares, finalShareGroups, vpcInfo)
 	}
-	if err != nil {
+	if err != nil && !nsxutil.IsRealizationPendingError(err) {
 		return err
 	}
+	realizeErr := err
 
 	err = service.applySecurityPolicyStore(finalGetNSXSecurityPolicy, finalRules, isChanged)
 	if err != nil {
@@ -663,11 +677,24 @@ func (service *SecurityPolicyService) createOrUpdateVPCSecurityPolicy(obj *v1alp
 	if err != nil {
 		return err
 	}
+	if realizeErr != nil {
+		return realizeErr
+	}
 
 	log.Info("Successfully created or updated NSX SecurityPolicy resources in VPC", "nsxSecurityPolicy", *finalGetNSXSecurityPolicy)
 	return nil
 }
 
+// checkWatchedSecurityPolicyRealizationState returns the realization result of the unchanged SecurityPolicy if it is
+// watched, NSX is not checked otherwise.
+func (service *SecurityPolicyService) checkWatchedSecurityPolicyRealizationState(sp *model.SecurityPolicy) error {
+	if sp.Path == nil {
+		return nil
+	}
+	_, err := realizestate.CheckWatchedRealizeState(*sp.Path, securityPolicyRealizationOwner(sp))
+	return err
+}
+
 func (service *SecurityPolicyService) DeleteSecurityPolicy(spUid types.UID, isGC bool, createdFor string) error {
 	var err error
 	// For VPC network, SecurityPolicy normal deletion, GC deletion and cleanup
@@ -1076,9 +1103,13 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicy(nsxSecurit
 		return nil, err
 	}
 
-	// Check SecurityPolicy realization state
+	// Check SecurityPolicy realization state, the SecurityPolicy is returned with the RealizationPendingError to
+	// update the stores.
 	err = service.checkSecurityPolicyRealizationState(&nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
 	if err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			return &nsxGetSecurityPolicy, err
+		}
 		return nil, err
 	}
 
@@ -1153,9 +1184,13 @@ func (service *SecurityPolicyService) createOrUpdateNSXSecurityPolicyForDefaultP
 		return nil, err
 	}
 
-	// Check SecurityPolicy realization state
+	// Check SecurityPolicy realization state, the SecurityPolicy is returned with the RealizationPendingError to
+	// update the stores.
 	err = service.checkSecurityPolicyRealizationState(&nsxGetSecurityPolicy, *(nsxGetSecurityPolicy.Path))
 	if err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			return &nsxGetSecurityPolicy, err
+		}
 		return nil, err
 	}
 
@@ -1330,6 +1365,33 @@ func (service *SecurityPolicyService) ListSecurityPolicyByName(ns, name string)
 	return result
 }
 
+// ListSecurityPolicyResourcePaths returns the paths of the NSX SecurityPolicies, Groups and Shares created for the CR
+// with the given UID, indexScope is the UID tag scope of the CR type. The Rules are not listed as they are children of
+// the SecurityPolicies.
+func (service *SecurityPolicyService) ListSecurityPolicyResourcePaths(indexScope string, uid string) []string {
+	var paths []string
+	for _, securityPolicy := range service.securityPolicyStore.GetByIndex(indexScope, uid) {
+		if securityPolicy.Path != nil {
+			paths = append(paths, *securityPolicy.Path)
+		}
+	}
+	for _, groupStore := range []*GroupStore{service.groupStore, service.projectGroupStore, service.infraGroupStore} {
+		for _, group := range groupStore.GetByIndex(indexScope, uid) {
+			if group.Path != nil {
+				paths = append(paths, *group.Path)
+			}
+		}
+	}
+	for _, shareStore := range []*ShareStore{service.projectShareStore, service.infraShareStore} {
+		for _, share := range shareStore.GetByIndex(indexScope, uid) {
+			if share.Path != nil {
+				paths = append(paths, *share.Path)
+			}
+		}
+	}
+	return paths
+}
+
 func (service *SecurityPolicyService) ListNetworkPolicyByName(ns, name string) []*model.SecurityPolicy {
 	var result []*model.SecurityPolicy
 	securityPolicies := service.securityPolicyStore.GetByIndex(common.TagScopeNamespace, ns)
@@ -1368,10 +1430,18 @@ func (service *SecurityPolicyService) getVPCInfo(spNameSpace string) (*common.VP
 	return &vpcInfo[0], nil
 }
 
+// checkSecurityPolicyRealizationState checks the realization state of the patched SecurityPolicy with the realization
+// watcher, a RealizationPendingError is returned if it is being realized.
 func (service *SecurityPolicyService) checkSecurityPolicyRealizationState(sp *model.SecurityPolicy, spPath string) error {
 	log.Trace("Check NSX SecurityPolicy realization state", "nsxSecurityPolicyId", *sp.Id)
+	// The result of the previous version is dropped as the SecurityPolicy is patched.
+	realizestate.ForgetRealizeState(spPath)
 	realizeService := realizestate.InitializeRealizeState(service.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, spPath, []string{}); err != nil {
+	if err := realizeService.CheckRealizeStateAsync(spPath, []string{}, securityPolicyRealizationOwner(sp)); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("NSX SecurityPolicy is being realized", "nsxSecurityPolicyId", *sp.Id)
+			return err
+		}
 		log.Error(err, "Failed to check NSX SecurityPolicy realization state", "nsxSecurityPolicyId", *sp.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created SecurityPolicy is in error realization state", "nsxSecurityPolicyId", *sp.Id)
@@ -1380,3 +1450,16 @@ func (service *SecurityPolicyService) checkSecurityPolicyRealizationState(sp *mo
 	}
 	return nil
 }
+
+// securityPolicyRealizationOwner returns the SecurityPolicy or NetworkPolicy CR the NSX SecurityPolicy is created for,
+// it is requeued when the NSX SecurityPolicy is realized.
+func securityPolicyRealizationOwner(sp *model.SecurityPolicy) realizestate.RealizationOwner {
+	owner := realizestate.RealizationOwner{Kind: common.ResourceTypeSecurityPolicy}
+	owner.Namespace = nsxutil.FindTag(sp.Tags, common.TagScopeNamespace)
+	owner.Name = nsxutil.FindTag(sp.Tags, common.TagValueScopeSecurityPolicyName)
+	if name := nsxutil.FindTag(sp.Tags, common.TagScopeNetworkPolicyName); name != "" {
+		owner.Kind = common.ResourceTypeNetworkPolicy
+		owner.Name = name
+	}
+	return owner
+}
@@ -3437,6 +3437,30 @@ func Test_ListSecurityPolicyByName(t *testing.T) {
 	assert.Len(t, result, 0)
 }
 
+func Test_ListSecurityPolicyResourcePaths(t *testing.T) {
+	common.TagValueScopeSecurityPolicyName = common.TagScopeSecurityPolicyName
+	common.TagValueScopeSecurityPolicyUID = common.TagScopeSecurityPolicyUID
+	fakeService := fakeSecurityPolicyService()
+	fakeService.NSXConfig.EnableVPCNetwork = true
+
+	fakeService.setUpStore(common.TagValueScopeSecurityPolicyUID, false)
+
+	uidTags := func(uid string) []model.Tag {
+		return []model.Tag{{Scope: util.Ptr(common.TagValueScopeSecurityPolicyUID), Tag: util.Ptr(uid)}}
+	}
+	fakeService.securityPolicyStore.Apply(&model.SecurityPolicy{Id: common.String("sp1"), Path: common.String("/vpcs/vpc1/security-policies/sp1"), Tags: uidTags("uid1")})
+	fakeService.securityPolicyStore.Apply(&model.SecurityPolicy{Id: common.String("sp2"), Path: common.String("/vpcs/vpc1/security-policies/sp2"), Tags: uidTags("uid2")})
+	fakeService.groupStore.Apply(&model.Group{Id: common.String("group1"), Path: common.String("/vpcs/vpc1/groups/group1"), Tags: uidTags("uid1")})
+	fakeService.infraGroupStore.Apply(&model.Group{Id: common.String("group2"), Path: common.String("/infra/domains/default/groups/group2"), Tags: uidTags("uid1")})
+	fakeService.infraShareStore.Apply(&model.Share{Id: common.String("share1"), Path: common.String("/infra/shares/share1"), Tags: uidTags("uid1")})
+
+	paths := fakeService.ListSecurityPolicyResourcePaths(common.TagValueScopeSecurityPolicyUID, "uid1")
+	assert.Equal(t, []string{"/vpcs/vpc1/security-policies/sp1", "/vpcs/vpc1/groups/group1", "/infra/domains/default/groups/group2", "/infra/shares/share1"}, paths)
+
+	paths = fakeService.ListSecurityPolicyResourcePaths(common.TagValueScopeSecurityPolicyUID, "nonexistent")
+	assert.Empty(t, paths)
+}
+
 func Test_ListNetworkPolicyByName(t *testing.T) {
 	common.TagValueScopeSecurityPolicyName = common.TagScopeSecurityPolicyName
 	common.TagValueScopeSecurityPolicyUID = common.TagScopeSecurityPolicyUID
@@ -4090,6 +4114,16 @@ func Test_convertNetworkPolicyPeerToSecurityPolicyPeer_IPv6(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "IPv6 IPBlock with IPv4 except",
+			npPeer: &networkingv1.NetworkPolicyPeer{
+				IPBlock: &networkingv1.IPBlock{
+					CIDR:   "::/0",
+					Except: []string{"10.0.0.0/8"},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
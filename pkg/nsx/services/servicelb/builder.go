@@ -0,0 +1,247 @@
+package servicelb
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const (
+	// The default NSX LB application profiles used by the Service virtual servers.
+	tcpApplicationProfilePath = "/infra/lb-app-profiles/default-tcp-lb-app-profile"
+	udpApplicationProfilePath = "/infra/lb-app-profiles/default-udp-lb-app-profile"
+
+	ipv6VIPPrefixLength = 128
+)
+
+var (
+	String = common.String
+	Int64  = common.Int64
+	Bool   = common.Bool
+)
+
+func (s *ServiceLBService) buildTags(svc *v1.Service) []model.Tag {
+	return util.BuildBasicTags(s.NSXConfig.Cluster, svc, "")
+}
+
+// isIPv6Service returns true if the primary IP family of the Service is IPv6.
+func isIPv6Service(svc *v1.Service) bool {
+	return len(svc.Spec.IPFamilies) > 0 && svc.Spec.IPFamilies[0] == v1.IPv6Protocol
+}
+
+// buildIPAllocationID generates the ID of the VpcIpAddressAllocation of the Service. The ID of the released
+// allocation is replaced with a random suffix, as NSX may still be deleting it.
+func buildIPAllocationID(svc *v1.Service, releasedID string) string {
+	return common.BuildUniqueIDWithRandomUUID(svc, util.GenerateIDByObject, func(id string) bool {
+		return id == releasedID
+	})
+}
+
+// buildIPAllocation builds the VpcIpAddressAllocation to allocate the VIP of the Service from
+// the external IP blocks of the VPC. The staticVIP is allocated if it is not empty.
+func (s *ServiceLBService) buildIPAllocation(svc *v1.Service, vpcPath string, staticVIP string, id string) *model.VpcIpAddressAllocation {
+	allocation := &model.VpcIpAddressAllocation{
+		Id:          String(id),
+		DisplayName: String(util.GenerateTruncName(common.MaxNameLength, svc.Name, "", "", "", "")),
+		Path:        String(fmt.Sprintf("%s/%s/%s", vpcPath, common.PolicyResourceVpcIPAddressAllocation.PathKey, id)),
+		ParentPath:  String(vpcPath),
+		Tags:        s.buildTags(svc),
+	}
+	if staticVIP != "" {
+		allocation.AllocationIps = String(staticVIP)
+	}
+	if isIPv6Service(svc) {
+		allocation.IpAddressType = String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6)
+		if staticVIP == "" {
+			allocation.Ipv6AllocationPrefixLength = Int64(ipv6VIPPrefixLength)
+		}
+		return allocation
+	}
+	allocation.IpAddressType = String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4)
+	allocation.IpAddressBlockVisibility = String(model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL)
+	if staticVIP == "" {
+		allocation.AllocationSize = Int64(1)
+	}
+	return allocation
+}
+
+func buildGroupPath(svc *v1.Service, vpcPath string) string {
+	return fmt.Sprintf("%s/%s/%s", vpcPath, common.PolicyResourceGroup.PathKey, util.GenerateIDByObject(svc))
+}
+
+// buildSourceRangesGroup builds the VPC Group with the source ranges of the Service, the Group is referred by the
+// access list of the virtual servers. No Group is needed if the source ranges are not set.
+func (s *ServiceLBService) buildSourceRangesGroup(svc *v1.Service, vpcPath string, sourceRanges []string) *model.Group {
+	if len(sourceRanges) == 0 {
+		return nil
+	}
+	addresses := data.NewListValue()
+	for _, cidr := range sourceRanges {
+		addresses.Add(data.NewStringValue(cidr))
+	}
+	expression := data.NewStructValue(
+		"",
+		map[string]data.DataValue{
+			"resource_type": data.NewStringValue("IPAddressExpression"),
+			"ip_addresses":  addresses,
+		},
+	)
+	return &model.Group{
+		Id:          String(util.GenerateIDByObject(svc)),
+		DisplayName: String(util.GenerateTruncName(common.MaxNameLength, svc.Name, "", "", "", "")),
+		Path:        String(buildGroupPath(svc, vpcPath)),
+		ParentPath:  String(vpcPath),
+		Tags:        s.buildTags(svc),
+		Expression:  []*data.StructValue{expression},
+	}
+}
+
+// buildPortID generates the ID shared by the LBVirtualServer and LBPool of a Service port.
+func buildPortID(svc *v1.Service, port v1.ServicePort) string {
+	return util.GenerateIDByObject(&metav1.ObjectMeta{
+		Name: buildPortName(svc, port),
+		UID:  svc.UID,
+	})
+}
+
+func buildPortName(svc *v1.Service, port v1.ServicePort) string {
+	return fmt.Sprintf("%s-%s-%d", svc.Name, strings.ToLower(string(port.Protocol)), port.Port)
+}
+
+func getApplicationProfilePath(protocol v1.Protocol) (string, bool) {
+	switch protocol {
+	case v1.ProtocolTCP, "":
+		return tcpApplicationProfilePath, true
+	case v1.ProtocolUDP:
+		return udpApplicationProfilePath, true
+	default:
+		return "", false
+	}
+}
+
+// buildPoolMembers returns the ready endpoints of the Service port in the EndpointSlices as the LB pool members.
+func buildPoolMembers(svc *v1.Service, port v1.ServicePort, endpointSlices []discoveryv1.EndpointSlice) []model.LBPoolMember {
+	addressType := discoveryv1.AddressTypeIPv4
+	if isIPv6Service(svc) {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+	protocol := port.Protocol
+	if protocol == "" {
+		protocol = v1.ProtocolTCP
+	}
+	members := make([]model.LBPoolMember, 0)
+	seen := map[string]bool{}
+	for _, slice := range endpointSlices {
+		if slice.AddressType != addressType {
+			continue
+		}
+		var targetPort *int32
+		for _, slicePort := range slice.Ports {
+			sliceProtocol := v1.ProtocolTCP
+			if slicePort.Protocol != nil {
+				sliceProtocol = *slicePort.Protocol
+			}
+			sliceName := ""
+			if slicePort.Name != nil {
+				sliceName = *slicePort.Name
+			}
+			if sliceName == port.Name && sliceProtocol == protocol && slicePort.Port != nil {
+				targetPort = slicePort.Port
+				break
+			}
+		}
+		if targetPort == nil {
+			continue
+		}
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			for _, address := range endpoint.Addresses {
+				memberPort := strconv.Itoa(int(*targetPort))
+				key := address + ":" + memberPort
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				members = append(members, model.LBPoolMember{
+					IpAddress: String(address),
+					Port:      String(memberPort),
+				})
+			}
+		}
+	}
+	// Sort the members to get the stable comparison result.
+	sort.Slice(members, func(i, j int) bool {
+		if *members[i].IpAddress != *members[j].IpAddress {
+			return *members[i].IpAddress < *members[j].IpAddress
+		}
+		return *members[i].Port < *members[j].Port
+	})
+	return members
+}
+
+// buildPoolsAndVirtualServers builds one LBPool and one LBVirtualServer for each Service port. The virtual servers
+// listen on the VIP and forward the traffic to the ready endpoints of the port.
+func (s *ServiceLBService) buildPoolsAndVirtualServers(svc *v1.Service, endpointSlices []discoveryv1.EndpointSlice, vpcPath, lbsPath, vip string, opts *loadBalancerOptions) ([]*model.LBPool, []*model.LBVirtualServer) {
+	pools := make([]*model.LBPool, 0, len(svc.Spec.Ports))
+	vss := make([]*model.LBVirtualServer, 0, len(svc.Spec.Ports))
+	tags := s.buildTags(svc)
+	var accessList *model.LBAccessListControl
+	if len(opts.sourceRanges) > 0 {
+		accessList = &model.LBAccessListControl{
+			Action:    String(model.LBAccessListControl_ACTION_ALLOW),
+			Enabled:   Bool(true),
+			GroupPath: String(buildGroupPath(svc, vpcPath)),
+		}
+	}
+	var persistenceProfilePath *string
+	if opts.persistenceProfilePath != "" {
+		persistenceProfilePath = String(opts.persistenceProfilePath)
+	}
+	for _, port := range svc.Spec.Ports {
+		profilePath, ok := getApplicationProfilePath(port.Protocol)
+		if !ok {
+			log.Info("Skipping unsupported Service port protocol", "Service", svc.Namespace+"/"+svc.Name, "Port", port.Port, "Protocol", port.Protocol)
+			continue
+		}
+		id := buildPortID(svc, port)
+		name := util.GenerateTruncName(common.MaxNameLength, buildPortName(svc, port), "", "", "", "")
+		pool := &model.LBPool{
+			Id:                 String(id),
+			DisplayName:        String(name),
+			Path:               String(fmt.Sprintf("%s/%s/%s", vpcPath, common.PolicyResourceVpcLBPool.PathKey, id)),
+			ParentPath:         String(vpcPath),
+			Tags:               tags,
+			Members:            buildPoolMembers(svc, port, endpointSlices),
+			ActiveMonitorPaths: opts.monitorProfilePaths,
+		}
+		vs := &model.LBVirtualServer{
+			Id:                       String(id),
+			DisplayName:              String(name),
+			Path:                     String(fmt.Sprintf("%s/%s/%s", vpcPath, common.PolicyResourceVpcLBVirtualServer.PathKey, id)),
+			ParentPath:               String(vpcPath),
+			Tags:                     tags,
+			IpAddress:                String(vip),
+			Ports:                    []string{strconv.Itoa(int(port.Port))},
+			PoolPath:                 pool.Path,
+			LbServicePath:            String(lbsPath),
+			ApplicationProfilePath:   String(profilePath),
+			LbPersistenceProfilePath: persistenceProfilePath,
+			AccessListControl:        accessList,
+		}
+		pools = append(pools, pool)
+		vss = append(vss, vs)
+	}
+	return pools, vss
+}
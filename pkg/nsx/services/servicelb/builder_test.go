@@ -0,0 +1,206 @@
+package servicelb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+func createTestService() *ServiceLBService {
+	return &ServiceLBService{
+		Service: common.Service{
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{Cluster: "cluster1"},
+			},
+		},
+		VirtualServerStore: buildVirtualServerStore(),
+		PoolStore:          buildPoolStore(),
+		IPAllocationStore:  buildIPAllocationStore(),
+		GroupStore:         buildGroupStore(),
+	}
+}
+
+func createTestK8sService() *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1", UID: "svc-uid-1"},
+		Spec: v1.ServiceSpec{
+			Type:       v1.ServiceTypeLoadBalancer,
+			IPFamilies: []v1.IPFamily{v1.IPv4Protocol},
+			Ports: []v1.ServicePort{
+				{Name: "http", Protocol: v1.ProtocolTCP, Port: 80},
+				{Name: "dns", Protocol: v1.ProtocolUDP, Port: 53},
+				{Name: "sctp", Protocol: v1.ProtocolSCTP, Port: 9999},
+			},
+		},
+	}
+}
+
+func createTestEndpointSlices() []discoveryv1.EndpointSlice {
+	tcp, udp := v1.ProtocolTCP, v1.ProtocolUDP
+	httpName, dnsName := "http", "dns"
+	httpPort, dnsPort := int32(8080), int32(5353)
+	ready, notReady := true, false
+	return []discoveryv1.EndpointSlice{
+		{
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Ports: []discoveryv1.EndpointPort{
+				{Name: &httpName, Protocol: &tcp, Port: &httpPort},
+				{Name: &dnsName, Protocol: &udp, Port: &dnsPort},
+			},
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+				{Addresses: []string{"10.0.0.1"}},
+				{Addresses: []string{"10.0.0.3"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}},
+			},
+		},
+		{
+			AddressType: discoveryv1.AddressTypeIPv6,
+			Ports:       []discoveryv1.EndpointPort{{Name: &httpName, Protocol: &tcp, Port: &httpPort}},
+			Endpoints:   []discoveryv1.Endpoint{{Addresses: []string{"fd00::1"}}},
+		},
+	}
+}
+
+func TestBuildIPAllocation(t *testing.T) {
+	s := createTestService()
+	svc := createTestK8sService()
+
+	allocation := s.buildIPAllocation(svc, testVPCPath, "", buildIPAllocationID(svc, ""))
+	assert.Equal(t, util.GenerateIDByObject(svc), *allocation.Id)
+	assert.Equal(t, testVPCPath+"/ip-address-allocations/"+*allocation.Id, *allocation.Path)
+	assert.Equal(t, model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4, *allocation.IpAddressType)
+	assert.Equal(t, model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL, *allocation.IpAddressBlockVisibility)
+	assert.Equal(t, int64(1), *allocation.AllocationSize)
+	assert.Contains(t, allocation.Tags, model.Tag{Scope: String(common.TagScopeServiceUID), Tag: String("svc-uid-1")})
+
+	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+	allocation = s.buildIPAllocation(svc, testVPCPath, "192.168.0.20", *allocation.Id)
+	assert.Equal(t, "192.168.0.20", *allocation.AllocationIps)
+	assert.Equal(t, model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL, *allocation.IpAddressBlockVisibility)
+	assert.Nil(t, allocation.AllocationSize)
+
+	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+	allocation = s.buildIPAllocation(svc, testVPCPath, "", *allocation.Id)
+	assert.Equal(t, model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6, *allocation.IpAddressType)
+	assert.Equal(t, int64(ipv6VIPPrefixLength), *allocation.Ipv6AllocationPrefixLength)
+	assert.Nil(t, allocation.IpAddressBlockVisibility)
+	assert.Nil(t, allocation.AllocationSize)
+	assert.Nil(t, allocation.AllocationIps)
+
+	allocation = s.buildIPAllocation(svc, testVPCPath, "fd00::10", *allocation.Id)
+	assert.Equal(t, "fd00::10", *allocation.AllocationIps)
+	assert.Nil(t, allocation.Ipv6AllocationPrefixLength)
+}
+
+func TestBuildIPAllocationID(t *testing.T) {
+	svc := createTestK8sService()
+	id := buildIPAllocationID(svc, "")
+	assert.Equal(t, util.GenerateIDByObject(svc), id)
+
+	// The ID of the released allocation is not reused.
+	newID := buildIPAllocationID(svc, id)
+	assert.NotEqual(t, id, newID)
+	assert.True(t, strings.HasPrefix(newID, svc.Name+"_"))
+	assert.Equal(t, id, buildIPAllocationID(svc, newID))
+}
+
+func TestBuildSourceRangesGroup(t *testing.T) {
+	s := createTestService()
+	svc := createTestK8sService()
+
+	assert.Nil(t, s.buildSourceRangesGroup(svc, testVPCPath, nil))
+
+	group := s.buildSourceRangesGroup(svc, testVPCPath, []string{"10.0.0.0/24", "192.168.1.0/24"})
+	assert.Equal(t, util.GenerateIDByObject(svc), *group.Id)
+	assert.Equal(t, buildGroupPath(svc, testVPCPath), *group.Path)
+	assert.Equal(t, testVPCPath+"/groups/"+*group.Id, *group.Path)
+	assert.Equal(t, testVPCPath, *group.ParentPath)
+	assert.Len(t, group.Expression, 1)
+	resourceType, err := group.Expression[0].Field("resource_type")
+	assert.NoError(t, err)
+	assert.Equal(t, data.NewStringValue("IPAddressExpression"), resourceType)
+	addresses, err := group.Expression[0].Field("ip_addresses")
+	assert.NoError(t, err)
+	assert.Len(t, addresses.(*data.ListValue).List(), 2)
+}
+
+func TestBuildPoolMembers(t *testing.T) {
+	svc := createTestK8sService()
+	endpointSlices := createTestEndpointSlices()
+
+	members := buildPoolMembers(svc, svc.Spec.Ports[0], endpointSlices)
+	assert.Equal(t, []model.LBPoolMember{
+		{IpAddress: String("10.0.0.1"), Port: String("8080")},
+		{IpAddress: String("10.0.0.2"), Port: String("8080")},
+	}, members)
+
+	members = buildPoolMembers(svc, svc.Spec.Ports[1], endpointSlices)
+	assert.Equal(t, []model.LBPoolMember{
+		{IpAddress: String("10.0.0.1"), Port: String("5353")},
+		{IpAddress: String("10.0.0.2"), Port: String("5353")},
+	}, members)
+
+	svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+	members = buildPoolMembers(svc, svc.Spec.Ports[0], endpointSlices)
+	assert.Equal(t, []model.LBPoolMember{{IpAddress: String("fd00::1"), Port: String("8080")}}, members)
+
+	members = buildPoolMembers(svc, svc.Spec.Ports[0], nil)
+	assert.Empty(t, members)
+}
+
+func TestBuildPoolsAndVirtualServers(t *testing.T) {
+	s := createTestService()
+	svc := createTestK8sService()
+	lbsPath := testVPCPath + "/vpc-lbs/default"
+
+	pools, vss := s.buildPoolsAndVirtualServers(svc, createTestEndpointSlices(), testVPCPath, lbsPath, "192.168.0.10", &loadBalancerOptions{})
+	// The SCTP port is skipped.
+	assert.Len(t, pools, 2)
+	assert.Len(t, vss, 2)
+
+	assert.Equal(t, buildPortID(svc, svc.Spec.Ports[0]), *pools[0].Id)
+	assert.Equal(t, testVPCPath+"/vpc-lb-pools/"+*pools[0].Id, *pools[0].Path)
+	assert.Equal(t, "svc1-tcp-80", *pools[0].DisplayName)
+	assert.Len(t, pools[0].Members, 2)
+
+	assert.Equal(t, *pools[0].Id, *vss[0].Id)
+	assert.Equal(t, testVPCPath+"/vpc-lb-virtual-servers/"+*vss[0].Id, *vss[0].Path)
+	assert.Equal(t, "192.168.0.10", *vss[0].IpAddress)
+	assert.Equal(t, []string{"80"}, vss[0].Ports)
+	assert.Equal(t, pools[0].Path, vss[0].PoolPath)
+	assert.Equal(t, lbsPath, *vss[0].LbServicePath)
+	assert.Equal(t, tcpApplicationProfilePath, *vss[0].ApplicationProfilePath)
+
+	assert.Equal(t, "svc1-udp-53", *vss[1].DisplayName)
+	assert.Equal(t, []string{"53"}, vss[1].Ports)
+	assert.Equal(t, udpApplicationProfilePath, *vss[1].ApplicationProfilePath)
+	assert.Nil(t, vss[0].AccessListControl)
+	assert.Nil(t, vss[0].LbPersistenceProfilePath)
+	assert.Empty(t, pools[0].ActiveMonitorPaths)
+
+	opts := &loadBalancerOptions{
+		sourceRanges:           []string{"10.0.0.0/24"},
+		persistenceProfilePath: lbPersistenceProfilePathPrefix + "source-ip",
+		monitorProfilePaths:    []string{lbMonitorProfilePathPrefix + "tcp-monitor"},
+	}
+	pools, vss = s.buildPoolsAndVirtualServers(svc, createTestEndpointSlices(), testVPCPath, lbsPath, "192.168.0.10", opts)
+	for i := range vss {
+		assert.Equal(t, &model.LBAccessListControl{
+			Action:    String(model.LBAccessListControl_ACTION_ALLOW),
+			Enabled:   Bool(true),
+			GroupPath: String(buildGroupPath(svc, testVPCPath)),
+		}, vss[i].AccessListControl)
+		assert.Equal(t, "/infra/lb-persistence-profiles/source-ip", *vss[i].LbPersistenceProfilePath)
+		assert.Equal(t, []string{"/infra/lb-monitor-profiles/tcp-monitor"}, pools[i].ActiveMonitorPaths)
+	}
+}
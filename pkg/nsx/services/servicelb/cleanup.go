@@ -0,0 +1,86 @@
+package servicelb
+
+import (
+	"context"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+// CleanupBeforeVPCDeletion deletes all the LBVirtualServers created for the Services, otherwise they may block
+// the parallel deletion with the LBPools and VpcIpAddressAllocations.
+func (s *ServiceLBService) CleanupBeforeVPCDeletion(ctx context.Context) error {
+	vss := make([]*model.LBVirtualServer, 0)
+	for _, obj := range s.VirtualServerStore.List() {
+		vs := obj.(*model.LBVirtualServer)
+		vs.MarkedForDelete = &MarkedForDelete
+		vss = append(vss, vs)
+	}
+	if len(vss) == 0 {
+		return nil
+	}
+	log.Info("Cleaning up Service LBVirtualServers", "count", len(vss))
+	return s.vsBuilder.PagingUpdateResources(ctx, vss, common.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.LBVirtualServer) {
+		s.VirtualServerStore.DeleteMultipleObjects(deletedObjs)
+	})
+}
+
+// CleanupVPCChildResources deletes all the LBPools, Groups and VpcIpAddressAllocations created for the Services in the
+// given vpcPath on NSX and/or in local cache. If vpcPath is not empty, the function is called with an auto-created VPC
+// case, so it only deletes in the local cache for the NSX resources are already removed when VPC is deleted recursively.
+// Otherwise, it should delete all cached resources on NSX and in local cache.
+func (s *ServiceLBService) CleanupVPCChildResources(ctx context.Context, vpcPath string) error {
+	if vpcPath != "" {
+		for _, store := range []*common.ResourceStore{&s.VirtualServerStore.ResourceStore, &s.PoolStore.ResourceStore, &s.IPAllocationStore.ResourceStore, &s.GroupStore.ResourceStore} {
+			objs, err := store.ByIndex(common.IndexByVPCPathFuncKey, vpcPath)
+			if err != nil {
+				log.Error(err, "Failed to list Service LB resources under the VPC", "path", vpcPath)
+				continue
+			}
+			for _, obj := range objs {
+				if err := store.Delete(obj); err != nil {
+					log.Error(err, "Failed to delete Service LB resource from store", "path", vpcPath)
+				}
+			}
+		}
+		return nil
+	}
+
+	pools := make([]*model.LBPool, 0)
+	for _, obj := range s.PoolStore.List() {
+		pool := obj.(*model.LBPool)
+		pool.MarkedForDelete = &MarkedForDelete
+		pools = append(pools, pool)
+	}
+	log.Info("Cleaning up Service LBPools", "count", len(pools))
+	if err := s.poolBuilder.PagingUpdateResources(ctx, pools, common.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.LBPool) {
+		s.PoolStore.DeleteMultipleObjects(deletedObjs)
+	}); err != nil {
+		return err
+	}
+
+	groups := make([]*model.Group, 0)
+	for _, obj := range s.GroupStore.List() {
+		group := obj.(*model.Group)
+		group.MarkedForDelete = &MarkedForDelete
+		groups = append(groups, group)
+	}
+	log.Info("Cleaning up Service Groups", "count", len(groups))
+	if err := s.groupBuilder.PagingUpdateResources(ctx, groups, common.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.Group) {
+		s.GroupStore.DeleteMultipleObjects(deletedObjs)
+	}); err != nil {
+		return err
+	}
+
+	allocations := make([]*model.VpcIpAddressAllocation, 0)
+	for _, obj := range s.IPAllocationStore.List() {
+		allocation := obj.(*model.VpcIpAddressAllocation)
+		allocation.MarkedForDelete = &MarkedForDelete
+		allocations = append(allocations, allocation)
+	}
+	log.Info("Cleaning up Service VpcIpAddressAllocations", "count", len(allocations))
+	return s.allocationBuilder.PagingUpdateResources(ctx, allocations, common.DefaultHAPIChildrenCount, s.NSXClient, func(deletedObjs []*model.VpcIpAddressAllocation) {
+		s.IPAllocationStore.DeleteMultipleObjects(deletedObjs)
+	})
+}
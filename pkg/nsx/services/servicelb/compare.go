@@ -0,0 +1,156 @@
+package servicelb
+
+import (
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+type (
+	VirtualServer model.LBVirtualServer
+	Pool          model.LBPool
+	Group         model.Group
+)
+
+type Comparable = common.Comparable
+
+func (vs *VirtualServer) Key() string {
+	return *vs.Id
+}
+
+func (vs *VirtualServer) Value() data.DataValue {
+	if vs == nil {
+		return nil
+	}
+	s := &VirtualServer{Id: vs.Id, DisplayName: vs.DisplayName, Tags: vs.Tags, IpAddress: vs.IpAddress, Ports: vs.Ports,
+		PoolPath: vs.PoolPath, LbServicePath: vs.LbServicePath, ApplicationProfilePath: vs.ApplicationProfilePath,
+		LbPersistenceProfilePath: vs.LbPersistenceProfilePath, AccessListControl: vs.AccessListControl}
+	dataValue, _ := ComparableToVirtualServer(s).GetDataValue__()
+	return dataValue
+}
+
+func (p *Pool) Key() string {
+	return *p.Id
+}
+
+func (p *Pool) Value() data.DataValue {
+	if p == nil {
+		return nil
+	}
+	s := &Pool{Id: p.Id, DisplayName: p.DisplayName, Tags: p.Tags, Members: p.Members, ActiveMonitorPaths: p.ActiveMonitorPaths}
+	dataValue, _ := ComparableToPool(s).GetDataValue__()
+	return dataValue
+}
+
+func (g *Group) Key() string {
+	return *g.Id
+}
+
+func (g *Group) Value() data.DataValue {
+	if g == nil {
+		return nil
+	}
+	s := &Group{Id: g.Id, DisplayName: g.DisplayName, Tags: g.Tags, Expression: g.Expression}
+	dataValue, _ := ComparableToGroup(s).GetDataValue__()
+	return dataValue
+}
+
+func VirtualServerToComparable(vs *model.LBVirtualServer) Comparable {
+	return (*VirtualServer)(vs)
+}
+
+func ComparableToVirtualServer(vs Comparable) *model.LBVirtualServer {
+	return (*model.LBVirtualServer)(vs.(*VirtualServer))
+}
+
+func PoolToComparable(p *model.LBPool) Comparable {
+	return (*Pool)(p)
+}
+
+func ComparableToPool(p Comparable) *model.LBPool {
+	return (*model.LBPool)(p.(*Pool))
+}
+
+func GroupToComparable(g *model.Group) Comparable {
+	return (*Group)(g)
+}
+
+func ComparableToGroup(g Comparable) *model.Group {
+	return (*model.Group)(g.(*Group))
+}
+
+// diffVirtualServers returns the expected virtual servers which are changed, and the existing virtual servers which
+// are not expected any more with MarkedForDelete set.
+func diffVirtualServers(existing, expected []*model.LBVirtualServer) ([]*model.LBVirtualServer, []*model.LBVirtualServer) {
+	existingComp := make([]Comparable, 0, len(existing))
+	for _, vs := range existing {
+		existingComp = append(existingComp, VirtualServerToComparable(vs))
+	}
+	expectedComp := make([]Comparable, 0, len(expected))
+	for _, vs := range expected {
+		expectedComp = append(expectedComp, VirtualServerToComparable(vs))
+	}
+	changedComp, staleComp := common.CompareResources(existingComp, expectedComp)
+	changed := make([]*model.LBVirtualServer, 0, len(changedComp))
+	for _, c := range changedComp {
+		changed = append(changed, ComparableToVirtualServer(c))
+	}
+	stale := make([]*model.LBVirtualServer, 0, len(staleComp))
+	for _, c := range staleComp {
+		vs := ComparableToVirtualServer(c)
+		vs.MarkedForDelete = &MarkedForDelete
+		stale = append(stale, vs)
+	}
+	return changed, stale
+}
+
+// diffPools returns the expected pools which are changed, and the existing pools which are not expected any more
+// with MarkedForDelete set.
+func diffPools(existing, expected []*model.LBPool) ([]*model.LBPool, []*model.LBPool) {
+	existingComp := make([]Comparable, 0, len(existing))
+	for _, pool := range existing {
+		existingComp = append(existingComp, PoolToComparable(pool))
+	}
+	expectedComp := make([]Comparable, 0, len(expected))
+	for _, pool := range expected {
+		expectedComp = append(expectedComp, PoolToComparable(pool))
+	}
+	changedComp, staleComp := common.CompareResources(existingComp, expectedComp)
+	changed := make([]*model.LBPool, 0, len(changedComp))
+	for _, c := range changedComp {
+		changed = append(changed, ComparableToPool(c))
+	}
+	stale := make([]*model.LBPool, 0, len(staleComp))
+	for _, c := range staleComp {
+		pool := ComparableToPool(c)
+		pool.MarkedForDelete = &MarkedForDelete
+		stale = append(stale, pool)
+	}
+	return changed, stale
+}
+
+// diffGroups returns the expected groups which are changed, and the existing groups which are not expected any more
+// with MarkedForDelete set.
+func diffGroups(existing, expected []*model.Group) ([]*model.Group, []*model.Group) {
+	existingComp := make([]Comparable, 0, len(existing))
+	for _, group := range existing {
+		existingComp = append(existingComp, GroupToComparable(group))
+	}
+	expectedComp := make([]Comparable, 0, len(expected))
+	for _, group := range expected {
+		expectedComp = append(expectedComp, GroupToComparable(group))
+	}
+	changedComp, staleComp := common.CompareResources(existingComp, expectedComp)
+	changed := make([]*model.Group, 0, len(changedComp))
+	for _, c := range changedComp {
+		changed = append(changed, ComparableToGroup(c))
+	}
+	stale := make([]*model.Group, 0, len(staleComp))
+	for _, c := range staleComp {
+		group := ComparableToGroup(c)
+		group.MarkedForDelete = &MarkedForDelete
+		stale = append(stale, group)
+	}
+	return changed, stale
+}
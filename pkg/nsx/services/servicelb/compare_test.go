@@ -0,0 +1,83 @@
+package servicelb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestDiffPools(t *testing.T) {
+	member := model.LBPoolMember{IpAddress: String("10.0.0.1"), Port: String("8080")}
+	existing := []*model.LBPool{
+		{Id: String("pool-1"), DisplayName: String("pool-1"), Tags: testTags, Members: []model.LBPoolMember{member}},
+		{Id: String("pool-2"), DisplayName: String("pool-2"), Tags: testTags},
+		{Id: String("pool-3"), DisplayName: String("pool-3"), Tags: testTags},
+	}
+	expected := []*model.LBPool{
+		// Unchanged, the realized fields are ignored.
+		{Id: String("pool-1"), DisplayName: String("pool-1"), Tags: testTags, Members: []model.LBPoolMember{member}, Path: String("/path")},
+		// Members changed.
+		{Id: String("pool-2"), DisplayName: String("pool-2"), Tags: testTags, Members: []model.LBPoolMember{member}},
+		// New pool.
+		{Id: String("pool-4"), DisplayName: String("pool-4"), Tags: testTags},
+	}
+	changed, stale := diffPools(existing, expected)
+	assert.ElementsMatch(t, []*model.LBPool{expected[1], expected[2]}, changed)
+	assert.Equal(t, []*model.LBPool{existing[2]}, stale)
+	assert.True(t, *stale[0].MarkedForDelete)
+}
+
+func TestDiffVirtualServers(t *testing.T) {
+	existing := []*model.LBVirtualServer{
+		{Id: String("vs-1"), DisplayName: String("vs-1"), Tags: testTags, IpAddress: String("192.168.0.10"), Ports: []string{"80"}},
+		{Id: String("vs-2"), DisplayName: String("vs-2"), Tags: testTags, IpAddress: String("192.168.0.10"), Ports: []string{"53"}},
+	}
+	expected := []*model.LBVirtualServer{
+		{Id: String("vs-1"), DisplayName: String("vs-1"), Tags: testTags, IpAddress: String("192.168.0.10"), Ports: []string{"8080"}},
+	}
+	changed, stale := diffVirtualServers(existing, expected)
+	assert.Equal(t, expected, changed)
+	assert.Equal(t, []*model.LBVirtualServer{existing[1]}, stale)
+	assert.True(t, *stale[0].MarkedForDelete)
+
+	changed, stale = diffVirtualServers(expected, expected)
+	assert.Empty(t, changed)
+	assert.Empty(t, stale)
+
+	// The access list and persistence profile changes are detected.
+	updated := []*model.LBVirtualServer{
+		{Id: String("vs-1"), DisplayName: String("vs-1"), Tags: testTags, IpAddress: String("192.168.0.10"), Ports: []string{"8080"},
+			LbPersistenceProfilePath: String("/infra/lb-persistence-profiles/source-ip"),
+			AccessListControl:        &model.LBAccessListControl{Action: String(model.LBAccessListControl_ACTION_ALLOW), Enabled: Bool(true), GroupPath: String("/group")}},
+	}
+	changed, stale = diffVirtualServers(expected, updated)
+	assert.Equal(t, updated, changed)
+	assert.Empty(t, stale)
+}
+
+func TestDiffGroups(t *testing.T) {
+	expression := data.NewStructValue("", map[string]data.DataValue{
+		"resource_type": data.NewStringValue("IPAddressExpression"),
+		"ip_addresses":  data.NewListValue(),
+	})
+	existing := []*model.Group{
+		{Id: String("group-1"), DisplayName: String("group-1"), Tags: testTags},
+	}
+	expected := []*model.Group{
+		{Id: String("group-1"), DisplayName: String("group-1"), Tags: testTags, Expression: []*data.StructValue{expression}},
+	}
+	changed, stale := diffGroups(existing, expected)
+	assert.Equal(t, expected, changed)
+	assert.Empty(t, stale)
+
+	changed, stale = diffGroups(expected, expected)
+	assert.Empty(t, changed)
+	assert.Empty(t, stale)
+
+	changed, stale = diffGroups(existing, nil)
+	assert.Empty(t, changed)
+	assert.Equal(t, existing, stale)
+	assert.True(t, *stale[0].MarkedForDelete)
+}
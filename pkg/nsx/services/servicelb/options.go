@@ -0,0 +1,123 @@
+package servicelb
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	stderrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+const (
+	lbPersistenceProfilePathPrefix = "/infra/lb-persistence-profiles/"
+	lbMonitorProfilePathPrefix     = "/infra/lb-monitor-profiles/"
+)
+
+// loadBalancerOptions holds the user-specified settings of the Service load balancer, which are translated from the
+// Service spec and the nsx.vmware.com annotations.
+type loadBalancerOptions struct {
+	// staticVIP is the VIP requested by the user, the VIP is allocated dynamically if it is empty.
+	staticVIP string
+	// sourceRanges are the normalized CIDRs allowed to access the VIP, all the sources are allowed if it is empty.
+	sourceRanges           []string
+	persistenceProfilePath string
+	monitorProfilePaths    []string
+}
+
+// buildLoadBalancerOptions validates and translates the load balancer settings of the Service. A ValidationError is
+// returned if the settings are invalid, so that it can be reported to the user.
+func (s *ServiceLBService) buildLoadBalancerOptions(svc *v1.Service) (*loadBalancerOptions, error) {
+	opts := &loadBalancerOptions{}
+	var err error
+	if opts.staticVIP, err = getStaticVIP(svc); err != nil {
+		return nil, err
+	}
+	if opts.sourceRanges, err = getSourceRanges(svc); err != nil {
+		return nil, err
+	}
+	if id, ok := svc.Annotations[common.AnnotationLBPersistenceProfile]; ok {
+		id = strings.TrimSpace(id)
+		if err := s.validateProfile("persistence", id, s.NSXClient.LbPersistenceProfilesClient.Get); err != nil {
+			return nil, err
+		}
+		opts.persistenceProfilePath = lbPersistenceProfilePathPrefix + id
+	}
+	if ids, ok := svc.Annotations[common.AnnotationLBHealthCheckProfile]; ok {
+		for _, id := range strings.Split(ids, ",") {
+			id = strings.TrimSpace(id)
+			if err := s.validateProfile("monitor", id, s.NSXClient.LbMonitorProfilesClient.Get); err != nil {
+				return nil, err
+			}
+			path := lbMonitorProfilePathPrefix + id
+			if !slices.Contains(opts.monitorProfilePaths, path) {
+				opts.monitorProfilePaths = append(opts.monitorProfilePaths, path)
+			}
+		}
+	}
+	return opts, nil
+}
+
+// getStaticVIP returns the VIP requested with the annotation or spec.loadBalancerIP of the Service, the annotation
+// takes precedence since spec.loadBalancerIP is deprecated.
+func getStaticVIP(svc *v1.Service) (string, error) {
+	vip := svc.Spec.LoadBalancerIP
+	if value := strings.TrimSpace(svc.Annotations[common.AnnotationLBStaticIP]); value != "" {
+		vip = value
+	}
+	if vip == "" {
+		return "", nil
+	}
+	ip := net.ParseIP(vip)
+	if ip == nil {
+		return "", &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid load balancer IP %q", vip)}
+	}
+	if (ip.To4() == nil) != isIPv6Service(svc) {
+		return "", &nsxutil.ValidationError{Desc: fmt.Sprintf("load balancer IP %s doesn't match the IP family of the Service", vip)}
+	}
+	return ip.String(), nil
+}
+
+// getSourceRanges returns the sorted CIDRs in spec.loadBalancerSourceRanges, or in the legacy annotation
+// service.beta.kubernetes.io/load-balancer-source-ranges if the spec field is not set.
+func getSourceRanges(svc *v1.Service) ([]string, error) {
+	ranges := svc.Spec.LoadBalancerSourceRanges
+	if len(ranges) == 0 {
+		if value, ok := svc.Annotations[v1.AnnotationLoadBalancerSourceRangesKey]; ok && strings.TrimSpace(value) != "" {
+			ranges = strings.Split(value, ",")
+		}
+	}
+	cidrs := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(r))
+		if err != nil {
+			return nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid load balancer source range %q", r)}
+		}
+		cidrs = append(cidrs, ipNet.String())
+	}
+	// Sort the CIDRs to get the stable comparison result.
+	slices.Sort(cidrs)
+	return slices.Compact(cidrs), nil
+}
+
+// validateProfile checks the LB profile with the given ID exists under /infra on NSX.
+func (s *ServiceLBService) validateProfile(kind, id string, get func(string) (*data.StructValue, error)) error {
+	if id == "" || strings.Contains(id, "/") {
+		return &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid LB %s profile ID %q", kind, id)}
+	}
+	_, err := get(id)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		if nsxErr, ok := err.(*nsxutil.NSXApiError); ok && nsxErr.Type() == stderrors.ErrorType_NOT_FOUND {
+			return &nsxutil.ValidationError{Desc: fmt.Sprintf("LB %s profile %s not found", kind, id)}
+		}
+		log.Error(err, "Failed to get LB profile", "kind", kind, "ID", id)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package servicelb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+func TestGetStaticVIP(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		specIP      string
+		ipv6        bool
+		expected    string
+		expectedErr string
+	}{
+		{name: "not set"},
+		{name: "spec", specIP: "192.168.0.20", expected: "192.168.0.20"},
+		{name: "annotation takes precedence", specIP: "192.168.0.20", annotations: map[string]string{common.AnnotationLBStaticIP: " 192.168.0.21 "}, expected: "192.168.0.21"},
+		{name: "empty annotation", specIP: "192.168.0.20", annotations: map[string]string{common.AnnotationLBStaticIP: ""}, expected: "192.168.0.20"},
+		{name: "ipv6", specIP: "fd00:0::10", ipv6: true, expected: "fd00::10"},
+		{name: "invalid", specIP: "192.168.0", expectedErr: "invalid load balancer IP \"192.168.0\""},
+		{name: "family mismatch", specIP: "fd00::10", expectedErr: "load balancer IP fd00::10 doesn't match the IP family of the Service"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := createTestK8sService()
+			svc.Annotations = tt.annotations
+			svc.Spec.LoadBalancerIP = tt.specIP
+			if tt.ipv6 {
+				svc.Spec.IPFamilies = []v1.IPFamily{v1.IPv6Protocol}
+			}
+			vip, err := getStaticVIP(svc)
+			if tt.expectedErr != "" {
+				var validationErr *nsxutil.ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, vip)
+		})
+	}
+}
+
+func TestGetSourceRanges(t *testing.T) {
+	svc := createTestK8sService()
+	ranges, err := getSourceRanges(svc)
+	assert.NoError(t, err)
+	assert.Empty(t, ranges)
+
+	// The CIDRs are normalized, sorted and deduplicated.
+	svc.Spec.LoadBalancerSourceRanges = []string{"192.168.1.10/24", "10.0.0.0/8", "192.168.1.0/24"}
+	ranges, err = getSourceRanges(svc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.0/24"}, ranges)
+
+	// The legacy annotation is ignored if the spec field is set.
+	svc.Annotations = map[string]string{v1.AnnotationLoadBalancerSourceRangesKey: "172.16.0.0/16, fd00::/64"}
+	ranges, err = getSourceRanges(svc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.0/24"}, ranges)
+
+	svc.Spec.LoadBalancerSourceRanges = nil
+	ranges, err = getSourceRanges(svc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"172.16.0.0/16", "fd00::/64"}, ranges)
+
+	svc.Annotations[v1.AnnotationLoadBalancerSourceRangesKey] = "172.16.0.0"
+	_, err = getSourceRanges(svc)
+	var validationErr *nsxutil.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.EqualError(t, err, "invalid load balancer source range \"172.16.0.0\"")
+}
+
+func TestBuildLoadBalancerOptions(t *testing.T) {
+	s, _, _, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+
+	opts, err := s.buildLoadBalancerOptions(svc)
+	assert.NoError(t, err)
+	assert.Equal(t, &loadBalancerOptions{sourceRanges: []string{}}, opts)
+
+	svc.Spec.LoadBalancerIP = "192.168.0.20"
+	svc.Spec.LoadBalancerSourceRanges = []string{"10.0.0.0/24"}
+	svc.Annotations = map[string]string{
+		common.AnnotationLBPersistenceProfile: "source-ip",
+		common.AnnotationLBHealthCheckProfile: "tcp-monitor,icmp-monitor,tcp-monitor",
+	}
+	opts, err = s.buildLoadBalancerOptions(svc)
+	assert.NoError(t, err)
+	assert.Equal(t, &loadBalancerOptions{
+		staticVIP:              "192.168.0.20",
+		sourceRanges:           []string{"10.0.0.0/24"},
+		persistenceProfilePath: "/infra/lb-persistence-profiles/source-ip",
+		monitorProfilePaths:    []string{"/infra/lb-monitor-profiles/tcp-monitor", "/infra/lb-monitor-profiles/icmp-monitor"},
+	}, opts)
+
+	svc.Annotations[common.AnnotationLBHealthCheckProfile] = "tcp-monitor,http-monitor"
+	_, err = s.buildLoadBalancerOptions(svc)
+	assert.EqualError(t, err, "LB monitor profile http-monitor not found")
+
+	svc.Annotations[common.AnnotationLBHealthCheckProfile] = "tcp-monitor,"
+	_, err = s.buildLoadBalancerOptions(svc)
+	assert.EqualError(t, err, "invalid LB monitor profile ID \"\"")
+
+	svc.Annotations[common.AnnotationLBPersistenceProfile] = "/infra/lb-persistence-profiles/source-ip"
+	_, err = s.buildLoadBalancerOptions(svc)
+	assert.EqualError(t, err, "invalid LB persistence profile ID \"/infra/lb-persistence-profiles/source-ip\"")
+}
+
+func TestValidateProfile(t *testing.T) {
+	s := createTestService()
+	err := s.validateProfile("monitor", "tcp-monitor", func(string) (*data.StructValue, error) {
+		return nil, errors.New("connection refused")
+	})
+	// The errors other than NOT_FOUND are not validation errors, so the Service is retried.
+	var validationErr *nsxutil.ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+	assert.EqualError(t, err, "connection refused")
+
+	assert.NoError(t, s.validateProfile("monitor", "tcp-monitor", func(string) (*data.StructValue, error) {
+		return data.NewStructValue("", nil), nil
+	}))
+}
@@ -0,0 +1,373 @@
+package servicelb
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/logger"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+var (
+	log                       = logger.Log
+	MarkedForDelete           = true
+	enforceRevisionCheckParam = false
+)
+
+// ServiceLBService realizes the Kubernetes Services of type LoadBalancer with the native NSX VPC load balancer.
+// For each Service, a VIP is allocated from the external IP blocks of the VPC, and one LBPool and one LBVirtualServer
+// are created for each Service port. A Group is created for the access list of the virtual servers if the Service
+// has load balancer source ranges.
+type ServiceLBService struct {
+	common.Service
+	VirtualServerStore *VirtualServerStore
+	PoolStore          *PoolStore
+	IPAllocationStore  *IPAllocationStore
+	GroupStore         *GroupStore
+	VPCService         common.VPCServiceProvider
+	vsBuilder          *common.PolicyTreeBuilder[*model.LBVirtualServer]
+	poolBuilder        *common.PolicyTreeBuilder[*model.LBPool]
+	allocationBuilder  *common.PolicyTreeBuilder[*model.VpcIpAddressAllocation]
+	groupBuilder       *common.PolicyTreeBuilder[*model.Group]
+}
+
+func InitializeServiceLB(service common.Service, vpcService common.VPCServiceProvider) (*ServiceLBService, error) {
+	vsBuilder, _ := common.PolicyPathVpcLBVirtualServer.NewPolicyTreeBuilder()
+	poolBuilder, _ := common.PolicyPathVpcLBPool.NewPolicyTreeBuilder()
+	allocationBuilder, _ := common.PolicyPathVpcIPAddressAllocation.NewPolicyTreeBuilder()
+	groupBuilder, _ := common.PolicyPathVpcGroup.NewPolicyTreeBuilder()
+
+	wg := sync.WaitGroup{}
+	wgDone := make(chan bool)
+	fatalErrors := make(chan error)
+
+	serviceLBService := &ServiceLBService{
+		Service:            service,
+		VirtualServerStore: buildVirtualServerStore(),
+		PoolStore:          buildPoolStore(),
+		IPAllocationStore:  buildIPAllocationStore(),
+		GroupStore:         buildGroupStore(),
+		VPCService:         vpcService,
+		vsBuilder:          vsBuilder,
+		poolBuilder:        poolBuilder,
+		allocationBuilder:  allocationBuilder,
+		groupBuilder:       groupBuilder,
+	}
+
+	// Only the resources tagged with the Service UID are created by this service.
+	serviceTags := []model.Tag{{Scope: String(common.TagScopeServiceUID)}}
+	wg.Add(4)
+	go serviceLBService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeLBVirtualServer, serviceTags, serviceLBService.VirtualServerStore)
+	go serviceLBService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeLBPool, serviceTags, serviceLBService.PoolStore)
+	go serviceLBService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeIPAddressAllocation, serviceTags, serviceLBService.IPAllocationStore)
+	go serviceLBService.InitializeResourceStore(&wg, fatalErrors, common.ResourceTypeGroup, serviceTags, serviceLBService.GroupStore)
+
+	go func() {
+		wg.Wait()
+		close(wgDone)
+	}()
+	select {
+	case <-wgDone:
+		break
+	case err := <-fatalErrors:
+		return serviceLBService, err
+	}
+	return serviceLBService, nil
+}
+
+// CreateOrUpdateLoadBalancer realizes the Service on the LB service of the Namespace VPC, and returns the allocated VIPs.
+// A ValidationError is returned if the load balancer settings of the Service are invalid.
+func (s *ServiceLBService) CreateOrUpdateLoadBalancer(svc *v1.Service, endpointSlices []discoveryv1.EndpointSlice) ([]string, error) {
+	opts, err := s.buildLoadBalancerOptions(svc)
+	if err != nil {
+		return nil, err
+	}
+	vpcInfo, err := common.GetVPCInfoByName(s.VPCService, svc.Namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	vpcPath := vpcInfo.GetVPCPath()
+	lbsPath, err := s.getLBServicePath(vpcInfo)
+	if err != nil {
+		return nil, err
+	}
+	if lbsPath == "" {
+		return nil, nsxutil.NoEffectiveOption{Desc: fmt.Sprintf("no NSX LB service found in VPC %s", vpcPath)}
+	}
+
+	vip, err := s.allocateVIP(svc, vpcInfo, opts.staticVIP)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*model.Group, 0, 1)
+	if group := s.buildSourceRangesGroup(svc, vpcPath, opts.sourceRanges); group != nil {
+		groups = append(groups, group)
+	}
+	changedGroups, staleGroups := diffGroups(s.GroupStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), groups)
+	pools, vss := s.buildPoolsAndVirtualServers(svc, endpointSlices, vpcPath, lbsPath, vip, opts)
+	existingPools := s.PoolStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	existingVSs := s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	changedPools, stalePools := diffPools(existingPools, pools)
+	changedVSs, staleVSs := diffVirtualServers(existingVSs, vss)
+
+	// The pools and groups are created before the virtual servers referring to them, and the stale virtual servers
+	// are deleted before the stale pools and groups.
+	if err := s.applyGroups(changedGroups); err != nil {
+		return nil, err
+	}
+	if err := s.applyPools(changedPools); err != nil {
+		return nil, err
+	}
+	if err := s.applyVirtualServers(append(changedVSs, staleVSs...)); err != nil {
+		return nil, err
+	}
+	if err := s.applyPools(stalePools); err != nil {
+		return nil, err
+	}
+	if err := s.applyGroups(staleGroups); err != nil {
+		return nil, err
+	}
+	log.Info("Successfully realized LoadBalancer Service", "Service", types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}, "VIP", vip)
+	return []string{vip}, nil
+}
+
+// DeleteLoadBalancer deletes the NSX resources created for the Service with the given UID.
+func (s *ServiceLBService) DeleteLoadBalancer(uid types.UID) error {
+	return s.deleteLoadBalancerByIndex(serviceUIDIndexKey, string(uid))
+}
+
+// DeleteLoadBalancerByNamespacedName deletes the NSX resources created for the Service with the given
+// NamespacedName, it is used when the Service has been removed from the cluster.
+func (s *ServiceLBService) DeleteLoadBalancerByNamespacedName(nn types.NamespacedName) error {
+	return s.deleteLoadBalancerByIndex(serviceNameIndexKey, nn.String())
+}
+
+func (s *ServiceLBService) deleteLoadBalancerByIndex(key, value string) error {
+	vss := s.VirtualServerStore.GetByIndex(key, value)
+	for _, vs := range vss {
+		vs.MarkedForDelete = &MarkedForDelete
+	}
+	if err := s.applyVirtualServers(vss); err != nil {
+		return err
+	}
+	pools := s.PoolStore.GetByIndex(key, value)
+	for _, pool := range pools {
+		pool.MarkedForDelete = &MarkedForDelete
+	}
+	if err := s.applyPools(pools); err != nil {
+		return err
+	}
+	groups := s.GroupStore.GetByIndex(key, value)
+	for _, group := range groups {
+		group.MarkedForDelete = &MarkedForDelete
+	}
+	if err := s.applyGroups(groups); err != nil {
+		return err
+	}
+	for _, allocation := range s.IPAllocationStore.GetByIndex(key, value) {
+		if err := s.releaseVIP(allocation); err != nil {
+			return err
+		}
+	}
+	log.Info("Successfully deleted LoadBalancer Service resources", key, value)
+	return nil
+}
+
+// ListServiceUIDs returns the UIDs of the Services which have NSX resources created.
+func (s *ServiceLBService) ListServiceUIDs() sets.Set[string] {
+	uids := s.VirtualServerStore.ListIndexFuncValues(serviceUIDIndexKey)
+	uids = uids.Union(s.PoolStore.ListIndexFuncValues(serviceUIDIndexKey))
+	uids = uids.Union(s.GroupStore.ListIndexFuncValues(serviceUIDIndexKey))
+	return uids.Union(s.IPAllocationStore.ListIndexFuncValues(serviceUIDIndexKey))
+}
+
+// ListLoadBalancerResourcePaths returns the paths of the NSX resources DeleteLoadBalancer would delete for the Service.
+func (s *ServiceLBService) ListLoadBalancerResourcePaths(uid types.UID) []string {
+	var paths []string
+	for _, vs := range s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(uid)) {
+		if vs.Path != nil {
+			paths = append(paths, *vs.Path)
+		}
+	}
+	for _, pool := range s.PoolStore.GetByIndex(serviceUIDIndexKey, string(uid)) {
+		if pool.Path != nil {
+			paths = append(paths, *pool.Path)
+		}
+	}
+	for _, group := range s.GroupStore.GetByIndex(serviceUIDIndexKey, string(uid)) {
+		if group.Path != nil {
+			paths = append(paths, *group.Path)
+		}
+	}
+	for _, allocation := range s.IPAllocationStore.GetByIndex(serviceUIDIndexKey, string(uid)) {
+		if allocation.Path != nil {
+			paths = append(paths, *allocation.Path)
+		}
+	}
+	return paths
+}
+
+func (s *ServiceLBService) getLBServicePath(vpcInfo *common.VPCResourceInfo) (string, error) {
+	includeMarkForDeleted := false
+	lbs, err := s.NSXClient.VPCLBSClient.List(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, nil, &includeMarkForDeleted, nil, nil, nil, nil)
+	err = nsxutil.TransNSXApiError(err)
+	if err != nil {
+		log.Error(err, "Failed to list LB services in VPC", "VPC", vpcInfo.GetVPCPath())
+		return "", err
+	}
+	if len(lbs.Results) == 0 {
+		return "", nil
+	}
+	return *lbs.Results[0].Path, nil
+}
+
+// allocateVIP returns the VIP of the Service, the VpcIpAddressAllocation is created if it doesn't exist. If the
+// requested static VIP is changed, the allocated VIP is released and the static VIP is allocated by a new
+// VpcIpAddressAllocation. The allocated VIP is kept if the static VIP is removed from the Service.
+func (s *ServiceLBService) allocateVIP(svc *v1.Service, vpcInfo *common.VPCResourceInfo, staticVIP string) (string, error) {
+	id := buildIPAllocationID(svc, "")
+	allocations := s.IPAllocationStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	if len(allocations) > 0 && allocations[0].AllocationIps == nil {
+		// The VpcIpAddressAllocation is not realized yet, it is patched again with the same ID.
+		id = *allocations[0].Id
+	} else if len(allocations) > 0 {
+		vip := getVIP(allocations[0])
+		if staticVIP == "" || staticVIP == vip {
+			return vip, nil
+		}
+		log.Info("Static VIP of Service changed, releasing the allocated VIP", "Service", types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}, "oldVIP", vip, "newVIP", staticVIP)
+		// The virtual servers listening on the old VIP are deleted before the VIP is released, and they are
+		// recreated with the new VIP.
+		vss := s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+		for _, vs := range vss {
+			vs.MarkedForDelete = &MarkedForDelete
+		}
+		if err := s.applyVirtualServers(vss); err != nil {
+			return "", err
+		}
+		if err := s.releaseVIP(allocations[0]); err != nil {
+			return "", err
+		}
+		id = buildIPAllocationID(svc, *allocations[0].Id)
+	}
+	allocation := s.buildIPAllocation(svc, vpcInfo.GetVPCPath(), staticVIP, id)
+	errPatch := s.NSXClient.IPAddressAllocationClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *allocation.Id, *allocation)
+	errPatch = nsxutil.TransNSXApiError(errPatch)
+	if errPatch != nil {
+		// Try to get it from NSX in case the allocation is created but not realized at the first time.
+		log.Error(errPatch, "Failed to patch VpcIpAddressAllocation for Service, try to get it from NSX", "VpcIpAddressAllocation", *allocation.Id)
+	}
+	nsxAllocation, errGet := s.NSXClient.IPAddressAllocationClient.Get(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *allocation.Id)
+	errGet = nsxutil.TransNSXApiError(errGet)
+	if errGet != nil {
+		if errPatch != nil {
+			return "", fmt.Errorf("error get %s, error patch %s", errGet.Error(), errPatch.Error())
+		}
+		return "", errGet
+	}
+	if nsxAllocation.AllocationIps == nil {
+		// The VpcIpAddressAllocation is kept in the store so that it is not leaked if a new ID was generated.
+		if nsxAllocation.Id != nil {
+			if err := s.IPAllocationStore.Apply(&nsxAllocation); err != nil {
+				return "", err
+			}
+		}
+		return "", fmt.Errorf("VIP of Service %s/%s not realized yet", svc.Namespace, svc.Name)
+	}
+	if err := s.IPAllocationStore.Apply(&nsxAllocation); err != nil {
+		return "", err
+	}
+	return getVIP(&nsxAllocation), nil
+}
+
+func (s *ServiceLBService) releaseVIP(allocation *model.VpcIpAddressAllocation) error {
+	vpcInfo, err := common.ParseVPCResourcePath(*allocation.Path)
+	if err != nil {
+		return err
+	}
+	if err := s.NSXClient.IPAddressAllocationClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *allocation.Id); err != nil {
+		err = nsxutil.TransNSXApiError(err)
+		log.Error(err, "Failed to delete VpcIpAddressAllocation for Service", "VpcIpAddressAllocation", *allocation.Id)
+		return err
+	}
+	allocation.MarkedForDelete = &MarkedForDelete
+	return s.IPAllocationStore.Apply(allocation)
+}
+
+func (s *ServiceLBService) applyPools(pools []*model.LBPool) error {
+	if len(pools) == 0 {
+		return nil
+	}
+	orgRoot, err := s.poolBuilder.BuildOrgRoot(pools, "")
+	if err != nil {
+		return err
+	}
+	if err = s.NSXClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
+		err = nsxutil.TransNSXApiError(err)
+		log.Error(err, "Failed to patch LBPools on NSX", "count", len(pools))
+		return err
+	}
+	for _, pool := range pools {
+		if err = s.PoolStore.Apply(pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ServiceLBService) applyVirtualServers(vss []*model.LBVirtualServer) error {
+	if len(vss) == 0 {
+		return nil
+	}
+	orgRoot, err := s.vsBuilder.BuildOrgRoot(vss, "")
+	if err != nil {
+		return err
+	}
+	if err = s.NSXClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
+		err = nsxutil.TransNSXApiError(err)
+		log.Error(err, "Failed to patch LBVirtualServers on NSX", "count", len(vss))
+		return err
+	}
+	for _, vs := range vss {
+		if err = s.VirtualServerStore.Apply(vs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ServiceLBService) applyGroups(groups []*model.Group) error {
+	if len(groups) == 0 {
+		return nil
+	}
+	orgRoot, err := s.groupBuilder.BuildOrgRoot(groups, "")
+	if err != nil {
+		return err
+	}
+	if err = s.NSXClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam); err != nil {
+		err = nsxutil.TransNSXApiError(err)
+		log.Error(err, "Failed to patch Groups on NSX", "count", len(groups))
+		return err
+	}
+	for _, group := range groups {
+		if err = s.GroupStore.Apply(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getVIP returns the VIP in the allocated IPs, the prefix length is removed if it exists.
+func getVIP(allocation *model.VpcIpAddressAllocation) string {
+	vip, _, _ := strings.Cut(*allocation.AllocationIps, "/")
+	return vip
+}
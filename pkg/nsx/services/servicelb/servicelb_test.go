@@ -0,0 +1,366 @@
+package servicelb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/stretchr/testify/assert"
+	stderrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/mock"
+	mocks "github.com/vmware-tanzu/nsx-operator/pkg/mock/ipaddressallocation"
+	mock_org_root "github.com/vmware-tanzu/nsx-operator/pkg/mock/orgrootclient"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeVPCLBSClient struct {
+	results []model.LBService
+}
+
+func (c *fakeVPCLBSClient) Delete(orgIdParam string, projectIdParam string, vpcIdParam string, vpcLbIdParam string, forceParam *bool) error {
+	return nil
+}
+
+func (c *fakeVPCLBSClient) Get(orgIdParam string, projectIdParam string, vpcIdParam string, vpcLbIdParam string) (model.LBService, error) {
+	return model.LBService{}, nil
+}
+
+func (c *fakeVPCLBSClient) List(orgIdParam string, projectIdParam string, vpcIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model.LBServiceListResult, error) {
+	return model.LBServiceListResult{Results: c.results}, nil
+}
+
+func (c *fakeVPCLBSClient) Patch(orgIdParam string, projectIdParam string, vpcIdParam string, vpcLbIdParam string, lbServiceParam model.LBService, actionParam *string) error {
+	return nil
+}
+
+func (c *fakeVPCLBSClient) Update(orgIdParam string, projectIdParam string, vpcIdParam string, vpcLbIdParam string, lbServiceParam model.LBService, actionParam *string) (model.LBService, error) {
+	return model.LBService{}, nil
+}
+
+type fakeLBPersistenceProfileClient struct {
+	existing map[string]bool
+}
+
+func (c *fakeLBPersistenceProfileClient) Delete(string, *bool) error {
+	return nil
+}
+
+func (c *fakeLBPersistenceProfileClient) Get(id string) (*data.StructValue, error) {
+	if !c.existing[id] {
+		return nil, nsxutil.NewNSXApiError(&model.ApiError{}, stderrors.ErrorType_NOT_FOUND)
+	}
+	return data.NewStructValue("", nil), nil
+}
+
+func (c *fakeLBPersistenceProfileClient) List(*string, *bool, *string, *int64, *bool, *string) (model.LBPersistenceProfileListResult, error) {
+	return model.LBPersistenceProfileListResult{}, nil
+}
+
+func (c *fakeLBPersistenceProfileClient) Patch(string, *data.StructValue) error {
+	return nil
+}
+
+func (c *fakeLBPersistenceProfileClient) Update(string, *data.StructValue) (*data.StructValue, error) {
+	return nil, nil
+}
+
+type fakeLBMonitorProfileClient struct {
+	existing map[string]bool
+}
+
+func (c *fakeLBMonitorProfileClient) Delete(string, *bool) error {
+	return nil
+}
+
+func (c *fakeLBMonitorProfileClient) Get(id string) (*data.StructValue, error) {
+	if !c.existing[id] {
+		return nil, nsxutil.NewNSXApiError(&model.ApiError{}, stderrors.ErrorType_NOT_FOUND)
+	}
+	return data.NewStructValue("", nil), nil
+}
+
+func (c *fakeLBMonitorProfileClient) List(*string, *bool, *string, *int64, *bool, *string) (model.LBMonitorProfileListResult, error) {
+	return model.LBMonitorProfileListResult{}, nil
+}
+
+func (c *fakeLBMonitorProfileClient) Patch(string, *data.StructValue) error {
+	return nil
+}
+
+func (c *fakeLBMonitorProfileClient) Update(string, *data.StructValue) (*data.StructValue, error) {
+	return nil, nil
+}
+
+func createServiceWithClients(t *testing.T) (*ServiceLBService, *mocks.MockIPAddressAllocationClient, *mock_org_root.MockOrgRootClient, *fakeVPCLBSClient) {
+	mockCtrl := gomock.NewController(t)
+	allocationClient := mocks.NewMockIPAddressAllocationClient(mockCtrl)
+	orgRootClient := mock_org_root.NewMockOrgRootClient(mockCtrl)
+	lbsClient := &fakeVPCLBSClient{results: []model.LBService{{Path: String(testVPCPath + "/vpc-lbs/default")}}}
+
+	vpcService := &mock.MockVPCServiceProvider{}
+	vpcService.On("ListVPCInfo", "ns1").Return([]common.VPCResourceInfo{{OrgID: "default", ProjectID: "project-1", VPCID: "vpc-1", ID: "vpc-1"}})
+
+	s := createTestService()
+	s.NSXClient = &nsx.Client{
+		IPAddressAllocationClient:   allocationClient,
+		OrgRootClient:               orgRootClient,
+		VPCLBSClient:                lbsClient,
+		LbPersistenceProfilesClient: &fakeLBPersistenceProfileClient{existing: map[string]bool{"source-ip": true}},
+		LbMonitorProfilesClient:     &fakeLBMonitorProfileClient{existing: map[string]bool{"tcp-monitor": true, "icmp-monitor": true}},
+		NsxConfig:                   &config.NSXOperatorConfig{CoeConfig: &config.CoeConfig{Cluster: "cluster1"}},
+	}
+	s.VPCService = vpcService
+	s.vsBuilder, _ = common.PolicyPathVpcLBVirtualServer.NewPolicyTreeBuilder()
+	s.poolBuilder, _ = common.PolicyPathVpcLBPool.NewPolicyTreeBuilder()
+	s.allocationBuilder, _ = common.PolicyPathVpcIPAddressAllocation.NewPolicyTreeBuilder()
+	s.groupBuilder, _ = common.PolicyPathVpcGroup.NewPolicyTreeBuilder()
+	return s, allocationClient, orgRootClient, lbsClient
+}
+
+func TestInitializeServiceLB(t *testing.T) {
+	commonService := common.Service{}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&commonService), "InitializeResourceStore", func(_ *common.Service, wg *sync.WaitGroup,
+		fatalErrors chan error, resourceTypeValue string, tags []model.Tag, store common.Store,
+	) {
+		assert.Equal(t, []model.Tag{{Scope: String(common.TagScopeServiceUID)}}, tags)
+		wg.Done()
+	})
+	s, err := InitializeServiceLB(commonService, &mock.MockVPCServiceProvider{})
+	patches.Reset()
+	assert.NoError(t, err)
+	assert.NotNil(t, s.VirtualServerStore)
+	assert.NotNil(t, s.PoolStore)
+	assert.NotNil(t, s.IPAllocationStore)
+	assert.NotNil(t, s.GroupStore)
+
+	patches = gomonkey.ApplyMethod(reflect.TypeOf(&commonService), "InitializeResourceStore", func(_ *common.Service, wg *sync.WaitGroup,
+		fatalErrors chan error, resourceTypeValue string, tags []model.Tag, store common.Store,
+	) {
+		defer wg.Done()
+		if resourceTypeValue == common.ResourceTypeLBPool {
+			fatalErrors <- errors.New("init failed")
+		}
+	})
+	defer patches.Reset()
+	_, err = InitializeServiceLB(commonService, &mock.MockVPCServiceProvider{})
+	assert.EqualError(t, err, "init failed")
+}
+
+func TestServiceLBService_CreateOrUpdateLoadBalancer(t *testing.T) {
+	s, allocationClient, orgRootClient, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+	allocationID := String(buildIPAllocationID(svc, ""))
+
+	allocationClient.EXPECT().Patch("default", "project-1", "vpc-1", *allocationID, gomock.Any()).Return(nil)
+	allocationClient.EXPECT().Get("default", "project-1", "vpc-1", *allocationID).Return(model.VpcIpAddressAllocation{
+		Id:            allocationID,
+		Path:          String(testVPCPath + "/ip-address-allocations/" + *allocationID),
+		ParentPath:    String(testVPCPath),
+		AllocationIps: String("192.168.0.10/32"),
+		Tags:          s.buildTags(svc),
+	}, nil)
+	// One patch for the pools and one for the virtual servers.
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	vips, err := s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.0.10"}, vips)
+	assert.Len(t, s.PoolStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 2)
+	assert.Len(t, s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 2)
+	assert.Len(t, s.IPAllocationStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 1)
+
+	// Nothing is patched if the Service is not changed.
+	vips, err = s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.0.10"}, vips)
+
+	// The virtual server and pool of the removed port are deleted.
+	svc.Spec.Ports = svc.Spec.Ports[:1]
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	_, err = s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Len(t, s.PoolStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 1)
+	assert.Len(t, s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 1)
+	assert.Empty(t, s.GroupStore.List())
+}
+
+func TestServiceLBService_CreateOrUpdateLoadBalancerWithOptions(t *testing.T) {
+	s, allocationClient, orgRootClient, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+	svc.Spec.Ports = svc.Spec.Ports[:1]
+	svc.Spec.LoadBalancerIP = "192.168.0.19"
+	svc.Spec.LoadBalancerSourceRanges = []string{"10.0.0.0/24"}
+	svc.Annotations = map[string]string{
+		common.AnnotationLBStaticIP:           "192.168.0.20",
+		common.AnnotationLBPersistenceProfile: "source-ip",
+		common.AnnotationLBHealthCheckProfile: "tcp-monitor, icmp-monitor",
+	}
+	allocationID := buildIPAllocationID(svc, "")
+	expectAllocation := func(vip string) *string {
+		patchedID := new(string)
+		allocationClient.EXPECT().Patch("default", "project-1", "vpc-1", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_, _, _, id string, allocation model.VpcIpAddressAllocation) error {
+				assert.Equal(t, vip, *allocation.AllocationIps)
+				*patchedID = id
+				return nil
+			})
+		allocationClient.EXPECT().Get("default", "project-1", "vpc-1", gomock.Any()).DoAndReturn(
+			func(_, _, _, id string) (model.VpcIpAddressAllocation, error) {
+				return model.VpcIpAddressAllocation{
+					Id:            String(id),
+					Path:          String(testVPCPath + "/ip-address-allocations/" + id),
+					ParentPath:    String(testVPCPath),
+					AllocationIps: String(vip),
+					Tags:          s.buildTags(svc),
+				}, nil
+			})
+		return patchedID
+	}
+
+	// The static VIP in the annotation takes precedence over spec.loadBalancerIP.
+	patchedID := expectAllocation("192.168.0.20")
+	// One patch for the group, one for the pools and one for the virtual servers.
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+	vips, err := s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.0.20"}, vips)
+	assert.Equal(t, allocationID, *patchedID)
+	groups := s.GroupStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	assert.Len(t, groups, 1)
+	vss := s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	assert.Len(t, vss, 1)
+	assert.Equal(t, groups[0].Path, vss[0].AccessListControl.GroupPath)
+	assert.Equal(t, "/infra/lb-persistence-profiles/source-ip", *vss[0].LbPersistenceProfilePath)
+	pools := s.PoolStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	assert.Equal(t, []string{"/infra/lb-monitor-profiles/tcp-monitor", "/infra/lb-monitor-profiles/icmp-monitor"}, pools[0].ActiveMonitorPaths)
+
+	// The virtual server is deleted before the old VIP is released, and recreated with the new VIP.
+	svc.Annotations[common.AnnotationLBStaticIP] = "192.168.0.21"
+	allocationClient.EXPECT().Delete("default", "project-1", "vpc-1", allocationID).Return(nil)
+	patchedID = expectAllocation("192.168.0.21")
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	vips, err = s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.0.21"}, vips)
+	// The new static VIP is allocated with a new ID, as NSX may still be deleting the released allocation.
+	assert.NotEqual(t, allocationID, *patchedID)
+	allocations := s.IPAllocationStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))
+	assert.Len(t, allocations, 1)
+	assert.Equal(t, *patchedID, *allocations[0].Id)
+	assert.Equal(t, "192.168.0.21", *s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))[0].IpAddress)
+
+	// The access list is removed from the virtual server before the group is deleted.
+	svc.Spec.LoadBalancerSourceRanges = nil
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	_, err = s.CreateOrUpdateLoadBalancer(svc, createTestEndpointSlices())
+	assert.NoError(t, err)
+	assert.Empty(t, s.GroupStore.List())
+	assert.Nil(t, s.VirtualServerStore.GetByIndex(serviceUIDIndexKey, string(svc.UID))[0].AccessListControl)
+}
+
+func TestServiceLBService_CreateOrUpdateLoadBalancerValidation(t *testing.T) {
+	s, _, _, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+	svc.Annotations = map[string]string{common.AnnotationLBPersistenceProfile: "cookie"}
+
+	_, err := s.CreateOrUpdateLoadBalancer(svc, nil)
+	var validationErr *nsxutil.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.EqualError(t, err, "LB persistence profile cookie not found")
+	assert.Empty(t, s.ListServiceUIDs())
+}
+
+func TestServiceLBService_CreateOrUpdateLoadBalancerFailure(t *testing.T) {
+	s, allocationClient, orgRootClient, lbsClient := createServiceWithClients(t)
+	svc := createTestK8sService()
+
+	// No LB service in the VPC.
+	lbsClient.results = nil
+	_, err := s.CreateOrUpdateLoadBalancer(svc, nil)
+	assert.ErrorContains(t, err, "no NSX LB service found")
+
+	// VIP is not realized.
+	lbsClient.results = []model.LBService{{Path: String(testVPCPath + "/vpc-lbs/default")}}
+	allocationClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	allocationClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(model.VpcIpAddressAllocation{}, nil)
+	_, err = s.CreateOrUpdateLoadBalancer(svc, nil)
+	assert.ErrorContains(t, err, "not realized yet")
+
+	// The unrealized allocation is kept in the store and patched again with the same ID.
+	pendingAllocation := model.VpcIpAddressAllocation{Id: String("ipa-1"), Path: String(testVPCPath + "/ip-address-allocations/ipa-1"), ParentPath: String(testVPCPath), Tags: s.buildTags(svc)}
+	allocationClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	allocationClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(pendingAllocation, nil)
+	_, err = s.CreateOrUpdateLoadBalancer(svc, nil)
+	assert.ErrorContains(t, err, "not realized yet")
+	assert.Len(t, s.IPAllocationStore.GetByIndex(serviceUIDIndexKey, string(svc.UID)), 1)
+	allocationClient.EXPECT().Patch("default", "project-1", "vpc-1", "ipa-1", gomock.Any()).Return(nil)
+	allocationClient.EXPECT().Get("default", "project-1", "vpc-1", "ipa-1").Return(pendingAllocation, nil)
+	_, err = s.CreateOrUpdateLoadBalancer(svc, nil)
+	assert.ErrorContains(t, err, "not realized yet")
+
+	// Failed to patch the pools.
+	s.IPAllocationStore.Apply(&model.VpcIpAddressAllocation{Id: String("ipa-1"), ParentPath: String(testVPCPath), AllocationIps: String("192.168.0.10"), Tags: s.buildTags(svc)})
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(errors.New("patch failed"))
+	_, err = s.CreateOrUpdateLoadBalancer(svc, nil)
+	assert.ErrorContains(t, err, "patch failed")
+	assert.Empty(t, s.PoolStore.List())
+	assert.Empty(t, s.VirtualServerStore.List())
+}
+
+func TestServiceLBService_DeleteLoadBalancer(t *testing.T) {
+	s, allocationClient, orgRootClient, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+	tags := s.buildTags(svc)
+	pools, vss := s.buildPoolsAndVirtualServers(svc, nil, testVPCPath, testVPCPath+"/vpc-lbs/default", "192.168.0.10", &loadBalancerOptions{})
+	for i := range pools {
+		s.PoolStore.Apply(pools[i])
+		s.VirtualServerStore.Apply(vss[i])
+	}
+	s.GroupStore.Apply(s.buildSourceRangesGroup(svc, testVPCPath, []string{"10.0.0.0/24"}))
+	s.IPAllocationStore.Apply(&model.VpcIpAddressAllocation{Id: String("ipa-1"), Path: String(testVPCPath + "/ip-address-allocations/ipa-1"), ParentPath: String(testVPCPath), Tags: tags})
+	assert.Equal(t, "svc-uid-1", s.ListServiceUIDs().UnsortedList()[0])
+
+	orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+	allocationClient.EXPECT().Delete("default", "project-1", "vpc-1", "ipa-1").Return(nil)
+	assert.NoError(t, s.DeleteLoadBalancerByNamespacedName(types.NamespacedName{Namespace: "ns1", Name: "svc1"}))
+	assert.Empty(t, s.PoolStore.List())
+	assert.Empty(t, s.VirtualServerStore.List())
+	assert.Empty(t, s.IPAllocationStore.List())
+	assert.Empty(t, s.GroupStore.List())
+	assert.Empty(t, s.ListServiceUIDs())
+
+	// Nothing to delete.
+	assert.NoError(t, s.DeleteLoadBalancer(svc.UID))
+}
+
+func TestServiceLBService_Cleanup(t *testing.T) {
+	s, _, _, _ := createServiceWithClients(t)
+	svc := createTestK8sService()
+	pools, vss := s.buildPoolsAndVirtualServers(svc, nil, testVPCPath, testVPCPath+"/vpc-lbs/default", "192.168.0.10", &loadBalancerOptions{})
+	for i := range pools {
+		s.PoolStore.Apply(pools[i])
+		s.VirtualServerStore.Apply(vss[i])
+	}
+	s.GroupStore.Apply(s.buildSourceRangesGroup(svc, testVPCPath, []string{"10.0.0.0/24"}))
+	s.IPAllocationStore.Apply(&model.VpcIpAddressAllocation{Id: String("ipa-1"), ParentPath: String(testVPCPath), Tags: s.buildTags(svc)})
+
+	// The resources in an auto-created VPC are only removed from the local cache.
+	assert.NoError(t, s.CleanupVPCChildResources(context.TODO(), testVPCPath))
+	assert.Empty(t, s.PoolStore.List())
+	assert.Empty(t, s.VirtualServerStore.List())
+	assert.Empty(t, s.IPAllocationStore.List())
+	assert.Empty(t, s.GroupStore.List())
+
+	assert.NoError(t, s.CleanupBeforeVPCDeletion(context.TODO()))
+}
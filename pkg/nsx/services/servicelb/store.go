@@ -0,0 +1,267 @@
+package servicelb
+
+import (
+	"errors"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+const (
+	serviceUIDIndexKey  = "serviceUID"
+	serviceNameIndexKey = "serviceName"
+)
+
+func keyFunc(obj interface{}) (string, error) {
+	switch v := obj.(type) {
+	case *model.LBVirtualServer:
+		return *v.Id, nil
+	case *model.LBPool:
+		return *v.Id, nil
+	case *model.VpcIpAddressAllocation:
+		return *v.Id, nil
+	case *model.Group:
+		return *v.Id, nil
+	default:
+		return "", errors.New("keyFunc doesn't support unknown type")
+	}
+}
+
+func getTags(obj interface{}) ([]model.Tag, error) {
+	switch v := obj.(type) {
+	case *model.LBVirtualServer:
+		return v.Tags, nil
+	case *model.LBPool:
+		return v.Tags, nil
+	case *model.VpcIpAddressAllocation:
+		return v.Tags, nil
+	case *model.Group:
+		return v.Tags, nil
+	default:
+		return nil, errors.New("getTags doesn't support unknown type")
+	}
+}
+
+func serviceUIDIndexFunc(obj interface{}) ([]string, error) {
+	tags, err := getTags(obj)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if *tag.Scope == common.TagScopeServiceUID {
+			return []string{*tag.Tag}, nil
+		}
+	}
+	return []string{}, nil
+}
+
+func serviceNameIndexFunc(obj interface{}) ([]string, error) {
+	tags, err := getTags(obj)
+	if err != nil {
+		return nil, err
+	}
+	var name, namespace string
+	for _, tag := range tags {
+		switch *tag.Scope {
+		case common.TagScopeServiceName:
+			name = *tag.Tag
+		case common.TagScopeNamespace:
+			namespace = *tag.Tag
+		}
+	}
+	if name == "" || namespace == "" {
+		return []string{}, nil
+	}
+	return []string{types.NamespacedName{Namespace: namespace, Name: name}.String()}, nil
+}
+
+func newIndexer() cache.Indexer {
+	return cache.NewIndexer(keyFunc, cache.Indexers{
+		serviceUIDIndexKey:           serviceUIDIndexFunc,
+		serviceNameIndexKey:          serviceNameIndexFunc,
+		common.IndexByVPCPathFuncKey: common.IndexByVPCFunc,
+	})
+}
+
+// VirtualServerStore is a store for the LBVirtualServers created for the Services.
+type VirtualServerStore struct {
+	common.ResourceStore
+}
+
+func (s *VirtualServerStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	vs := i.(*model.LBVirtualServer)
+	if vs.MarkedForDelete != nil && *vs.MarkedForDelete {
+		if err := s.Delete(vs); err != nil {
+			return err
+		}
+		log.Debug("Deleted LBVirtualServer from store", "LBVirtualServer", vs.Id)
+		return nil
+	}
+	if err := s.Add(vs); err != nil {
+		return err
+	}
+	log.Debug("Added LBVirtualServer to store", "LBVirtualServer", vs.Id)
+	return nil
+}
+
+func (s *VirtualServerStore) DeleteMultipleObjects(vss []*model.LBVirtualServer) {
+	for _, obj := range vss {
+		s.Delete(obj)
+	}
+}
+
+func (s *VirtualServerStore) GetByIndex(key string, value string) []*model.LBVirtualServer {
+	vss := make([]*model.LBVirtualServer, 0)
+	for _, obj := range s.ResourceStore.GetByIndex(key, value) {
+		vss = append(vss, obj.(*model.LBVirtualServer))
+	}
+	return vss
+}
+
+// PoolStore is a store for the LBPools created for the Services.
+type PoolStore struct {
+	common.ResourceStore
+}
+
+func (s *PoolStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	pool := i.(*model.LBPool)
+	if pool.MarkedForDelete != nil && *pool.MarkedForDelete {
+		if err := s.Delete(pool); err != nil {
+			return err
+		}
+		log.Debug("Deleted LBPool from store", "LBPool", pool.Id)
+		return nil
+	}
+	if err := s.Add(pool); err != nil {
+		return err
+	}
+	log.Debug("Added LBPool to store", "LBPool", pool.Id)
+	return nil
+}
+
+func (s *PoolStore) DeleteMultipleObjects(pools []*model.LBPool) {
+	for _, obj := range pools {
+		s.Delete(obj)
+	}
+}
+
+func (s *PoolStore) GetByIndex(key string, value string) []*model.LBPool {
+	pools := make([]*model.LBPool, 0)
+	for _, obj := range s.ResourceStore.GetByIndex(key, value) {
+		pools = append(pools, obj.(*model.LBPool))
+	}
+	return pools
+}
+
+// IPAllocationStore is a store for the VpcIpAddressAllocations holding the VIPs of the Services.
+type IPAllocationStore struct {
+	common.ResourceStore
+}
+
+func (s *IPAllocationStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	allocation := i.(*model.VpcIpAddressAllocation)
+	if allocation.MarkedForDelete != nil && *allocation.MarkedForDelete {
+		if err := s.Delete(allocation); err != nil {
+			return err
+		}
+		log.Debug("Deleted VpcIpAddressAllocation from store", "VpcIpAddressAllocation", allocation.Id)
+		return nil
+	}
+	if err := s.Add(allocation); err != nil {
+		return err
+	}
+	log.Debug("Added VpcIpAddressAllocation to store", "VpcIpAddressAllocation", allocation.Id)
+	return nil
+}
+
+func (s *IPAllocationStore) DeleteMultipleObjects(allocations []*model.VpcIpAddressAllocation) {
+	for _, obj := range allocations {
+		s.Delete(obj)
+	}
+}
+
+func (s *IPAllocationStore) GetByIndex(key string, value string) []*model.VpcIpAddressAllocation {
+	allocations := make([]*model.VpcIpAddressAllocation, 0)
+	for _, obj := range s.ResourceStore.GetByIndex(key, value) {
+		allocations = append(allocations, obj.(*model.VpcIpAddressAllocation))
+	}
+	return allocations
+}
+
+// GroupStore is a store for the Groups holding the load balancer source ranges of the Services.
+type GroupStore struct {
+	common.ResourceStore
+}
+
+func (s *GroupStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	group := i.(*model.Group)
+	if group.MarkedForDelete != nil && *group.MarkedForDelete {
+		if err := s.Delete(group); err != nil {
+			return err
+		}
+		log.Debug("Deleted Group from store", "Group", group.Id)
+		return nil
+	}
+	if err := s.Add(group); err != nil {
+		return err
+	}
+	log.Debug("Added Group to store", "Group", group.Id)
+	return nil
+}
+
+func (s *GroupStore) DeleteMultipleObjects(groups []*model.Group) {
+	for _, obj := range groups {
+		s.Delete(obj)
+	}
+}
+
+func (s *GroupStore) GetByIndex(key string, value string) []*model.Group {
+	groups := make([]*model.Group, 0)
+	for _, obj := range s.ResourceStore.GetByIndex(key, value) {
+		groups = append(groups, obj.(*model.Group))
+	}
+	return groups
+}
+
+func buildVirtualServerStore() *VirtualServerStore {
+	return &VirtualServerStore{ResourceStore: common.ResourceStore{
+		Indexer:     newIndexer(),
+		BindingType: model.LBVirtualServerBindingType(),
+	}}
+}
+
+func buildPoolStore() *PoolStore {
+	return &PoolStore{ResourceStore: common.ResourceStore{
+		Indexer:     newIndexer(),
+		BindingType: model.LBPoolBindingType(),
+	}}
+}
+
+func buildIPAllocationStore() *IPAllocationStore {
+	return &IPAllocationStore{ResourceStore: common.ResourceStore{
+		Indexer:     newIndexer(),
+		BindingType: model.VpcIpAddressAllocationBindingType(),
+	}}
+}
+
+func buildGroupStore() *GroupStore {
+	return &GroupStore{ResourceStore: common.ResourceStore{
+		Indexer:     newIndexer(),
+		BindingType: model.GroupBindingType(),
+	}}
+}
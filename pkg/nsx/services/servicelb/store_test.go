@@ -0,0 +1,106 @@
+package servicelb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+)
+
+var (
+	testVPCPath = "/orgs/default/projects/project-1/vpcs/vpc-1"
+	testTags    = []model.Tag{
+		{Scope: String(common.TagScopeCluster), Tag: String("cluster1")},
+		{Scope: String(common.TagScopeNamespace), Tag: String("ns1")},
+		{Scope: String(common.TagScopeServiceName), Tag: String("svc1")},
+		{Scope: String(common.TagScopeServiceUID), Tag: String("svc-uid-1")},
+	}
+)
+
+func TestKeyFunc(t *testing.T) {
+	id := "id-1"
+	for _, obj := range []interface{}{&model.LBVirtualServer{Id: &id}, &model.LBPool{Id: &id}, &model.VpcIpAddressAllocation{Id: &id}, &model.Group{Id: &id}} {
+		key, err := keyFunc(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, id, key)
+	}
+	_, err := keyFunc(&model.Rule{Id: &id})
+	assert.Error(t, err)
+}
+
+func TestIndexFunc(t *testing.T) {
+	vs := &model.LBVirtualServer{Id: String("vs-1"), Tags: testTags}
+	values, err := serviceUIDIndexFunc(vs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"svc-uid-1"}, values)
+	values, err = serviceNameIndexFunc(vs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ns1/svc1"}, values)
+
+	pool := &model.LBPool{Id: String("pool-1"), Tags: testTags[:1]}
+	values, err = serviceUIDIndexFunc(pool)
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+	values, err = serviceNameIndexFunc(pool)
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = serviceUIDIndexFunc(&model.Rule{})
+	assert.Error(t, err)
+}
+
+func TestVirtualServerStore_Apply(t *testing.T) {
+	store := buildVirtualServerStore()
+	vs := &model.LBVirtualServer{Id: String("vs-1"), Path: String(testVPCPath + "/vpc-lb-virtual-servers/vs-1"), ParentPath: String(testVPCPath), Tags: testTags}
+	assert.NoError(t, store.Apply(vs))
+	assert.Equal(t, []*model.LBVirtualServer{vs}, store.GetByIndex(serviceUIDIndexKey, "svc-uid-1"))
+	assert.Equal(t, []*model.LBVirtualServer{vs}, store.GetByIndex(serviceNameIndexKey, "ns1/svc1"))
+	assert.Equal(t, sets.New[string]("svc-uid-1"), store.ListIndexFuncValues(serviceUIDIndexKey))
+
+	vs.MarkedForDelete = &MarkedForDelete
+	assert.NoError(t, store.Apply(vs))
+	assert.Empty(t, store.GetByIndex(serviceUIDIndexKey, "svc-uid-1"))
+}
+
+func TestPoolStore_Apply(t *testing.T) {
+	store := buildPoolStore()
+	pool := &model.LBPool{Id: String("pool-1"), Path: String(testVPCPath + "/vpc-lb-pools/pool-1"), ParentPath: String(testVPCPath), Tags: testTags}
+	assert.NoError(t, store.Apply(pool))
+	assert.Equal(t, []*model.LBPool{pool}, store.GetByIndex(serviceUIDIndexKey, "svc-uid-1"))
+
+	store.DeleteMultipleObjects([]*model.LBPool{pool})
+	assert.Empty(t, store.GetByIndex(serviceUIDIndexKey, "svc-uid-1"))
+}
+
+func TestIPAllocationStore_Apply(t *testing.T) {
+	store := buildIPAllocationStore()
+	allocation := &model.VpcIpAddressAllocation{Id: String("ipa-1"), Path: String(testVPCPath + "/ip-address-allocations/ipa-1"), ParentPath: String(testVPCPath), Tags: testTags}
+	assert.NoError(t, store.Apply(allocation))
+	assert.Equal(t, []*model.VpcIpAddressAllocation{allocation}, store.GetByIndex(serviceNameIndexKey, "ns1/svc1"))
+	objs, err := store.ByIndex(common.IndexByVPCPathFuncKey, testVPCPath)
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	allocation.MarkedForDelete = &MarkedForDelete
+	assert.NoError(t, store.Apply(allocation))
+	assert.Empty(t, store.List())
+}
+
+func TestGroupStore_Apply(t *testing.T) {
+	store := buildGroupStore()
+	group := &model.Group{Id: String("group-1"), Path: String(testVPCPath + "/groups/group-1"), ParentPath: String(testVPCPath), Tags: testTags}
+	assert.NoError(t, store.Apply(group))
+	assert.Equal(t, []*model.Group{group}, store.GetByIndex(serviceUIDIndexKey, "svc-uid-1"))
+	assert.Equal(t, sets.New[string]("svc-uid-1"), store.ListIndexFuncValues(serviceUIDIndexKey))
+
+	group.MarkedForDelete = &MarkedForDelete
+	assert.NoError(t, store.Apply(group))
+	assert.Empty(t, store.List())
+
+	assert.NoError(t, store.Apply(group))
+	store.DeleteMultipleObjects([]*model.Group{group})
+	assert.Empty(t, store.List())
+}
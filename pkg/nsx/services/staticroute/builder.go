@@ -13,21 +13,69 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
+// defaultAdminDistance is used for the next hops without adminDistance.
+const defaultAdminDistance = int64(1)
+
 func validateStaticRoute(obj *v1alpha1.StaticRoute) error {
+	// The network and all the next hops must be in the same IP family.
+	hasFamily, isIPv6 := false, false
+	if obj.Spec.Network != "" {
+		ip, _, err := net.ParseCIDR(obj.Spec.Network)
+		if err != nil {
+			err = fmt.Errorf("invalid network: %s", obj.Spec.Network)
+			log.Error(err, "buildStaticRoute")
+			return err
+		}
+		hasFamily, isIPv6 = true, ip.To4() == nil
+	}
 	ipDict := make(map[string]bool)
 	for index := range obj.Spec.NextHops {
 		ip := obj.Spec.NextHops[index].IPAddress
-		if _, exist := ipDict[ip]; exist {
+		value := net.ParseIP(ip)
+		if value == nil {
+			err := fmt.Errorf("invalid IP address: %s", ip)
+			log.Error(err, "buildStaticRoute")
+			return err
+		}
+		// IPv6 addresses have multiple text forms, compare them in the canonical form
+		if _, exist := ipDict[value.String()]; exist {
 			err := fmt.Errorf("duplicate ip address %s", ip)
 			log.Error(err, "buildStaticRoute")
 			return err
 		}
-		if value := net.ParseIP(ip); value == nil {
-			err := fmt.Errorf("invalid IP address: %s", ip)
+		if !hasFamily {
+			hasFamily, isIPv6 = true, value.To4() == nil
+		} else if isIPv6 != (value.To4() == nil) {
+			err := fmt.Errorf("next hop %s is not in the same IP family as the network or other next hops", ip)
+			log.Error(err, "buildStaticRoute")
+			return err
+		}
+		if distance := obj.Spec.NextHops[index].AdminDistance; distance < 0 || distance > 255 {
+			err := fmt.Errorf("invalid admin distance %d for next hop %s", distance, ip)
+			log.Error(err, "buildStaticRoute")
+			return err
+		}
+		ipDict[value.String()] = true
+	}
+	return nil
+}
+
+// validateNextHopsIPFamily checks that the next hops are in the IP family of the VpcIpAddressAllocation
+// referred by spec.networkIpAllocationName, as the allocated IP is used as the network of the StaticRoute.
+func validateNextHopsIPFamily(obj *v1alpha1.StaticRoute, nsxAllocation *model.VpcIpAddressAllocation) error {
+	isIPv6 := nsxAllocation.IpAddressType != nil && *nsxAllocation.IpAddressType == model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6
+	for index := range obj.Spec.NextHops {
+		ip := obj.Spec.NextHops[index].IPAddress
+		value := net.ParseIP(ip)
+		if value == nil {
+			// The invalid IP address is reported by validateStaticRoute.
+			continue
+		}
+		if isIPv6 != (value.To4() == nil) {
+			err := fmt.Errorf("next hop %s is not in the same IP family as the network IP allocation %s", ip, obj.Spec.NetworkIPAllocationName)
 			log.Error(err, "buildStaticRoute")
 			return err
 		}
-		ipDict[ip] = true
 	}
 	return nil
 }
@@ -47,15 +95,21 @@ func (service *StaticRouteService) buildStaticRoute(obj *v1alpha1.StaticRoute, n
 	} else {
 		sr.Network = String(obj.Spec.Network)
 	}
-	dis := int64(1)
 	for index := range obj.Spec.NextHops {
+		dis := obj.Spec.NextHops[index].AdminDistance
+		if dis == 0 {
+			dis = defaultAdminDistance
+		}
 		nexthop := model.RouterNexthop{AdminDistance: &dis}
 		nexthop.IpAddress = &obj.Spec.NextHops[index].IPAddress
 		sr.NextHops = append(sr.NextHops, nexthop)
 	}
+	if obj.Spec.Description != "" {
+		sr.Description = String(obj.Spec.Description)
+	}
 
 	tags := service.buildBasicTags(obj)
-	sr.Tags = tags
+	sr.Tags = append(tags, buildUserTags(obj)...)
 	objForIdGeneration := &v1.ObjectMeta{
 		Name: obj.GetName(),
 		UID:  types.UID(common.GetNamespaceUIDFromTag(tags)),
@@ -65,6 +119,15 @@ func (service *StaticRouteService) buildStaticRoute(obj *v1alpha1.StaticRoute, n
 	return sr, nil
 }
 
+// buildUserTags converts the tags in the StaticRoute spec into NSX tags.
+func buildUserTags(obj *v1alpha1.StaticRoute) []model.Tag {
+	tags := make([]model.Tag, 0, len(obj.Spec.Tags))
+	for _, tag := range obj.Spec.Tags {
+		tags = append(tags, model.Tag{Scope: String(tag.Scope), Tag: String(tag.Tag)})
+	}
+	return tags
+}
+
 func (service *StaticRouteService) buildStaticRouteId(obj v1.Object) string {
 	return common.BuildUniqueIDWithRandomUUID(obj, util.GenerateIDByObject, service.staticRoutesIdExists)
 }
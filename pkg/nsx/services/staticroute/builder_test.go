@@ -7,6 +7,7 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -65,6 +66,85 @@ func TestValidateStaticRoute(t *testing.T) {
 	obj.Spec.NextHops = []v1alpha1.NextHop{{IPAddress: ip1}, {IPAddress: ip2}}
 	err = validateStaticRoute(obj)
 	assert.Equal(t, err, fmt.Errorf("invalid IP address: %s", ip2))
+
+	obj.Spec.NextHops = []v1alpha1.NextHop{{IPAddress: ip1, AdminDistance: 256}}
+	err = validateStaticRoute(obj)
+	assert.Equal(t, err, fmt.Errorf("invalid admin distance 256 for next hop %s", ip1))
+}
+
+func TestValidateStaticRoute_IPv6(t *testing.T) {
+	tests := []struct {
+		name     string
+		network  string
+		nextHops []string
+		wantErr  error
+	}{
+		{
+			name:     "IPv6 network and next hops",
+			network:  "2001:db8::/64",
+			nextHops: []string{"fd00::1", "fd00::2"},
+		},
+		{
+			name:     "IPv6 next hops without network",
+			nextHops: []string{"fd00::1", "fd00::2"},
+		},
+		{
+			name:     "duplicate IPv6 next hops in different forms",
+			network:  "2001:db8::/64",
+			nextHops: []string{"fd00::1", "fd00:0:0::1"},
+			wantErr:  fmt.Errorf("duplicate ip address fd00:0:0::1"),
+		},
+		{
+			name:     "IPv4 next hop for IPv6 network",
+			network:  "2001:db8::/64",
+			nextHops: []string{"10.0.0.1"},
+			wantErr:  fmt.Errorf("next hop 10.0.0.1 is not in the same IP family as the network or other next hops"),
+		},
+		{
+			name:     "IPv6 next hop for IPv4 network",
+			network:  "10.0.0.0/24",
+			nextHops: []string{"fd00::1"},
+			wantErr:  fmt.Errorf("next hop fd00::1 is not in the same IP family as the network or other next hops"),
+		},
+		{
+			name:     "mixed next hops without network",
+			nextHops: []string{"fd00::1", "10.0.0.1"},
+			wantErr:  fmt.Errorf("next hop 10.0.0.1 is not in the same IP family as the network or other next hops"),
+		},
+		{
+			name:     "invalid IPv6 network",
+			network:  "2001:db8::/129",
+			nextHops: []string{"fd00::1"},
+			wantErr:  fmt.Errorf("invalid network: 2001:db8::/129"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &v1alpha1.StaticRoute{}
+			obj.Spec.Network = tt.network
+			for _, ip := range tt.nextHops {
+				obj.Spec.NextHops = append(obj.Spec.NextHops, v1alpha1.NextHop{IPAddress: ip})
+			}
+			assert.Equal(t, tt.wantErr, validateStaticRoute(obj))
+		})
+	}
+}
+
+func TestValidateNextHopsIPFamily(t *testing.T) {
+	obj := &v1alpha1.StaticRoute{}
+	obj.Spec.NetworkIPAllocationName = "ipa-1"
+	obj.Spec.NextHops = []v1alpha1.NextHop{{IPAddress: "10.0.0.1"}}
+	ipv4Allocation := &model.VpcIpAddressAllocation{IpAddressType: String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4)}
+	ipv6Allocation := &model.VpcIpAddressAllocation{IpAddressType: String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6)}
+
+	assert.NoError(t, validateNextHopsIPFamily(obj, ipv4Allocation))
+	// The allocation without ipAddressType is IPv4.
+	assert.NoError(t, validateNextHopsIPFamily(obj, &model.VpcIpAddressAllocation{}))
+	assert.Equal(t, fmt.Errorf("next hop 10.0.0.1 is not in the same IP family as the network IP allocation ipa-1"), validateNextHopsIPFamily(obj, ipv6Allocation))
+
+	obj.Spec.NextHops = []v1alpha1.NextHop{{IPAddress: "fd00::1"}}
+	assert.NoError(t, validateNextHopsIPFamily(obj, ipv6Allocation))
+	assert.Equal(t, fmt.Errorf("next hop fd00::1 is not in the same IP family as the network IP allocation ipa-1"), validateNextHopsIPFamily(obj, ipv4Allocation))
 }
 
 func TestBuildStaticRoute(t *testing.T) {
@@ -93,4 +173,19 @@ func TestBuildStaticRoute(t *testing.T) {
 	assert.Equal(t, expName, *staticroutes.DisplayName)
 	expId := "teststaticroute_du8nz"
 	assert.Equal(t, expId, *staticroutes.Id)
+	assert.Equal(t, int64(1), *staticroutes.NextHops[0].AdminDistance)
+	assert.Nil(t, staticroutes.Description)
+
+	// The admin distance, description and tags in the spec are mapped to the NSX static route.
+	obj.Spec.NextHops = []v1alpha1.NextHop{{IPAddress: ip1, AdminDistance: 10}, {IPAddress: ip2}}
+	obj.Spec.Description = "backup route"
+	obj.Spec.Tags = []v1alpha1.StaticRouteTag{{Scope: "owner", Tag: "team-a"}, {Tag: "prod"}}
+	staticroutes, err = service.buildStaticRoute(obj, "")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, int64(10), *staticroutes.NextHops[0].AdminDistance)
+	assert.Equal(t, int64(1), *staticroutes.NextHops[1].AdminDistance)
+	assert.Equal(t, "backup route", *staticroutes.Description)
+	assert.Contains(t, staticroutes.Tags, model.Tag{Scope: common.String("owner"), Tag: common.String("team-a")})
+	assert.Contains(t, staticroutes.Tags, model.Tag{Scope: common.String(""), Tag: common.String("prod")})
+	assert.Contains(t, staticroutes.Tags, model.Tag{Scope: common.String(common.TagScopeNamespaceUID), Tag: common.String("nsUUID")})
 }
@@ -10,19 +10,46 @@ func (service *StaticRouteService) compareStaticRoute(oldStaticRoute *model.Stat
 	if *oldStaticRoute.Network != *newStaticRoute.Network {
 		return false
 	}
+	if getString(oldStaticRoute.Description) != getString(newStaticRoute.Description) {
+		return false
+	}
 	oldNextHops := oldStaticRoute.NextHops
 	newNextHops := newStaticRoute.NextHops
 	if len(oldNextHops) != len(newNextHops) {
 		return false
 	}
-	oldHopsSet := sets.NewString()
-	for _, addr := range oldNextHops {
-		oldHopsSet.Insert(*addr.IpAddress)
+	oldHops := make(map[string]int64, len(oldNextHops))
+	for _, hop := range oldNextHops {
+		oldHops[*hop.IpAddress] = getAdminDistance(hop)
 	}
-	for _, addr := range newNextHops {
-		if !oldHopsSet.Has(*addr.IpAddress) {
+	for _, hop := range newNextHops {
+		distance, ok := oldHops[*hop.IpAddress]
+		if !ok || distance != getAdminDistance(hop) {
 			return false
 		}
 	}
-	return true
+	return tagSet(oldStaticRoute.Tags).Equal(tagSet(newStaticRoute.Tags))
+}
+
+func getString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// getAdminDistance returns the admin distance of the next hop, NSX uses 1 if it is not set.
+func getAdminDistance(hop model.RouterNexthop) int64 {
+	if hop.AdminDistance == nil {
+		return defaultAdminDistance
+	}
+	return *hop.AdminDistance
+}
+
+func tagSet(tags []model.Tag) sets.Set[string] {
+	set := sets.New[string]()
+	for _, tag := range tags {
+		set.Insert(getString(tag.Scope) + "=" + getString(tag.Tag))
+	}
+	return set
 }
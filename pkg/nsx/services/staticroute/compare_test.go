@@ -45,4 +45,33 @@ func TestCompareStaticRoute(t *testing.T) {
 	assert.True(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteSame))
 	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferent))
 	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentNetwork))
+
+	// The default admin distance is the same as the unset one.
+	newStaticRouteSame.NextHops[0].AdminDistance = util.Ptr(int64(1))
+	assert.True(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteSame))
+
+	newStaticRouteDifferentDistance := &model.StaticRoutes{
+		Network: util.Ptr("192.168.1.0/24"),
+		NextHops: []model.RouterNexthop{
+			{IpAddress: util.Ptr("192.168.1.1"), AdminDistance: util.Ptr(int64(10))},
+			{IpAddress: util.Ptr("192.168.1.2")},
+		},
+	}
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentDistance))
+
+	newStaticRouteDifferentDescription := &model.StaticRoutes{
+		Network:     util.Ptr("192.168.1.0/24"),
+		Description: util.Ptr("backup route"),
+		NextHops:    oldStaticRoute.NextHops,
+	}
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentDescription))
+
+	newStaticRouteDifferentTags := &model.StaticRoutes{
+		Network:  util.Ptr("192.168.1.0/24"),
+		NextHops: oldStaticRoute.NextHops,
+		Tags:     []model.Tag{{Scope: util.Ptr("owner"), Tag: util.Ptr("team-a")}},
+	}
+	assert.False(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentTags))
+	oldStaticRoute.Tags = []model.Tag{{Scope: util.Ptr("owner"), Tag: util.Ptr("team-a")}}
+	assert.True(t, service.compareStaticRoute(oldStaticRoute, newStaticRouteDifferentTags))
 }
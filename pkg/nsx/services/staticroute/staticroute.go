@@ -8,6 +8,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -15,7 +16,6 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
-	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 type StaticRouteService struct {
@@ -24,6 +24,8 @@ type StaticRouteService struct {
 	VPCService          common.VPCServiceProvider
 	IPAllocationService common.IPAddressAllocationServiceProvider
 	builder             *common.PolicyTreeBuilder[*model.StaticRoutes]
+	// batchWriter coalesces the writes of the StaticRoutes if it is enabled.
+	batchWriter *common.PolicyTreeBatchWriter[*model.StaticRoutes]
 }
 
 var (
@@ -40,7 +42,7 @@ func InitializeStaticRoute(commonService common.Service, vpcService common.VPCSe
 	fatalErrors := make(chan error)
 
 	wg.Add(1)
-	staticRouteService := &StaticRouteService{Service: commonService, builder: builder}
+	staticRouteService := &StaticRouteService{Service: commonService, builder: builder, batchWriter: common.NewBatchWriterByConfig(builder, commonService)}
 	staticRouteService.StaticRouteStore = buildStaticRouteStore()
 	staticRouteService.NSXConfig = commonService.NSXConfig
 	staticRouteService.VPCService = vpcService
@@ -72,29 +74,29 @@ func isStaticRouteReady(staticRoute *v1alpha1.StaticRoute) bool {
 	return false
 }
 
-// resolveNetworkIPAllocationPath fetches the IPAddressAllocation CR by name and looks up
-// the corresponding NSX VpcIpAddressAllocation from the local store to obtain its policy path.
-// The path is used as the network field on the NSX static route (network_ip_allocation_path).
-func (service *StaticRouteService) resolveNetworkIPAllocationPath(ctx context.Context, namespace, ipAllocCRName string) (string, error) {
+// resolveNetworkIPAllocation fetches the IPAddressAllocation CR by name and looks up
+// the corresponding NSX VpcIpAddressAllocation from the local store. Its policy path is
+// used as the network field on the NSX static route (network_ip_allocation_path).
+func (service *StaticRouteService) resolveNetworkIPAllocation(ctx context.Context, namespace, ipAllocCRName string) (*model.VpcIpAddressAllocation, error) {
 	ipAllocCR := &v1alpha1.IPAddressAllocation{}
 	if err := service.Client.Get(ctx, k8sclient.ObjectKey{Namespace: namespace, Name: ipAllocCRName}, ipAllocCR); err != nil {
 		if apierrors.IsNotFound(err) {
-			return "", fmt.Errorf("IPAddressAllocation CR %s/%s not found", namespace, ipAllocCRName)
+			return nil, fmt.Errorf("IPAddressAllocation CR %s/%s not found", namespace, ipAllocCRName)
 		}
-		return "", fmt.Errorf("failed to get IPAddressAllocation CR %s/%s: %w", namespace, ipAllocCRName, err)
+		return nil, fmt.Errorf("failed to get IPAddressAllocation CR %s/%s: %w", namespace, ipAllocCRName, err)
 	}
 
 	nsxAllocation, err := service.IPAllocationService.GetIPAddressAllocationByOwner(ipAllocCR)
 	if err != nil {
-		return "", fmt.Errorf("failed to look up NSX allocation for IPAddressAllocation CR %s/%s: %w", namespace, ipAllocCRName, err)
+		return nil, fmt.Errorf("failed to look up NSX allocation for IPAddressAllocation CR %s/%s: %w", namespace, ipAllocCRName, err)
 	}
 	if nsxAllocation == nil {
-		return "", fmt.Errorf("NSX allocation for IPAddressAllocation CR %s/%s not found in store", namespace, ipAllocCRName)
+		return nil, fmt.Errorf("NSX allocation for IPAddressAllocation CR %s/%s not found in store", namespace, ipAllocCRName)
 	}
 	if nsxAllocation.Path == nil || *nsxAllocation.Path == "" {
-		return "", fmt.Errorf("NSX allocation for IPAddressAllocation CR %s/%s has no policy path", namespace, ipAllocCRName)
+		return nil, fmt.Errorf("NSX allocation for IPAddressAllocation CR %s/%s has no policy path", namespace, ipAllocCRName)
 	}
-	return *nsxAllocation.Path, nil
+	return nsxAllocation, nil
 }
 
 func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context, namespace string, obj *v1alpha1.StaticRoute) error {
@@ -102,11 +104,14 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 	// IPAddressAllocation CR whose NSX policy path becomes the network_ip_allocation_path.
 	var networkIPAllocationPath string
 	if obj.Spec.NetworkIPAllocationName != "" {
-		var err error
-		networkIPAllocationPath, err = service.resolveNetworkIPAllocationPath(ctx, namespace, obj.Spec.NetworkIPAllocationName)
+		nsxAllocation, err := service.resolveNetworkIPAllocation(ctx, namespace, obj.Spec.NetworkIPAllocationName)
 		if err != nil {
 			return err
 		}
+		if err := validateNextHopsIPFamily(obj, nsxAllocation); err != nil {
+			return err
+		}
+		networkIPAllocationPath = *nsxAllocation.Path
 	}
 
 	nsxStaticRoute, err := service.buildStaticRoute(obj, networkIPAllocationPath)
@@ -124,7 +129,7 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 			// unrealized StaticRoute will be saved to the store after full sync.
 			// Recheck the realizedstate if the StaticRoute CR is not ready.
 			if !isStaticRouteReady(obj) {
-				return service.checkStaticRouteRealizeState(existingStaticRoute)
+				return service.checkStaticRouteRealizeState(existingStaticRoute, obj)
 			}
 			return nil
 		}
@@ -143,20 +148,29 @@ func (service *StaticRouteService) CreateOrUpdateStaticRoute(ctx context.Context
 	if err != nil {
 		return err
 	}
-	err = service.checkStaticRouteRealizeState(&staticRoute)
-	if err != nil {
-		return err
-	}
+	// The StaticRoute is saved to the store before it is realized, so that its realization state is rechecked
+	// when the CR is requeued by the realization watcher.
 	err = service.StaticRouteStore.Add(&staticRoute)
 	if err != nil {
 		return err
 	}
-	return nil
+	realizestate.ForgetRealizeState(*staticRoute.Path)
+	return service.checkStaticRouteRealizeState(&staticRoute, obj)
 }
 
-func (service *StaticRouteService) checkStaticRouteRealizeState(staticRoute *model.StaticRoutes) error {
+// checkStaticRouteRealizeState returns a RealizationPendingError if the StaticRoute is not realized yet, the
+// StaticRoute CR is requeued when the realization finishes. The StaticRoute failed to be realized is deleted.
+func (service *StaticRouteService) checkStaticRouteRealizeState(staticRoute *model.StaticRoutes, obj *v1alpha1.StaticRoute) error {
 	realizeService := realizestate.InitializeRealizeState(service.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *staticRoute.Path, []string{}); err != nil {
+	owner := realizestate.RealizationOwner{
+		Kind:           common.ResourceTypeStaticRoutes,
+		NamespacedName: types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name},
+	}
+	if err := realizeService.CheckRealizeStateAsync(*staticRoute.Path, []string{}, owner); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("Static route is being realized", "ID", *staticRoute.Id)
+			return err
+		}
 		log.Error(err, "Failed to check static route realization state", "ID", *staticRoute.Id)
 		deleteErr := service.DeleteStaticRoute(staticRoute)
 		if deleteErr != nil {
@@ -169,8 +183,15 @@ func (service *StaticRouteService) checkStaticRouteRealizeState(staticRoute *mod
 }
 
 func (service *StaticRouteService) patch(orgId string, projectId string, vpcId string, st *model.StaticRoutes) error {
-	err := service.NSXClient.StaticRouteClient.Patch(orgId, projectId, vpcId, *st.Id, *st)
-	err = nsxutil.TransNSXApiError(err)
+	var err error
+	if service.batchWriter != nil {
+		batchedStaticRoute := *st
+		batchedStaticRoute.Path = String(service.builder.LeafPath(fmt.Sprintf(common.VPCKey, orgId, projectId, vpcId), st))
+		err = service.batchWriter.Write(&batchedStaticRoute)
+	} else {
+		err = service.NSXClient.StaticRouteClient.Patch(orgId, projectId, vpcId, *st.Id, *st)
+		err = nsxutil.TransNSXApiError(err)
+	}
 	if err != nil {
 		return err
 	}
@@ -184,7 +205,13 @@ func (service *StaticRouteService) DeleteStaticRoute(nsxStaticRoute *model.Stati
 		log.Error(err, "Failed to parse NSX VPC path for StaticRoute", "path", *nsxStaticRoute.Path)
 		return err
 	}
-	if err := staticRouteClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxStaticRoute.Id); err != nil {
+	if service.batchWriter != nil {
+		deletedStaticRoute := *nsxStaticRoute
+		deletedStaticRoute.MarkedForDelete = common.Bool(true)
+		if err := service.batchWriter.Write(&deletedStaticRoute); err != nil {
+			return err
+		}
+	} else if err := staticRouteClient.Delete(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxStaticRoute.Id); err != nil {
 		err = nsxutil.TransNSXApiError(err)
 		return err
 	}
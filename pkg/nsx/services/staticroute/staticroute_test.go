@@ -17,7 +17,6 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -594,8 +593,8 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchPatch.Reset()
 		// Patch StaticRouteClient.Get to succeed, but realization check fails and delete fails
 		mockStaticRouteclient.EXPECT().Get("org1", "proj1", "vpc1", staticRouteID).Return(*nsxStaticRoute, nil).Times(1)
-		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeStateAsync,
+			func(_ *realizestate.RealizeStateService, _ string, _ []string, _ realizestate.RealizationOwner) error {
 				return nsxutil.NewRealizeStateError("mocked realized error", 0)
 			})
 		defer patchRealize.Reset()
@@ -641,8 +640,8 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		defer patchPatch.Reset()
 		// Patch StaticRouteClient.Get to succeed, but realization check fails and delete fails
 		mockStaticRouteclient.EXPECT().Get("org1", "proj1", "vpc1", staticRouteID).Return(*nsxStaticRoute, nil).Times(1)
-		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeStateAsync,
+			func(_ *realizestate.RealizeStateService, _ string, _ []string, _ realizestate.RealizationOwner) error {
 				return nsxutil.NewRealizeStateError("mocked realized error", 0)
 			})
 		defer patchRealize.Reset()
@@ -685,8 +684,8 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		})
 		defer patchPatch.Reset()
 		// Patch Add to succeed, should return nil
-		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeState,
-			func(_ *realizestate.RealizeStateService, _ wait.Backoff, _ string, _ []string) error {
+		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeStateAsync,
+			func(_ *realizestate.RealizeStateService, _ string, _ []string, _ realizestate.RealizationOwner) error {
 				return nil
 			})
 		defer patchRealize.Reset()
@@ -694,6 +693,45 @@ func TestStaticRouteService_CreateOrUpdateStaticRoute(t *testing.T) {
 		err := service.CreateOrUpdateStaticRoute(context.Background(), "ns", &v1alpha1.StaticRoute{})
 		assert.NoError(t, err)
 	})
+
+	t.Run("return at once if the static route is being realized", func(t *testing.T) {
+		patchBuild := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "buildStaticRoute", func(_ *StaticRouteService, obj *v1alpha1.StaticRoute, networkIPAllocationPath string) (*model.StaticRoutes, error) {
+			return nsxStaticRoute, nil
+		})
+		defer patchBuild.Reset()
+		patchGetByIndex := gomonkey.ApplyMethod(reflect.TypeOf(service.StaticRouteStore), "GetStaticRoutesByCRUID", func(_ *StaticRouteStore, uid types.UID) *model.StaticRoutes {
+			return nil
+		})
+		defer patchGetByIndex.Reset()
+		patchVPC := gomonkey.ApplyMethod(reflect.TypeOf(service.VPCService), "ListVPCInfo", func(_ common.VPCServiceProvider, ns string) []common.VPCResourceInfo {
+			return []common.VPCResourceInfo{{OrgID: "org1", ProjectID: "proj1", VPCID: "vpc1", ID: "vpc1"}}
+		})
+		defer patchVPC.Reset()
+		patchPatch := gomonkey.ApplyPrivateMethod(reflect.TypeOf(service), "patch", func(_ *StaticRouteService, orgId, projectId, vpcId string, st *model.StaticRoutes) error {
+			return nil
+		})
+		defer patchPatch.Reset()
+		var watchedOwner realizestate.RealizationOwner
+		patchRealize := gomonkey.ApplyFunc((*realizestate.RealizeStateService).CheckRealizeStateAsync,
+			func(_ *realizestate.RealizeStateService, _ string, _ []string, owner realizestate.RealizationOwner) error {
+				watchedOwner = owner
+				return nsxutil.NewRealizationPendingError("being realized")
+			})
+		defer patchRealize.Reset()
+		patchDelete := gomonkey.ApplyMethod(reflect.TypeOf(service), "DeleteStaticRoute", func(_ *StaticRouteService, _ *model.StaticRoutes) error {
+			assert.Fail(t, "static route being realized should not be deleted")
+			return nil
+		})
+		defer patchDelete.Reset()
+		mockStaticRouteclient.EXPECT().Get("org1", "proj1", "vpc1", staticRouteID).Return(*nsxStaticRoute, nil).Times(1)
+
+		err := service.CreateOrUpdateStaticRoute(context.Background(), "ns", &v1alpha1.StaticRoute{ObjectMeta: v1.ObjectMeta{Namespace: "ns", Name: "sr1"}})
+		assert.True(t, nsxutil.IsRealizationPendingError(err))
+		assert.Equal(t, common.ResourceTypeStaticRoutes, watchedOwner.Kind)
+		assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: "sr1"}, watchedOwner.NamespacedName)
+		// The static route is saved to the store to be rechecked when the CR is requeued.
+		assert.NotNil(t, service.StaticRouteStore.GetByKey(staticRouteID))
+	})
 }
 
 func Test_isStaticRouteReady(t *testing.T) {
@@ -730,7 +768,7 @@ func Test_isStaticRouteReady(t *testing.T) {
 	assert.False(t, isStaticRouteReady(staticRouteUnready))
 }
 
-func TestResolveNetworkIPAllocationPath(t *testing.T) {
+func TestResolveNetworkIPAllocation(t *testing.T) {
 	const ns = "test-ns"
 	const crName = "my-alloc"
 	const nsxPath = "/orgs/default/projects/p1/vpcs/v1/ip-address-allocations/alloc-1"
@@ -754,7 +792,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 
 	t.Run("CR not found returns error", func(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{}) // no CR pre-loaded
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
 	})
@@ -769,7 +807,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 		svc.Client = mockK8s
 		svc.IPAllocationService = &fakeIPAllocationService{}
 
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to get IPAddressAllocation CR")
 		assert.Contains(t, err.Error(), "connection refused")
@@ -777,7 +815,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 
 	t.Run("NSX allocation lookup fails", func(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{err: fmt.Errorf("store error")}, allocCR)
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to look up NSX allocation")
 		assert.Contains(t, err.Error(), "store error")
@@ -785,7 +823,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 
 	t.Run("NSX allocation not in store returns error", func(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{allocation: nil, err: nil}, allocCR)
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found in store")
 	})
@@ -794,7 +832,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{
 			allocation: &model.VpcIpAddressAllocation{Path: nil},
 		}, allocCR)
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "has no policy path")
 	})
@@ -804,7 +842,7 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{
 			allocation: &model.VpcIpAddressAllocation{Path: &emptyPath},
 		}, allocCR)
-		_, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		_, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "has no policy path")
 	})
@@ -814,9 +852,9 @@ func TestResolveNetworkIPAllocationPath(t *testing.T) {
 		svc := makeService(&fakeIPAllocationService{
 			allocation: &model.VpcIpAddressAllocation{Path: &path},
 		}, allocCR)
-		got, err := svc.resolveNetworkIPAllocationPath(context.Background(), ns, crName)
+		got, err := svc.resolveNetworkIPAllocation(context.Background(), ns, crName)
 		assert.NoError(t, err)
-		assert.Equal(t, nsxPath, got)
+		assert.Equal(t, nsxPath, *got.Path)
 	})
 }
 
@@ -865,6 +903,22 @@ func TestCreateOrUpdateStaticRoute_WithNetworkIPAllocationName(t *testing.T) {
 		assert.Contains(t, err.Error(), "not found in store")
 	})
 
+	t.Run("next hop not in the IP family of the NSX allocation aborts without calling NSX", func(t *testing.T) {
+		allocCR := &v1alpha1.IPAddressAllocation{
+			ObjectMeta: v1.ObjectMeta{Name: crName, Namespace: ns, UID: "cr-uid-3"},
+		}
+		path := nsxPath
+		svc, ctrl, _ := createService(t)
+		defer ctrl.Finish()
+		svc.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(allocCR).Build()
+		svc.IPAllocationService = &fakeIPAllocationService{
+			allocation: &model.VpcIpAddressAllocation{Path: &path, IpAddressType: String(model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6)},
+		}
+
+		err := svc.CreateOrUpdateStaticRoute(context.Background(), ns, staticRouteCR)
+		assert.EqualError(t, err, "next hop 10.1.1.1 is not in the same IP family as the network IP allocation my-alloc")
+	})
+
 	t.Run("resolved path is forwarded to buildStaticRoute", func(t *testing.T) {
 		allocCR := &v1alpha1.IPAddressAllocation{
 			ObjectMeta: v1.ObjectMeta{Name: crName, Namespace: ns, UID: "cr-uid-2"},
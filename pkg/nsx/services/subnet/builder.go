@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -92,6 +93,10 @@ func (service *SubnetService) buildSubnetTags(obj client.Object, tags []model.Ta
 }
 
 func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, ipAddresses []string) (*model.VpcSubnet, error) {
+	if o, ok := obj.(*v1alpha1.Subnet); ok {
+		// Use the full slice expression to avoid modifying the tags of the caller.
+		tags = append(tags[:len(tags):len(tags)], common.BuildSegmentProfilesTags(o.Spec.AdvancedConfig.SegmentProfiles)...)
+	}
 	tags, err := service.buildSubnetTags(obj, tags)
 	if err != nil {
 		return nil, err
@@ -246,6 +251,16 @@ func (service *SubnetService) buildSubnet(obj client.Object, tags []model.Tag, i
 	return nsxSubnet, nil
 }
 
+// buildProfileBindingMaps validates the segment profiles of the Subnet and builds the profile binding maps as the
+// children of the VpcSubnet. hasBindings indicates whether the existing VpcSubnet has profiles bound.
+func (service *SubnetService) buildProfileBindingMaps(subnet *v1alpha1.Subnet, hasBindings bool) ([]*data.StructValue, error) {
+	profiles := subnet.Spec.AdvancedConfig.SegmentProfiles
+	if err := service.ValidateSegmentProfiles(profiles); err != nil {
+		return nil, err
+	}
+	return common.BuildSubnetProfileBindingMaps(profiles, hasBindings)
+}
+
 func (service *SubnetService) buildSubnetDHCPConfig(mode string, dhcpServerAdditionalConfig *model.DhcpServerAdditionalConfig) *model.SubnetDhcpConfig {
 	nsxMode := nsxutil.ParseDHCPMode(mode)
 	subnetDhcpConfig := &model.SubnetDhcpConfig{
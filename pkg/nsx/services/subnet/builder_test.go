@@ -1081,3 +1081,33 @@ func TestBuildSubnetForSubnet_IPv6(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildProfileBindingMaps(t *testing.T) {
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{QueryClient: &fakeQueryClient{}},
+		},
+	}
+	subnet := &v1alpha1.Subnet{}
+	children, err := service.buildProfileBindingMaps(subnet, false)
+	assert.NoError(t, err)
+	assert.Empty(t, children)
+
+	children, err = service.buildProfileBindingMaps(subnet, true)
+	assert.NoError(t, err)
+	assert.Len(t, children, 3)
+
+	subnet.Spec.AdvancedConfig.SegmentProfiles = v1alpha1.SegmentProfiles{
+		SegmentSecurityProfile: "/infra/segment-security-profiles/dhcp-allowed",
+		SpoofGuardProfile:      "/infra/spoofguard-profiles/relaxed",
+	}
+	children, err = service.buildProfileBindingMaps(subnet, false)
+	assert.NoError(t, err)
+	assert.Len(t, children, 1)
+
+	subnet.Spec.AdvancedConfig.SegmentProfiles.SpoofGuardProfile = "/infra/qos-profiles/gold"
+	_, err = service.buildProfileBindingMaps(subnet, false)
+	var validationErr *nsxutil.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.EqualError(t, err, "invalid SpoofGuard profile path \"/infra/qos-profiles/gold\"")
+}
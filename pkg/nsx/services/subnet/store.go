@@ -2,8 +2,11 @@ package subnet
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
@@ -143,3 +146,25 @@ func buildSubnetStore() *SubnetStore {
 		},
 	}
 }
+
+// subnetPathCollector is a Store which only records the paths of the NSX Subnets
+// returned by a search query, it is used to find the changed shared Subnets.
+type subnetPathCollector struct {
+	common.SearchCollector
+	paths sets.Set[string]
+}
+
+func (c *subnetPathCollector) TransResourceToStore(entity *data.StructValue) error {
+	obj, errs := common.NewConverter().ConvertToGolang(entity, model.VpcSubnetBindingType())
+	for _, err := range errs {
+		return err
+	}
+	subnet, ok := obj.(model.VpcSubnet)
+	if !ok {
+		return fmt.Errorf("unexpected search result type %T", obj)
+	}
+	if subnet.Path != nil {
+		c.paths.Insert(*subnet.Path)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
@@ -53,6 +54,8 @@ type SubnetService struct {
 	common.Service
 	SubnetStore *SubnetStore
 	builder     *common.PolicyTreeBuilder[*model.VpcSubnet]
+	// batchWriter coalesces the writes of the VpcSubnets if it is enabled.
+	batchWriter *common.PolicyTreeBatchWriter[*model.VpcSubnet]
 	// SharedSubnetData contains data related to shared subnets
 	SharedSubnetData
 }
@@ -75,6 +78,7 @@ func InitializeSubnetService(service common.Service) (*SubnetService, error) {
 		Service:     service,
 		SubnetStore: buildSubnetStore(),
 		builder:     builder,
+		batchWriter: common.NewBatchWriterByConfig(builder, service),
 		SharedSubnetData: SharedSubnetData{
 			NSXSubnetCache: make(map[string]struct {
 				Subnet     *model.VpcSubnet
@@ -208,23 +212,75 @@ func (service *SubnetService) CreateOrUpdateSubnet(obj client.Object, vpcInfo co
 			// unrealized Subnet will be saved to the store after full sync
 			// Recheck the realizedstate if the Subnet CR is not ready.
 			if !isSubnetReady(subnet) {
-				if err = service.checkSubnetRealizeState(nsxSubnet); err != nil {
+				if err = service.checkSubnetRealizeState(obj, nsxSubnet, false); err != nil {
 					return nil, err
 				}
 			}
 			log.Info("Subnet not changed, skip updating", "SubnetId", uid)
 			return existingSubnet, nil
 		}
+		// The segment profile binding maps are realized with the VpcSubnet in the same H-API call.
+		hasBindings := existingSubnet != nil && nsxutil.FindTag(existingSubnet.Tags, common.TagScopeSegmentProfiles) != ""
+		if nsxSubnet.Children, err = service.buildProfileBindingMaps(subnet, hasBindings); err != nil {
+			log.Error(err, "Failed to build segment profile binding maps", "SubnetId", uid)
+			return nil, err
+		}
 	}
 	return service.createOrUpdateSubnet(obj, nsxSubnet, &vpcInfo, false)
 }
 
-func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet) error {
+// subnetRealizationOwner returns the owner of the NSX Subnet watched by the realization watcher, it is the Subnet CR
+// or the SubnetSet.
+func subnetRealizationOwner(obj client.Object) realizestate.RealizationOwner {
+	kind := common.ResourceTypeSubnet
+	if _, ok := obj.(*v1alpha1.SubnetSet); ok {
+		kind = realizestate.RealizationKindSubnetSet
+	}
+	return realizestate.RealizationOwner{
+		Kind:           kind,
+		NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+	}
+}
+
+// checkSubnetRealizeState checks the realization state of the NSX Subnet. A RealizationPendingError is returned if the
+// NSX Subnet is not realized yet, and the Subnet CR or the SubnetSet is notified when the realization finishes. The
+// SubnetPorts waiting for the Subnet of the SubnetSet check it again with CheckSubnetSetSubnetRealizeState. updated
+// is set if the NSX Subnet is just patched, the result of the previous version is dropped then.
+func (service *SubnetService) checkSubnetRealizeState(obj client.Object, nsxSubnet *model.VpcSubnet, updated bool) error {
+	if updated {
+		realizestate.ForgetRealizeState(*nsxSubnet.Path)
+	}
 	realizeService := realizestate.InitializeRealizeState(service.Service)
+	err := realizeService.CheckRealizeStateAsync(*nsxSubnet.Path, []string{}, subnetRealizationOwner(obj))
+	if nsxutil.IsRealizationPendingError(err) {
+		log.Info("Subnet is being realized", "ID", *nsxSubnet.Id)
+		return err
+	}
+	return service.cleanupUnrealizedSubnet(nsxSubnet, err)
+}
+
+// CheckSubnetSetSubnetRealizeState checks the realization state of the NSX Subnet of the SubnetSet if it is watched by
+// the realization watcher, NSX is not checked otherwise. A RealizationPendingError is returned if it is not realized
+// yet, the SubnetSet status is updated once it is realized.
+func (service *SubnetService) CheckSubnetSetSubnetRealizeState(subnetSet *v1alpha1.SubnetSet, nsxSubnet *model.VpcSubnet) error {
+	watched, err := realizestate.CheckWatchedRealizeState(*nsxSubnet.Path, subnetRealizationOwner(subnetSet))
+	if !watched || nsxutil.IsRealizationPendingError(err) {
+		return err
+	}
+	if err = service.cleanupUnrealizedSubnet(nsxSubnet, err); err != nil {
+		service.updateSubnetSetConditionOnFail(subnetSet, err)
+		return err
+	}
+	service.removeSubnetSetConditionOnSuccess(subnetSet)
+	return service.UpdateSubnetSetStatus(subnetSet)
+}
+
+// cleanupUnrealizedSubnet deletes the NSX Subnet if the realization check fails with err.
+func (service *SubnetService) cleanupUnrealizedSubnet(nsxSubnet *model.VpcSubnet, err error) error {
 	// Failure of CheckRealizeState may result in the creation of an existing Subnet.
 	// For Subnets, it's important to reuse the already created NSXSubnet.
 	// For SubnetSets, since the ID includes a random value, the created NSX Subnet needs to be deleted and recreated.
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *nsxSubnet.Path, []string{}); err != nil {
+	if err != nil {
 		log.Error(err, "Failed to check Subnet realization state", "ID", *nsxSubnet.Id)
 		// Delete the subnet if the realization check fails, avoiding creating duplicate subnets continuously.
 		deleteErr := service.DeleteSubnet(*nsxSubnet)
@@ -238,8 +294,20 @@ func (service *SubnetService) checkSubnetRealizeState(nsxSubnet *model.VpcSubnet
 }
 
 func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
-	err := service.NSXClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
+	var err error
+	if service.batchWriter != nil {
+		batchedSubnet := *nsxSubnet
+		batchedSubnet.Path = common.String(service.builder.LeafPath(fmt.Sprintf(common.VPCKey, vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID), nsxSubnet))
+		err = service.batchWriter.Write(&batchedSubnet)
+	} else if len(nsxSubnet.Children) > 0 {
+		err = service.patchSubnetWithChildren(nsxSubnet, vpcInfo)
+	} else {
+		err = service.NSXClient.SubnetsClient.Patch(vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID, *nsxSubnet.Id, *nsxSubnet)
+	}
 	err = nsxutil.TransNSXApiError(err)
+	if nsxutil.ClassifyError(err) == nsxutil.ErrorReasonIPBlockExhausted {
+		err = nsxutil.IPBlockAllExhaustedError{Desc: fmt.Sprintf("failed to allocate the CIDR of nsxSubnet %s from the IP blocks: %v", *nsxSubnet.Id, err)}
+	}
 	if err != nil {
 		log.Error(err, "Failed to create or update nsxSubnet", "ID", *nsxSubnet.Id)
 		service.updateSubnetSetConditionOnFail(obj, err)
@@ -252,7 +320,16 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
 	}
-	err = service.checkSubnetRealizeState(nsxSubnet)
+	err = service.checkSubnetRealizeState(obj, nsxSubnet, true)
+	if nsxutil.IsRealizationPendingError(err) {
+		// The NSX Subnet is saved to the store before it is realized, so that its realization state is rechecked
+		// when the Subnet CR or the SubnetPorts waiting for the SubnetSet are requeued by the realization watcher.
+		if applyErr := service.SubnetStore.Apply(nsxSubnet); applyErr != nil {
+			log.Error(applyErr, "Failed to add nsxSubnet to store", "ID", *nsxSubnet.Id)
+			return nil, applyErr
+		}
+		return nil, err
+	}
 	if err != nil {
 		service.updateSubnetSetConditionOnFail(obj, err)
 		return nil, err
@@ -277,11 +354,28 @@ func (service *SubnetService) createOrUpdateSubnet(obj client.Object, nsxSubnet
 	return nsxSubnet, nil
 }
 
+// patchSubnetWithChildren creates or updates the VpcSubnet together with its children, e.g. the segment profile
+// binding maps, with H-API.
+func (service *SubnetService) patchSubnetWithChildren(nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo) error {
+	vpcPath := fmt.Sprintf(common.VPCKey, vpcInfo.OrgID, vpcInfo.ProjectID, vpcInfo.VPCID)
+	orgRoot, err := service.builder.BuildOrgRoot([]*model.VpcSubnet{nsxSubnet}, vpcPath)
+	if err != nil {
+		return err
+	}
+	enforceRevisionCheckParam := false
+	return service.NSXClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam)
+}
+
 func (service *SubnetService) DeleteSubnet(nsxSubnet model.VpcSubnet) error {
 	subnetInfo, _ := common.ParseVPCResourcePath(*nsxSubnet.Path)
 	nsxSubnet.MarkedForDelete = &MarkedForDelete
-	err := service.NSXClient.SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
-	err = nsxutil.TransNSXApiError(err)
+	var err error
+	if service.batchWriter != nil {
+		err = service.batchWriter.Write(&nsxSubnet)
+	} else {
+		err = service.NSXClient.SubnetsClient.Delete(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID)
+		err = nsxutil.TransNSXApiError(err)
+	}
 	if err != nil {
 		// GC will finally delete subnets that are not deleted successfully.
 		log.Error(err, "Failed to delete nsxSubnet", "ID", *nsxSubnet.Id)
@@ -581,6 +675,10 @@ func (service *SubnetService) UpdateSubnetSet(ns string, vpcSubnets []*model.Vpc
 		}
 		changed := common.CompareResource(SubnetToComparable(vpcSubnets[i]), SubnetToComparable(&updatedSubnet)) // #nosec G602
 		if !changed {
+			// Check the realization state of the previous update if it is still watched.
+			if err := service.CheckSubnetSetSubnetRealizeState(subnetSet, vpcSubnets[i]); err != nil { // #nosec G602
+				return fmt.Errorf("failed to realize Subnet %s in SubnetSet %s: %w", *vpcSubnet.Id, subnetSet.Name, err)
+			}
 			log.Info("NSX Subnet unchanged, skipping update", "Subnet", *vpcSubnet.Id)
 			continue
 		}
@@ -622,6 +720,20 @@ func (service *SubnetService) GetNSXSubnetByAssociatedResource(associatedResourc
 	return &nsxSubnet, nil
 }
 
+// ListChangedSubnetPaths searches NSX for the VpcSubnets modified after the given time and returns their paths.
+// The Subnets marked for delete are also returned so that the caller could notice the removal.
+func (service *SubnetService) ListChangedSubnetPaths(since time.Time) (sets.Set[string], error) {
+	queryParam := fmt.Sprintf("%s:%s AND %s:>%d", common.ResourceType, ResourceTypeSubnet, common.LastModifiedTime, since.UnixMilli())
+	collector := &subnetPathCollector{paths: sets.New[string]()}
+	count, err := service.SearchResource(ResourceTypeSubnet, queryParam, collector, nil)
+	if err != nil {
+		log.Error(err, "Failed to search changed NSX Subnets", "since", since)
+		return nil, err
+	}
+	log.Debug("Searched changed NSX Subnets", "since", since, "count", count)
+	return collector.paths, nil
+}
+
 // MapNSXSubnetToSubnetCR maps NSX subnet properties to Subnet CR properties
 func (service *SubnetService) MapNSXSubnetToSubnetCR(subnetCR *v1alpha1.Subnet, nsxSubnet *model.VpcSubnet) {
 	// Clear existing spec fields
@@ -927,6 +1039,24 @@ func (service *SubnetService) RemoveSharedSubnetFromResourceMap(associatedResour
 	}
 }
 
+// ListSharedSubnetResources returns the associatedResources in the resource map
+func (service *SubnetService) ListSharedSubnetResources() sets.Set[string] {
+	service.sharedSubnetResourceMapMutex.RLock()
+	defer service.sharedSubnetResourceMapMutex.RUnlock()
+	return sets.KeySet(service.SharedSubnetResourceMap)
+}
+
+// GetSharedSubnetNamespacedNames returns a copy of the namespaced names of the shared subnet CRs of the associatedResource
+func (service *SubnetService) GetSharedSubnetNamespacedNames(associatedResource string) (sets.Set[types.NamespacedName], bool) {
+	service.sharedSubnetResourceMapMutex.RLock()
+	defer service.sharedSubnetResourceMapMutex.RUnlock()
+	namespacedNames, exists := service.SharedSubnetResourceMap[associatedResource]
+	if !exists {
+		return nil, false
+	}
+	return namespacedNames.Clone(), true
+}
+
 // GetAllGatewayPrefixesOfSubnet returns all gateways (IPv4 and/or IPv6) with their prefixes for the subnet
 // This is used for dual-stack subnet support where both IPv4 and IPv6 gateways may be present
 func (service *SubnetService) GetAllGatewayPrefixesOfSubnet(nsxSubnet *model.VpcSubnet) ([]common.GatewayPrefixInfo, error) {
@@ -1037,7 +1167,7 @@ func (service *SubnetService) updateSubnetSetConditionOnFail(obj client.Object,
 			Type:               v1alpha1.SubnetCreationFailed,
 			Status:             v1.ConditionTrue,
 			Message:            fmt.Sprintf("Failed to create NSX Subnet: %v", err),
-			Reason:             "SubnetCreationFailed",
+			Reason:             nsxutil.ErrorReason(err, "SubnetCreationFailed"),
 			LastTransitionTime: metav1.Now(),
 		}
 		found := false
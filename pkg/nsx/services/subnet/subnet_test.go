@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +34,8 @@ import (
 	mockOrgRoot "github.com/vmware-tanzu/nsx-operator/pkg/mock/orgrootclient"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
@@ -617,6 +621,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 		restoreMode bool
 		setupMocks  func(p *gomonkey.Patches)
 		wantErr     bool
+		wantReason  string
 	}{
 		{
 			name:        "Successful creation in normal mode",
@@ -636,7 +641,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ client.Object, _ *model.VpcSubnet, _ bool) error {
 						return nil
 					})
 				p.ApplyMethod(reflect.TypeOf(service), "UpdateSubnetSetStatus",
@@ -664,7 +669,7 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 					})
 				// Patch the realization check
 				p.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState",
-					func(_ *SubnetService, _ *model.VpcSubnet) error {
+					func(_ *SubnetService, _ client.Object, _ *model.VpcSubnet, _ bool) error {
 						return nil
 					})
 			},
@@ -683,6 +688,21 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:        "Fail on IP blocks exhausted",
+			nsxSubnet:   &fakeSubnet,
+			vpcInfo:     &common.VPCResourceInfo{OrgID: "o", ProjectID: "p", VPCID: "v"},
+			restoreMode: false,
+			setupMocks: func(p *gomonkey.Patches) {
+				p.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Patch",
+					func(_ *fakeSubnetsClient, _, _, _, _ string, _ model.VpcSubnet) error {
+						errorType := apierrors.ErrorType_UNABLE_TO_ALLOCATE_RESOURCE
+						return apierrors.UnableToAllocateResource{Data: &data.StructValue{}, ErrorType: &errorType}
+					})
+			},
+			wantErr:    true,
+			wantReason: nsxutil.ErrorReasonIPBlockExhausted,
+		},
 	}
 
 	// 3. Execution Loop
@@ -703,6 +723,10 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, res)
+				if tt.wantReason != "" {
+					assert.ErrorAs(t, err, &nsxutil.IPBlockAllExhaustedError{})
+					assert.Equal(t, tt.wantReason, nsxutil.ErrorReason(err, ""))
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, res)
@@ -711,6 +735,56 @@ func TestSubnetService_createOrUpdateSubnet(t *testing.T) {
 	}
 }
 
+func TestSubnetService_createOrUpdateSubnet_RealizationPending(t *testing.T) {
+	subnetPath := "/orgs/default/projects/default/vpcs/default/subnets/subnet-pending"
+	fakeSubnet := model.VpcSubnet{
+		Id:   common.String("subnet-pending"),
+		Path: common.String(subnetPath),
+		Tags: []model.Tag{
+			{
+				Scope: common.String(common.TagScopeSubnetCRUID),
+				Tag:   common.String("subnet-uid"),
+			},
+		},
+		DisplayName: common.String("subnet-pending"),
+		ParentPath:  common.String("/orgs/default/projects/default/vpcs/default"),
+	}
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				SubnetsClient:          &fakeSubnetsClient{},
+				RealizedEntitiesClient: &fakeRealizedEntitiesClient{},
+			},
+		},
+		SubnetStore: buildSubnetStore(),
+	}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Get",
+		func(_ *fakeSubnetsClient, _, _, _, _ string) (model.VpcSubnet, error) {
+			return fakeSubnet, nil
+		})
+	patches.ApplyMethod(reflect.TypeOf(&fakeRealizedEntitiesClient{}), "List",
+		func(_ *fakeRealizedEntitiesClient, _ string, _ *string) (model.GenericPolicyRealizedResourceListResult, error) {
+			return model.GenericPolicyRealizedResourceListResult{
+				Results: []model.GenericPolicyRealizedResource{
+					{State: common.String(model.GenericPolicyRealizedResource_STATE_UNREALIZED)},
+				},
+			}, nil
+		})
+	patches.ApplyMethod(reflect.TypeOf(service), "DeleteSubnet", func(_ *SubnetService, _ model.VpcSubnet) error {
+		assert.FailNow(t, "The Subnet being realized should not be deleted")
+		return nil
+	})
+	defer patches.Reset()
+	defer realizestate.ForgetRealizeState(subnetPath)
+
+	obj := &v1alpha1.Subnet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "subnet-1", UID: "subnet-uid"}}
+	res, err := service.createOrUpdateSubnet(obj, &fakeSubnet, &common.VPCResourceInfo{OrgID: "o", ProjectID: "p", VPCID: "v"}, false)
+	assert.Nil(t, res)
+	assert.True(t, nsxutil.IsRealizationPendingError(err))
+	// The Subnet is saved to the store to recheck its realization state when the Subnet CR is requeued.
+	assert.Len(t, service.SubnetStore.GetByIndex(common.TagScopeSubnetCRUID, "subnet-uid"), 1)
+}
+
 func TestSubnetService_DeleteSubnet(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mockClient.NewMockClient(mockCtl)
@@ -2480,6 +2554,163 @@ func TestSubnetService_CreateOrUpdateSubnet_ConnectivityState(t *testing.T) {
 	}
 }
 
+func TestSubnetService_CreateOrUpdateSubnet_SegmentProfiles(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	k8sClient := mockClient.NewMockClient(mockCtl)
+	defer mockCtl.Finish()
+
+	service := &SubnetService{
+		Service: common.Service{
+			Client: k8sClient,
+			NSXClient: &nsx.Client{
+				QueryClient:        &fakeQueryClient{},
+				SubnetsClient:      &fakeSubnetsClient{},
+				SubnetStatusClient: &fakeSubnetStatusClient{},
+			},
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{
+					Cluster: "k8scl-one:test",
+				},
+			},
+		},
+	}
+
+	uuidStr := "test-uuid-profiles"
+	basicTags := []model.Tag{
+		{Scope: String(common.TagScopeSubnetCRName), Tag: String("subnet-profiles")},
+		{Scope: String(common.TagScopeSubnetCRUID), Tag: String(uuidStr)},
+		{Scope: String(common.TagScopeNamespaceUID), Tag: String("ns1")},
+	}
+	profiles := v1alpha1.SegmentProfiles{MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"}
+	vpcResourceInfo, _ := common.ParseVPCResourcePath("/orgs/default/projects/test/vpcs/vpc1")
+
+	testCases := []struct {
+		name             string
+		profiles         v1alpha1.SegmentProfiles
+		existingSubnet   *model.VpcSubnet
+		expectedChildren int
+		expectedTag      bool
+	}{
+		{
+			name:             "create subnet with segment profiles",
+			profiles:         profiles,
+			expectedChildren: 1,
+			expectedTag:      true,
+		},
+		{
+			name:             "create subnet without segment profiles",
+			expectedChildren: 0,
+		},
+		{
+			name: "remove segment profiles from existing subnet",
+			existingSubnet: &model.VpcSubnet{
+				Id:          String("existing-subnet-id"),
+				DisplayName: String("existing-subnet-name"),
+				Tags:        append(basicTags, common.BuildSegmentProfilesTags(profiles)...),
+			},
+			expectedChildren: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service.SubnetStore = buildSubnetStore()
+			if tc.existingSubnet != nil {
+				require.NoError(t, service.SubnetStore.Apply(tc.existingSubnet))
+			}
+			subnetCR := &v1alpha1.Subnet{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       types.UID(uuidStr),
+					Name:      "subnet-profiles",
+					Namespace: "ns1",
+				},
+				Spec: v1alpha1.SubnetSpec{
+					IPAddresses: []string{"10.0.0.0/24"},
+					AdvancedConfig: v1alpha1.SubnetAdvancedConfig{
+						StaticIPAllocation: v1alpha1.StaticIPAllocation{Enabled: common.Bool(true)},
+						SegmentProfiles:    tc.profiles,
+					},
+				},
+			}
+
+			updateCalled := false
+			patches := gomonkey.ApplyFunc((*SubnetService).createOrUpdateSubnet, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, vpcInfo *common.VPCResourceInfo, restoreMode bool) (*model.VpcSubnet, error) {
+				updateCalled = true
+				assert.Len(t, nsxSubnet.Children, tc.expectedChildren)
+				hasTag := false
+				for _, tag := range nsxSubnet.Tags {
+					if *tag.Scope == common.TagScopeSegmentProfiles {
+						hasTag = true
+					}
+				}
+				assert.Equal(t, tc.expectedTag, hasTag)
+				return nsxSubnet, nil
+			})
+			patches.ApplyFunc(controllerscommon.IsNamespaceInTepLessMode, func(_ client.Client, _ string) (bool, error) {
+				return false, nil
+			})
+			defer patches.Reset()
+
+			_, err := service.CreateOrUpdateSubnet(subnetCR, vpcResourceInfo, basicTags)
+			require.NoError(t, err)
+			assert.True(t, updateCalled)
+		})
+	}
+}
+
+func TestSubnetService_createOrUpdateSubnetWithChildren(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	defer mockCtl.Finish()
+	mockOrgRootClient := mockOrgRoot.NewMockOrgRootClient(mockCtl)
+
+	builder, _ := common.PolicyPathVpcSubnet.NewPolicyTreeBuilder()
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				OrgRootClient:      mockOrgRootClient,
+				SubnetsClient:      &fakeSubnetsClient{},
+				SubnetStatusClient: &fakeSubnetStatusClient{},
+			},
+		},
+		SubnetStore: buildSubnetStore(),
+		builder:     builder,
+	}
+	children, err := common.BuildSubnetProfileBindingMaps(v1alpha1.SegmentProfiles{QoSProfile: "/infra/qos-profiles/gold"}, false)
+	require.NoError(t, err)
+	nsxSubnet := &model.VpcSubnet{
+		Id:          common.String("subnet-1"),
+		DisplayName: common.String("subnet-1"),
+		Children:    children,
+	}
+	createdSubnet := model.VpcSubnet{
+		Id:          common.String("subnet-1"),
+		DisplayName: common.String("subnet-1"),
+		Path:        common.String("/orgs/o/projects/p/vpcs/v/subnets/subnet-1"),
+		ParentPath:  common.String("/orgs/o/projects/p/vpcs/v"),
+	}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Patch", func(_ *fakeSubnetsClient, _, _, _, _ string, _ model.VpcSubnet) error {
+		assert.FailNow(t, "SubnetsClient.Patch should not be called if the Subnet has children")
+		return nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(&fakeSubnetsClient{}), "Get", func(_ *fakeSubnetsClient, _, _, _, _ string) (model.VpcSubnet, error) {
+		return createdSubnet, nil
+	})
+	patches.ApplyPrivateMethod(reflect.TypeOf(service), "checkSubnetRealizeState", func(_ *SubnetService, _ client.Object, _ *model.VpcSubnet, _ bool) error {
+		return nil
+	})
+	mockOrgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	res, err := service.createOrUpdateSubnet(&v1alpha1.Subnet{}, nsxSubnet, &common.VPCResourceInfo{OrgID: "o", ProjectID: "p", VPCID: "v"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "/orgs/o/projects/p/vpcs/v/subnets/subnet-1", *res.Path)
+
+	mockOrgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(errors.New("nsx-error")).Times(1)
+	_, err = service.createOrUpdateSubnet(&v1alpha1.Subnet{}, nsxSubnet, &common.VPCResourceInfo{OrgID: "o", ProjectID: "p", VPCID: "v"}, false)
+	assert.Error(t, err)
+}
+
 func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 	mockCtl := gomock.NewController(t)
 	k8sClient := mockClient.NewMockClient(mockCtl)
@@ -2597,7 +2828,7 @@ func TestSubnetService_CreateOrUpdateSubnet_Consistency(t *testing.T) {
 				}
 				return nil, nil
 			})
-			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, nsxSubnet *model.VpcSubnet) error {
+			patches.ApplyFunc((*SubnetService).checkSubnetRealizeState, func(service *SubnetService, obj client.Object, nsxSubnet *model.VpcSubnet, updated bool) error {
 				return nil
 			})
 			defer patches.Reset()
@@ -3068,3 +3299,78 @@ func TestSubnetService_GetAllGatewayPrefixesOfSubnet_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestSubnetService_ListChangedSubnetPaths(t *testing.T) {
+	service := &SubnetService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{
+				QueryClient: &fakeQueryClient{},
+			},
+		},
+	}
+	since := time.UnixMilli(1700000000000)
+
+	t.Run("Search succeeds", func(t *testing.T) {
+		var queryParam string
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeQueryClient{}), "List", func(_ *fakeQueryClient, query string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			queryParam = query
+			cursor := "2"
+			resultCount := int64(2)
+			return model.SearchResponse{
+				Results: []*data.StructValue{
+					data.NewStructValue("", map[string]data.DataValue{
+						"resource_type": data.NewStringValue("VpcSubnet"),
+						"id":            data.NewStringValue("subnet1"),
+						"path":          data.NewStringValue("/orgs/default/projects/proj-1/vpcs/vpc-1/subnets/subnet1"),
+					}),
+					data.NewStructValue("", map[string]data.DataValue{
+						"resource_type":     data.NewStringValue("VpcSubnet"),
+						"id":                data.NewStringValue("subnet2"),
+						"path":              data.NewStringValue("/orgs/default/projects/proj-1/vpcs/vpc-1/subnets/subnet2"),
+						"marked_for_delete": data.NewBooleanValue(true),
+					}),
+				},
+				Cursor: &cursor, ResultCount: &resultCount,
+			}, nil
+		})
+		defer patches.Reset()
+
+		paths, err := service.ListChangedSubnetPaths(since)
+		require.NoError(t, err)
+		assert.Equal(t, "resource_type:VpcSubnet AND _last_modified_time:>1700000000000", queryParam)
+		assert.ElementsMatch(t, []string{
+			"/orgs/default/projects/proj-1/vpcs/vpc-1/subnets/subnet1",
+			"/orgs/default/projects/proj-1/vpcs/vpc-1/subnets/subnet2",
+		}, paths.UnsortedList())
+	})
+
+	t.Run("Search fails", func(t *testing.T) {
+		patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeQueryClient{}), "List", func(_ *fakeQueryClient, _ string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+			return model.SearchResponse{}, errors.New("search error")
+		})
+		defer patches.Reset()
+
+		paths, err := service.ListChangedSubnetPaths(since)
+		assert.Error(t, err)
+		assert.Nil(t, paths)
+	})
+}
+
+func TestSubnetService_SharedSubnetResourceMap(t *testing.T) {
+	service := &SubnetService{SharedSubnetResourceMap: map[string]sets.Set[types.NamespacedName]{}}
+	subnet1 := types.NamespacedName{Namespace: "ns-1", Name: "subnet-1"}
+	service.AddSharedSubnetToResourceMap("proj-1:vpc-1:subnet-1", subnet1)
+
+	assert.Equal(t, sets.New("proj-1:vpc-1:subnet-1"), service.ListSharedSubnetResources())
+	namespacedNames, ok := service.GetSharedSubnetNamespacedNames("proj-1:vpc-1:subnet-1")
+	require.True(t, ok)
+	assert.Equal(t, sets.New(subnet1), namespacedNames)
+	// The returned set is a copy
+	namespacedNames.Insert(types.NamespacedName{Namespace: "ns-2", Name: "subnet-1"})
+	assert.Equal(t, 1, service.SharedSubnetResourceMap["proj-1:vpc-1:subnet-1"].Len())
+
+	service.RemoveSharedSubnetFromResourceMap("proj-1:vpc-1:subnet-1", subnet1)
+	_, ok = service.GetSharedSubnetNamespacedNames("proj-1:vpc-1:subnet-1")
+	assert.False(t, ok)
+	assert.Empty(t, service.ListSharedSubnetResources())
+}
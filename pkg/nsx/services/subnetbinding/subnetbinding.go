@@ -264,16 +264,21 @@ func (s *BindingService) deleteSubnetConnectionBindingMaps(bindingMaps []*model.
 	})
 }
 
-func (s *BindingService) DeleteMultiSubnetConnectionBindingMapsByCRs(bindingCRs sets.Set[string]) error {
-	if bindingCRs.Len() == 0 {
-		return nil
-	}
+// ListSubnetConnectionBindingMapsByCRs returns the NSX SubnetConnectionBindingMaps created for the given CR UIDs.
+func (s *BindingService) ListSubnetConnectionBindingMapsByCRs(bindingCRs sets.Set[string]) []*model.SubnetConnectionBindingMap {
 	finalBindingMaps := make([]*model.SubnetConnectionBindingMap, 0)
-	for crID := range bindingCRs {
+	for _, crID := range sets.List(bindingCRs) {
 		bms := s.BindingStore.getBindingsByBindingMapCRUID(crID)
 		finalBindingMaps = append(finalBindingMaps, bms...)
 	}
-	return s.deleteSubnetConnectionBindingMaps(finalBindingMaps)
+	return finalBindingMaps
+}
+
+func (s *BindingService) DeleteMultiSubnetConnectionBindingMapsByCRs(bindingCRs sets.Set[string]) error {
+	if bindingCRs.Len() == 0 {
+		return nil
+	}
+	return s.deleteSubnetConnectionBindingMaps(s.ListSubnetConnectionBindingMapsByCRs(bindingCRs))
 }
 
 func (s *BindingService) GetSubnetConnectionBindingMapCRName(bindingMap *model.SubnetConnectionBindingMap) string {
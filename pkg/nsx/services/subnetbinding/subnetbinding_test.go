@@ -383,6 +383,20 @@ func TestCreateOrUpdateSubnetConnectionBindingMap(t *testing.T) {
 	}
 }
 
+func TestListSubnetConnectionBindingMapsByCRs(t *testing.T) {
+	svc := &BindingService{
+		BindingStore: SetupStore(),
+	}
+	svc.BindingStore.Add(createdBM1)
+	svc.BindingStore.Add(createdBM2)
+
+	bms := svc.ListSubnetConnectionBindingMapsByCRs(sets.New[string]("uuid-binding1"))
+	assert.ElementsMatch(t, []*model.SubnetConnectionBindingMap{createdBM1, createdBM2}, bms)
+
+	bms = svc.ListSubnetConnectionBindingMapsByCRs(sets.New[string]("uuid-unknown"))
+	assert.Empty(t, bms)
+}
+
 func TestDeleteMultiSubnetConnectionBindingMapsByCRs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockOrgRootClient := orgroot_mocks.NewMockOrgRootClient(ctrl)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"slices"
 	"sort"
 	"strings"
@@ -12,10 +13,12 @@ import (
 	"github.com/gofrs/uuid"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
@@ -46,6 +49,7 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	var addressBindings []model.PortAddressBindingEntry
 	var hasMacSpecified bool
 	var staticIpAllocationType string
+	var staticAddressKey string
 	switch o := obj.(type) {
 	case *v1alpha1.SubnetPort:
 		externalAddressBinding, err = service.buildExternalAddressBinding(o, restoreMode)
@@ -100,6 +104,25 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 			} else {
 				log.Error(nil, "MAC address annotation not found in Pod", "Pod", o)
 			}
+		} else {
+			staticMAC, staticIPs, err := GetPodStaticAddresses(o)
+			if err != nil {
+				return nil, err
+			}
+			for i := range staticIPs {
+				addressBindings = append(addressBindings, model.PortAddressBindingEntry{IpAddress: &staticIPs[i]})
+			}
+			// Same as the SubnetPort, the MAC is not specified if StaticIPAllocation is disabled and no IP is specified
+			if staticMAC != "" && (util.NSXSubnetStaticIPAllocationEnabled(nsxSubnet) || len(staticIPs) > 0) {
+				if len(addressBindings) == 0 {
+					addressBindings = []model.PortAddressBindingEntry{{}}
+				}
+				for i := range addressBindings {
+					addressBindings[i].MacAddress = &staticMAC
+				}
+				hasMacSpecified = true
+			}
+			staticAddressKey = getPodStaticAddressKey(staticMAC, staticIPs)
 		}
 		if util.NSXSubnetStaticIPAllocationEnabled(nsxSubnet) {
 			staticIpAllocationType = controllercommon.ConvertCRIPAddressTypeToNSX(interfaceIPType)
@@ -147,7 +170,10 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	}
 	namespaceUid := namespace.UID
 
-	nsxSubnetPortID, nsxSubnetPortName := service.BuildSubnetPortIdAndName(objMeta, namespaceUid, stsUID)
+	nsxSubnetPortID, nsxSubnetPortName, err := service.BuildSubnetPortIdAndName(objMeta, namespaceUid, stsUID, staticAddressKey)
+	if err != nil {
+		return nil, err
+	}
 	nsxSubnetPortPath := fmt.Sprintf("%s/ports/%s", *nsxSubnet.Path, nsxSubnetPortID)
 
 	tags := util.BuildBasicTags(getCluster(service), obj, namespaceUid)
@@ -183,6 +209,17 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 			tagsFiltered = append(tagsFiltered, model.Tag{Scope: common.String(k), Tag: common.String((*labelTags)[k])})
 		}
 	}
+	bandwidth, err := getPortBandwidth(obj)
+	if err != nil {
+		return nil, err
+	}
+	tagsFiltered = append(tagsFiltered, common.BuildSegmentProfilesTags(service.getSegmentProfiles(obj, bandwidth))...)
+	if bandwidth != nil {
+		tagsFiltered = append(tagsFiltered, model.Tag{Scope: String(common.TagScopePortBandwidth), Tag: String(bandwidth.key())})
+	}
+	if staticAddressKey != "" {
+		tagsFiltered = append(tagsFiltered, model.Tag{Scope: String(common.TagScopePodStaticAddress), Tag: String(staticAddressKey)})
+	}
 
 	nsxSubnetPort := &model.VpcSubnetPort{
 		DisplayName: String(nsxSubnetPortName),
@@ -212,6 +249,19 @@ func (service *SubnetPortService) buildSubnetPort(obj interface{}, nsxSubnet *mo
 	return nsxSubnetPort, nil
 }
 
+// buildProfileBindingMaps validates the segment profiles of the SubnetPort and builds the profile binding maps as the
+// children of the VpcSubnetPort, including the QoS profile for the bandwidth limits of the SubnetPort or the Pod.
+// hasBindings indicates whether the existing VpcSubnetPort has profiles bound.
+func (service *SubnetPortService) buildProfileBindingMaps(obj interface{}, bandwidth *portBandwidth, hasBindings bool) ([]*data.StructValue, error) {
+	if subnetPort, ok := obj.(*v1alpha1.SubnetPort); ok {
+		// The QoS profile for the bandwidth limits is not validated, as it may not be searchable right after creation.
+		if err := service.ValidateSegmentProfiles(subnetPort.Spec.SegmentProfiles); err != nil {
+			return nil, err
+		}
+	}
+	return common.BuildPortProfileBindingMaps(service.getSegmentProfiles(obj, bandwidth), hasBindings)
+}
+
 // getStatefulSetInfo returns the StatefulSet name and UID if the pod's controller
 // is a StatefulSet (matches real API server behavior: the STS sets controller=true).
 func getStatefulSetInfo(obj interface{}) (string, string) {
@@ -226,10 +276,92 @@ func getStatefulSetInfo(obj interface{}) (string, string) {
 	return "", ""
 }
 
-func (service *SubnetPortService) BuildSubnetPortIdAndName(obj *metav1.ObjectMeta, namespaceUID types.UID, stsUID string) (string, string) {
+// GetPodStaticAddresses returns the MAC address and IP addresses pinned by the annotations of the Pod. At most one IP
+// address is allowed for each IP family.
+func GetPodStaticAddresses(pod *corev1.Pod) (string, []string, error) {
+	annotations := pod.GetAnnotations()
+	var staticMAC string
+	var staticIPs []string
+	if value := strings.TrimSpace(annotations[common.AnnotationPodStaticMAC]); value != "" {
+		mac, err := net.ParseMAC(value)
+		if err != nil {
+			return "", nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid MAC address %q in annotation %s", value, common.AnnotationPodStaticMAC)}
+		}
+		staticMAC = mac.String()
+	}
+	if value := strings.TrimSpace(annotations[common.AnnotationPodStaticIPs]); value != "" {
+		hasIPv4, hasIPv6 := false, false
+		for _, ipStr := range strings.Split(value, ",") {
+			ip := net.ParseIP(strings.TrimSpace(ipStr))
+			if ip == nil {
+				return "", nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid IP address %q in annotation %s", ipStr, common.AnnotationPodStaticIPs)}
+			}
+			if ip.To4() != nil {
+				if hasIPv4 {
+					return "", nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("more than one IPv4 address in annotation %s", common.AnnotationPodStaticIPs)}
+				}
+				hasIPv4 = true
+			} else {
+				if hasIPv6 {
+					return "", nil, &nsxutil.ValidationError{Desc: fmt.Sprintf("more than one IPv6 address in annotation %s", common.AnnotationPodStaticIPs)}
+				}
+				hasIPv6 = true
+			}
+			staticIPs = append(staticIPs, ip.String())
+		}
+	}
+	return staticMAC, staticIPs, nil
+}
+
+// GetPodStaticAddressKey returns the key of the addresses pinned by the annotations of the Pod, or empty if the Pod
+// pins no valid addresses.
+func GetPodStaticAddressKey(pod *corev1.Pod) string {
+	staticMAC, staticIPs, err := GetPodStaticAddresses(pod)
+	if err != nil {
+		return ""
+	}
+	return getPodStaticAddressKey(staticMAC, staticIPs)
+}
+
+// getPodStaticAddressKey returns the key to identify the SubnetPort with the pinned addresses, the MAC address is used
+// if it is pinned as it is unique across the IP families.
+func getPodStaticAddressKey(staticMAC string, staticIPs []string) string {
+	if staticMAC != "" {
+		return staticMAC
+	}
+	return strings.Join(staticIPs, ",")
+}
+
+// getPinnedSubnetPort returns the SubnetPort retained with the pinned addresses after its Pod is deleted, so that it
+// can be taken over by the Pod which replaces it. An error is returned if the Pod holding the pinned addresses still
+// exists, including when it is terminating, as its VIF may still be in use.
+func (service *SubnetPortService) getPinnedSubnetPort(obj *metav1.ObjectMeta, staticAddressKey string) (*model.VpcSubnetPort, error) {
+	for _, port := range service.SubnetPortStore.GetByIndex(common.TagScopePodStaticAddress, staticAddressKey) {
+		if nsxutil.FindTag(port.Tags, common.TagScopeNamespace) != obj.Namespace {
+			continue
+		}
+		podName := nsxutil.FindTag(port.Tags, common.TagScopePodName)
+		podUID := nsxutil.FindTag(port.Tags, common.TagScopePodUID)
+		pod := &corev1.Pod{}
+		if err := service.Client.Get(context.TODO(), types.NamespacedName{Namespace: obj.Namespace, Name: podName}, pod); err != nil {
+			if apierrors.IsNotFound(err) {
+				return port, nil
+			}
+			return nil, err
+		}
+		// The Pod with the same name is recreated after the Pod holding the pinned addresses is deleted.
+		if string(pod.UID) != podUID {
+			return port, nil
+		}
+		return nil, fmt.Errorf("pinned addresses %s are held by Pod %s/%s, waiting for it to be deleted", staticAddressKey, obj.Namespace, podName)
+	}
+	return nil, nil
+}
+
+func (service *SubnetPortService) BuildSubnetPortIdAndName(obj *metav1.ObjectMeta, namespaceUID types.UID, stsUID string, staticAddressKey string) (string, string, error) {
 	existingSubnetPort, err := service.SubnetPortStore.GetVpcSubnetPortByUID(obj.GetUID())
 	if err == nil && existingSubnetPort != nil {
-		return *existingSubnetPort.Id, *existingSubnetPort.DisplayName
+		return *existingSubnetPort.Id, *existingSubnetPort.DisplayName, nil
 	}
 
 	// For StatefulSet pods: check if a SubnetPort with the same StatefulSet UID and pod name exists
@@ -244,11 +376,25 @@ func (service *SubnetPortService) BuildSubnetPortIdAndName(obj *metav1.ObjectMet
 			if portName == obj.Name {
 				log.Info("Reusing existing SubnetPort for StatefulSet pod",
 					"podName", obj.Name, "stsUID", stsUID)
-				return *port.Id, *port.DisplayName
+				return *port.Id, *port.DisplayName, nil
 			}
 		}
 	}
 
+	// For Pods with pinned addresses: the SubnetPort is retained after its Pod is deleted, and taken over by the Pod
+	// which replaces it with the same pinned addresses.
+	if staticAddressKey != "" {
+		port, err := service.getPinnedSubnetPort(obj, staticAddressKey)
+		if err != nil {
+			return "", "", err
+		}
+		if port != nil {
+			log.Info("Reusing existing SubnetPort with pinned addresses for Pod",
+				"podName", obj.Name, "staticAddress", staticAddressKey, "nsxSubnetPort.Id", *port.Id)
+			return *port.Id, service.BuildSubnetPortName(obj), nil
+		}
+	}
+
 	// Note: we will use the Pod or Subnet CR's name and the Namespace UID to generate the NSX VpcSubnetPort's id.
 	objWithNamespaceUID := &metav1.ObjectMeta{
 		Name: obj.Name,
@@ -256,7 +402,7 @@ func (service *SubnetPortService) BuildSubnetPortIdAndName(obj *metav1.ObjectMet
 	}
 	return common.BuildUniqueIDWithRandomUUID(objWithNamespaceUID, util.GenerateIDByObject, func(id string) bool {
 		return service.SubnetPortStore.GetByKey(id) != nil
-	}), service.BuildSubnetPortName(obj)
+	}), service.BuildSubnetPortName(obj), nil
 }
 
 func (service *SubnetPortService) BuildSubnetPortName(obj *metav1.ObjectMeta) string {
@@ -297,12 +443,11 @@ func (service *SubnetPortService) buildExternalAddressBinding(sp *v1alpha1.Subne
 		}
 		portExternalAddressBinding.AllocatedExternalIpPath = ipAllocationModel.Path
 	} else if restoreMode && len(addressBinding.Status.IPAddress) > 0 {
-		ns := sp.Namespace
-		VPCInfo := service.VPCService.ListVPCInfo(ns)
-		if len(VPCInfo) == 0 {
-			return nil, fmt.Errorf("failed to listVPCInfo for AddressBinding")
+		VPCInfo, err := common.GetVPCInfoByName(service.VPCService, sp.Namespace, sp.Spec.VPCName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listVPCInfo for AddressBinding: %w", err)
 		}
-		vpcPath := VPCInfo[0].GetVPCPath()
+		vpcPath := VPCInfo.GetVPCPath()
 		existingAddressAllocation, err := service.IpAddressAllocationService.GetIPAddressAllocationByOwner(addressBinding)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find an existing external AddressBidning: %v", err)
@@ -12,6 +12,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
@@ -24,6 +25,7 @@ import (
 	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
 )
 
 func TestBuildSubnetPort(t *testing.T) {
@@ -117,6 +119,53 @@ func TestBuildSubnetPort(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:            "build-NSX-port-for-subnetport-with-segment-profiles",
+			interfaceIPType: v1alpha1.IPAddressTypeIPv4,
+			obj: &v1alpha1.SubnetPort{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "2ccec3b9-7546-4fd2-812a-1e3a4afd7acc",
+					Name:      "fake_subnetport",
+					Namespace: "fake_ns",
+				},
+				Spec: v1alpha1.SubnetPortSpec{
+					StaticIPAllocationType: v1alpha1.StaticIPAllocationTypeNone,
+					SegmentProfiles: v1alpha1.SegmentProfiles{
+						MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning",
+					},
+				},
+			},
+			nsxSubnet: &model.VpcSubnet{
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_SERVER"),
+				},
+				Path: common.String("fake_path"),
+			},
+			contextID: "fake_context_id",
+			labelTags: nil,
+			expectedPort: &model.VpcSubnetPort{
+				DisplayName: common.String("fake_subnetport"),
+				Id:          common.String("fake_subnetport_phoia"),
+				Tags: []model.Tag{
+					{Scope: common.String("nsx-op/cluster"), Tag: common.String("fake_cluster")},
+					{Scope: common.String("nsx-op/version"), Tag: common.String("1.0.0")},
+					{Scope: common.String("nsx-op/namespace"), Tag: common.String("fake_ns")},
+					{Scope: common.String("nsx-op/subnetport_name"), Tag: common.String("fake_subnetport")},
+					{Scope: common.String("nsx-op/subnetport_uid"), Tag: common.String("2ccec3b9-7546-4fd2-812a-1e3a4afd7acc")},
+					common.BuildSegmentProfilesTags(v1alpha1.SegmentProfiles{MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning"})[0],
+				},
+				Path:       common.String("fake_path/ports/fake_subnetport_phoia"),
+				ParentPath: common.String("fake_path"),
+				Attachment: &model.PortAttachment{
+					AllocateAddresses: common.String("NONE"),
+					Type_:             common.String(model.PortAttachment_TYPE_INDEPENDENT),
+					Id:                common.String("32636365-6333-4239-ad37-3534362d3466"),
+					TrafficTag:        common.Int64(0),
+				},
+				StaticIpAllocationType: common.String(controllercommon.NSXIPAddressTypeNone),
+			},
+			expectedError: nil,
+		},
 		{
 			name:            "build-NSX-port-in-subnet-dhcp-with-binding-in-nsx-mac-pool",
 			interfaceIPType: v1alpha1.IPAddressTypeIPv4,
@@ -551,6 +600,67 @@ func TestBuildSubnetPort(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:            "build-NSX-port-for-pod-with-pinned-addresses",
+			interfaceIPType: v1alpha1.IPAddressTypeIPv4,
+			obj: &corev1.Pod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Pod",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "c5db1800-ce4c-11de-a935-8105ba7ace78",
+					Name:      "fake_pod",
+					Namespace: "fake_ns",
+					Annotations: map[string]string{
+						common.AnnotationPodStaticMAC: "04:50:56:00:FA:01",
+						common.AnnotationPodStaticIPs: "10.0.0.5",
+					},
+				},
+			},
+			nsxSubnet: &model.VpcSubnet{
+				AdvancedConfig: &model.SubnetAdvancedConfig{
+					StaticIpAllocation: &model.StaticIpAllocation{
+						Enabled: common.Bool(true),
+					},
+				},
+				SubnetDhcpConfig: &model.SubnetDhcpConfig{
+					Mode: common.String("DHCP_DEACTIVATED"),
+				},
+				Path: common.String("fake_path"),
+			},
+			contextID: "fake_context_id",
+			expectedPort: &model.VpcSubnetPort{
+				DisplayName: common.String("fake_pod"),
+				Id:          common.String("fake_pod_phoia"),
+				Tags: []model.Tag{
+					{Scope: common.String("nsx-op/cluster"), Tag: common.String("fake_cluster")},
+					{Scope: common.String("nsx-op/version"), Tag: common.String("1.0.0")},
+					{Scope: common.String("nsx-op/namespace"), Tag: common.String("fake_ns")},
+					{Scope: common.String("nsx-op/pod_name"), Tag: common.String("fake_pod")},
+					{Scope: common.String("nsx-op/pod_uid"), Tag: common.String("c5db1800-ce4c-11de-a935-8105ba7ace78")},
+					{Scope: common.String("nsx-op/pod_static_address"), Tag: common.String("04:50:56:00:fa:01")},
+				},
+				Path:       common.String("fake_path/ports/fake_pod_phoia"),
+				ParentPath: common.String("fake_path"),
+				Attachment: &model.PortAttachment{
+					AllocateAddresses: common.String("IP_POOL"),
+					Type_:             common.String(model.PortAttachment_TYPE_INDEPENDENT),
+					TrafficTag:        common.Int64(0),
+					Id:                common.String("63356462-3138-4030-ad63-6534632d3131"),
+					AppId:             common.String("c5db1800-ce4c-11de-a935-8105ba7ace78"),
+					ContextId:         common.String("fake_context_id"),
+				},
+				AddressBindings: []model.PortAddressBindingEntry{
+					{
+						IpAddress:  common.String("10.0.0.5"),
+						MacAddress: common.String("04:50:56:00:fa:01"),
+					},
+				},
+				StaticIpAllocationType: common.String(controllercommon.NSXIPAddressTypeIPv4),
+			},
+			expectedError: nil,
+		},
 		{
 			name:            "build-NSX-port-for-restore-pod",
 			interfaceIPType: v1alpha1.IPAddressTypeIPv4,
@@ -1392,7 +1502,8 @@ func TestBuildSubnetPortIdAndName_existingPortByUID(t *testing.T) {
 	defer patchesStsFeat.Reset()
 
 	objMeta := &metav1.ObjectMeta{Name: "test-pod", UID: "pod-uid-123"}
-	id, name := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "")
+	id, name, err := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "", "")
+	assert.NoError(t, err)
 	assert.Equal(t, "existing-port-id", id)
 	assert.Equal(t, "existing-port-name", name)
 }
@@ -1440,7 +1551,120 @@ func TestBuildSubnetPortIdAndName_reuseSTSPortByUIDAndPodName(t *testing.T) {
 	defer patchesStsFeat.Reset()
 
 	objMeta := &metav1.ObjectMeta{Name: "test-pod", UID: "pod-uid-123"}
-	id, name := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "sts-uid-123")
+	id, name, err := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "sts-uid-123", "")
+	assert.NoError(t, err)
 	assert.Equal(t, "sts-port-id", id)
 	assert.Equal(t, "test-pod", name)
 }
+
+func TestGetPodStaticAddresses(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expectedMAC string
+		expectedIPs []string
+		expectedErr string
+	}{
+		{
+			name: "NoAnnotation",
+		},
+		{
+			name: "DualStack",
+			annotations: map[string]string{
+				common.AnnotationPodStaticMAC: "04:50:56:00:FA:01",
+				common.AnnotationPodStaticIPs: "10.0.0.5, 2001:db8:0::5",
+			},
+			expectedMAC: "04:50:56:00:fa:01",
+			expectedIPs: []string{"10.0.0.5", "2001:db8::5"},
+		},
+		{
+			name:        "InvalidMAC",
+			annotations: map[string]string{common.AnnotationPodStaticMAC: "04:50:56:00:fa"},
+			expectedErr: "invalid MAC address",
+		},
+		{
+			name:        "InvalidIP",
+			annotations: map[string]string{common.AnnotationPodStaticIPs: "10.0.0.256"},
+			expectedErr: "invalid IP address",
+		},
+		{
+			name:        "MultipleIPv4",
+			annotations: map[string]string{common.AnnotationPodStaticIPs: "10.0.0.5,10.0.0.6"},
+			expectedErr: "more than one IPv4 address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			mac, ips, err := GetPodStaticAddresses(pod)
+			if tt.expectedErr != "" {
+				var validationErr *nsxutil.ValidationError
+				assert.ErrorAs(t, err, &validationErr)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMAC, mac)
+			assert.Equal(t, tt.expectedIPs, ips)
+		})
+	}
+}
+
+func TestBuildSubnetPortIdAndName_reusePinnedPortOfDeletedPod(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	defer mockCtl.Finish()
+	k8sClient := mock_client.NewMockClient(mockCtl)
+	service := &SubnetPortService{
+		Service: common.Service{
+			Client:    k8sClient,
+			NSXClient: &nsx.Client{},
+		},
+		SubnetPortStore: setupStore(),
+	}
+	patchesStsFeat := gomonkey.ApplyFunc(nsx.StatefulSetPodSubnetPortFeatureEnabled,
+		func(_ *nsx.Client, _ *config.NSXOperatorConfig) bool {
+			return false
+		})
+	defer patchesStsFeat.Reset()
+	service.SubnetPortStore.Add(&model.VpcSubnetPort{
+		Id:          common.String("pinned-port-id"),
+		DisplayName: common.String("old-pod"),
+		Tags: []model.Tag{
+			{Scope: common.String(common.TagScopeNamespace), Tag: common.String("ns-1")},
+			{Scope: common.String(common.TagScopePodName), Tag: common.String("old-pod")},
+			{Scope: common.String(common.TagScopePodUID), Tag: common.String("old-pod-uid")},
+			{Scope: common.String(common.TagScopePodStaticAddress), Tag: common.String("04:50:56:00:fa:01")},
+		},
+	})
+	objMeta := &metav1.ObjectMeta{Name: "new-pod", Namespace: "ns-1", UID: "new-pod-uid"}
+
+	// The Pod holding the pinned addresses is still running or terminating.
+	for _, deletionTimestamp := range []*metav1.Time{nil, {Time: time.Now()}} {
+		k8sClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns-1", Name: "old-pod"}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+				obj.SetUID("old-pod-uid")
+				obj.SetDeletionTimestamp(deletionTimestamp)
+				return nil
+			})
+		_, _, err := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "", "04:50:56:00:fa:01")
+		assert.ErrorContains(t, err, "waiting for it to be deleted")
+	}
+
+	// The Pod with the same name is recreated after the Pod holding the pinned addresses is deleted.
+	k8sClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns-1", Name: "old-pod"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+			obj.SetUID("recreated-pod-uid")
+			return nil
+		})
+	id, _, err := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "", "04:50:56:00:fa:01")
+	assert.NoError(t, err)
+	assert.Equal(t, "pinned-port-id", id)
+
+	// The Pod holding the pinned addresses is deleted.
+	k8sClient.EXPECT().Get(gomock.Any(), types.NamespacedName{Namespace: "ns-1", Name: "old-pod"}, gomock.Any()).
+		Return(apierrors.NewNotFound(corev1.Resource("pods"), "old-pod"))
+	id, name, err := service.BuildSubnetPortIdAndName(objMeta, types.UID("ns-uid-456"), "", "04:50:56:00:fa:01")
+	assert.NoError(t, err)
+	assert.Equal(t, "pinned-port-id", id)
+	assert.Equal(t, "new-pod", name)
+}
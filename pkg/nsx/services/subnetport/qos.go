@@ -0,0 +1,264 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetport
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+	"github.com/vmware-tanzu/nsx-operator/pkg/util"
+)
+
+const bitsPerMbps = 1000 * 1000
+
+var minBandwidth = resource.MustParse("1M")
+
+// portBandwidth is the bandwidth limits of a SubnetPort in Mbps, 0 means no limit.
+type portBandwidth struct {
+	ingressMbps int64
+	egressMbps  int64
+}
+
+// key identifies the limits, it is used as the tag of the QoS profile and the SubnetPorts bound to it.
+func (bandwidth *portBandwidth) key() string {
+	return fmt.Sprintf("i%d_e%d", bandwidth.ingressMbps, bandwidth.egressMbps)
+}
+
+// getPortBandwidth returns the bandwidth limits of the SubnetPort CR or the Pod, nil is returned if no limit is set.
+// A ValidationError is returned if a limit is invalid.
+func getPortBandwidth(obj interface{}) (*portBandwidth, error) {
+	bandwidth := &portBandwidth{}
+	var err error
+	switch o := obj.(type) {
+	case *v1alpha1.SubnetPort:
+		if o.Spec.Bandwidth == nil {
+			return nil, nil
+		}
+		if bandwidth.ingressMbps, err = quantityToMbps(o.Spec.Bandwidth.Ingress, "spec.bandwidth.ingress"); err != nil {
+			return nil, err
+		}
+		if bandwidth.egressMbps, err = quantityToMbps(o.Spec.Bandwidth.Egress, "spec.bandwidth.egress"); err != nil {
+			return nil, err
+		}
+	case *corev1.Pod:
+		if bandwidth.ingressMbps, err = annotationToMbps(o, common.AnnotationPodIngressBandwidth); err != nil {
+			return nil, err
+		}
+		if bandwidth.egressMbps, err = annotationToMbps(o, common.AnnotationPodEgressBandwidth); err != nil {
+			return nil, err
+		}
+	}
+	if bandwidth.ingressMbps == 0 && bandwidth.egressMbps == 0 {
+		return nil, nil
+	}
+	return bandwidth, nil
+}
+
+func annotationToMbps(pod *corev1.Pod, annotation string) (int64, error) {
+	value, ok := pod.GetAnnotations()[annotation]
+	if !ok {
+		return 0, nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, &nsxutil.ValidationError{Desc: fmt.Sprintf("invalid value %q of annotation %s: %v", value, annotation, err)}
+	}
+	return quantityToMbps(&quantity, "annotation "+annotation)
+}
+
+// quantityToMbps converts the bandwidth in bits per second to Mbps, the fraction is rounded down.
+func quantityToMbps(quantity *resource.Quantity, field string) (int64, error) {
+	if quantity == nil {
+		return 0, nil
+	}
+	if quantity.Cmp(minBandwidth) < 0 {
+		return 0, &nsxutil.ValidationError{Desc: fmt.Sprintf("bandwidth %s of %s is less than %s", quantity.String(), field, minBandwidth.String())}
+	}
+	return quantity.Value() / bitsPerMbps, nil
+}
+
+func (service *SubnetPortService) qosProfileID(bandwidth *portBandwidth) string {
+	return util.NormalizeId(fmt.Sprintf("%s_%s", getCluster(service), bandwidth.key()))
+}
+
+func (service *SubnetPortService) qosProfilePath(bandwidth *portBandwidth) string {
+	return fmt.Sprintf("/infra/qos-profiles/%s", service.qosProfileID(bandwidth))
+}
+
+// getSegmentProfiles returns the segment profiles bound to the SubnetPort CR or the Pod, the QoS profile is the one
+// created for the bandwidth limits if any.
+func (service *SubnetPortService) getSegmentProfiles(obj interface{}, bandwidth *portBandwidth) v1alpha1.SegmentProfiles {
+	var profiles v1alpha1.SegmentProfiles
+	if subnetPort, ok := obj.(*v1alpha1.SubnetPort); ok {
+		profiles = subnetPort.Spec.SegmentProfiles
+	}
+	if bandwidth != nil {
+		profiles.QoSProfile = service.qosProfilePath(bandwidth)
+	}
+	return profiles
+}
+
+// buildQoSProfile builds the QoS profile for the bandwidth limits. The ingress and egress of NSX are from the view of
+// the switch, so the ingress limit of the SubnetPort is realized with the egress rate shaper, and vice versa.
+func (service *SubnetPortService) buildQoSProfile(bandwidth *portBandwidth) (*model.QosProfile, error) {
+	id := service.qosProfileID(bandwidth)
+	tags := util.BuildClusterTags(getCluster(service))
+	tags = append(tags, model.Tag{Scope: String(common.TagScopePortBandwidth), Tag: String(bandwidth.key())})
+	profile := &model.QosProfile{
+		Id:          String(id),
+		DisplayName: String(id),
+		Path:        String(service.qosProfilePath(bandwidth)),
+		Tags:        tags,
+	}
+	if bandwidth.ingressMbps > 0 {
+		shaper := model.EgressRateShaper{
+			ResourceType:         model.QosBaseRateShaper_RESOURCE_TYPE_EGRESSRATESHAPER,
+			Enabled:              common.Bool(true),
+			AverageBandwidthMbps: common.Int64(bandwidth.ingressMbps),
+			PeakBandwidthMbps:    common.Int64(bandwidth.ingressMbps),
+		}
+		dataValue, errs := common.NewConverter().ConvertToVapi(shaper, model.EgressRateShaperBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		profile.ShaperConfigurations = append(profile.ShaperConfigurations, dataValue.(*data.StructValue))
+	}
+	if bandwidth.egressMbps > 0 {
+		shaper := model.IngressRateShaper{
+			ResourceType:         model.QosBaseRateShaper_RESOURCE_TYPE_INGRESSRATESHAPER,
+			Enabled:              common.Bool(true),
+			AverageBandwidthMbps: common.Int64(bandwidth.egressMbps),
+			PeakBandwidthMbps:    common.Int64(bandwidth.egressMbps),
+		}
+		dataValue, errs := common.NewConverter().ConvertToVapi(shaper, model.IngressRateShaperBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		profile.ShaperConfigurations = append(profile.ShaperConfigurations, dataValue.(*data.StructValue))
+	}
+	return profile, nil
+}
+
+// ensureQoSProfile creates the QoS profile for the bandwidth limits if it doesn't exist. The caller should hold the
+// read lock of qosProfileLock until the SubnetPort bound to the QoS profile is applied to the store.
+func (service *SubnetPortService) ensureQoSProfile(bandwidth *portBandwidth) error {
+	if service.QoSProfileStore.GetByKey(service.qosProfileID(bandwidth)) != nil {
+		return nil
+	}
+	profile, err := service.buildQoSProfile(bandwidth)
+	if err != nil {
+		return err
+	}
+	if err := service.patchQoSProfile(profile); err != nil {
+		log.Error(err, "Failed to create QoS profile", "qosProfile.Id", *profile.Id)
+		return err
+	}
+	log.Info("Created QoS profile", "qosProfile.Id", *profile.Id)
+	return nil
+}
+
+func (service *SubnetPortService) patchQoSProfile(profile *model.QosProfile) error {
+	child, err := common.WrapQosProfile(profile)
+	if err != nil {
+		return err
+	}
+	infra, err := service.WrapInfra([]*data.StructValue{child})
+	if err != nil {
+		return err
+	}
+	enforceRevisionCheckParam := false
+	if err := service.NSXClient.InfraClient.Patch(*infra, &enforceRevisionCheckParam); err != nil {
+		return nsxutil.TransNSXApiError(err)
+	}
+	return service.QoSProfileStore.Apply(profile)
+}
+
+// GarbageCollectQoSProfiles deletes the QoS profiles created for the bandwidth limits which are not bound to any
+// SubnetPort.
+func (service *SubnetPortService) GarbageCollectQoSProfiles() error {
+	if service.QoSProfileStore == nil || service.SubnetPortStore == nil {
+		return nil
+	}
+	service.qosProfileLock.Lock()
+	defer service.qosProfileLock.Unlock()
+
+	var errList []error
+	for _, profile := range service.listUnusedQoSProfiles() {
+		log.Info("GC collected QoS profile", "qosProfile.Id", *profile.Id)
+		deletedProfile := *profile
+		deletedProfile.MarkedForDelete = common.Bool(true)
+		if err := service.patchQoSProfile(&deletedProfile); err != nil {
+			log.Error(err, "Failed to delete QoS profile", "qosProfile.Id", *profile.Id)
+			errList = append(errList, err)
+		}
+	}
+	if len(errList) > 0 {
+		return errors.Join(errList...)
+	}
+	return nil
+}
+
+// ListUnusedQoSProfiles returns the QoS profiles which would be deleted by GarbageCollectQoSProfiles.
+func (service *SubnetPortService) ListUnusedQoSProfiles() []*model.QosProfile {
+	if service.QoSProfileStore == nil || service.SubnetPortStore == nil {
+		return nil
+	}
+	service.qosProfileLock.Lock()
+	defer service.qosProfileLock.Unlock()
+	return service.listUnusedQoSProfiles()
+}
+
+func (service *SubnetPortService) listUnusedQoSProfiles() []*model.QosProfile {
+	usedBandwidths := service.SubnetPortStore.ListIndexFuncValues(common.TagScopePortBandwidth)
+	var profiles []*model.QosProfile
+	for _, obj := range service.QoSProfileStore.List() {
+		profile := obj.(*model.QosProfile)
+		if !usedBandwidths.Has(nsxutil.FindTag(profile.Tags, common.TagScopePortBandwidth)) {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
+}
+
+// QoSProfileStore is a store for the QoS profiles created for the bandwidth limits of SubnetPorts.
+type QoSProfileStore struct {
+	common.ResourceStore
+}
+
+func setupQoSProfileStore() *QoSProfileStore {
+	return &QoSProfileStore{
+		ResourceStore: common.ResourceStore{
+			Indexer:     cache.NewIndexer(keyFunc, cache.Indexers{}),
+			BindingType: model.QosProfileBindingType(),
+		},
+	}
+}
+
+func (qosProfileStore *QoSProfileStore) Apply(i interface{}) error {
+	if i == nil {
+		return nil
+	}
+	profile := i.(*model.QosProfile)
+	if profile.MarkedForDelete != nil && *profile.MarkedForDelete {
+		return qosProfileStore.Delete(profile)
+	}
+	return qosProfileStore.Add(profile)
+}
+
+func (qosProfileStore *QoSProfileStore) GetByKey(key string) *model.QosProfile {
+	obj := qosProfileStore.ResourceStore.GetByKey(key)
+	if obj == nil {
+		return nil
+	}
+	return obj.(*model.QosProfile)
+}
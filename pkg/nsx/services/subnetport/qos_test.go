@@ -0,0 +1,164 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package subnetport
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+	"github.com/vmware-tanzu/nsx-operator/pkg/config"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
+	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
+	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
+)
+
+type fakeInfraClient struct {
+	patched []model.Infra
+}
+
+func (c *fakeInfraClient) Get(basePathParam *string, filterParam *string, typeFilterParam *string) (model.Infra, error) {
+	return model.Infra{}, nil
+}
+
+func (c *fakeInfraClient) Patch(infraParam model.Infra, enforceRevisionCheckParam *bool) error {
+	c.patched = append(c.patched, infraParam)
+	return nil
+}
+
+func (c *fakeInfraClient) Update(infraParam model.Infra) (model.Infra, error) {
+	return model.Infra{}, nil
+}
+
+func newQoSTestService(infraClient *fakeInfraClient) *SubnetPortService {
+	return &SubnetPortService{
+		Service: common.Service{
+			NSXClient: &nsx.Client{InfraClient: infraClient},
+			NSXConfig: &config.NSXOperatorConfig{
+				CoeConfig: &config.CoeConfig{Cluster: "k8scl-one"},
+			},
+		},
+		SubnetPortStore: setupStore(),
+		QoSProfileStore: setupQoSProfileStore(),
+	}
+}
+
+func TestGetPortBandwidth(t *testing.T) {
+	quantity := func(value string) *resource.Quantity {
+		q := resource.MustParse(value)
+		return &q
+	}
+	tests := []struct {
+		name    string
+		obj     interface{}
+		want    *portBandwidth
+		wantErr bool
+	}{
+		{
+			name: "subnetport-without-bandwidth",
+			obj:  &v1alpha1.SubnetPort{},
+		},
+		{
+			name: "subnetport-with-bandwidth",
+			obj: &v1alpha1.SubnetPort{Spec: v1alpha1.SubnetPortSpec{Bandwidth: &v1alpha1.PortBandwidth{
+				Ingress: quantity("10M"),
+				Egress:  quantity("1500k"),
+			}}},
+			want: &portBandwidth{ingressMbps: 10, egressMbps: 1},
+		},
+		{
+			name: "subnetport-with-bandwidth-too-low",
+			obj: &v1alpha1.SubnetPort{Spec: v1alpha1.SubnetPortSpec{Bandwidth: &v1alpha1.PortBandwidth{
+				Egress: quantity("500k"),
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "pod-with-bandwidth-annotations",
+			obj: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				common.AnnotationPodEgressBandwidth: "1G",
+			}}},
+			want: &portBandwidth{egressMbps: 1000},
+		},
+		{
+			name: "pod-with-invalid-bandwidth-annotation",
+			obj: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				common.AnnotationPodIngressBandwidth: "fast",
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "pod-without-bandwidth-annotations",
+			obj:  &corev1.Pod{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getPortBandwidth(tt.obj)
+			if tt.wantErr {
+				var validationErr *nsxutil.ValidationError
+				assert.True(t, errors.As(err, &validationErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSubnetPortService_buildQoSProfile(t *testing.T) {
+	service := newQoSTestService(&fakeInfraClient{})
+	bandwidth := &portBandwidth{ingressMbps: 10, egressMbps: 20}
+	profile, err := service.buildQoSProfile(bandwidth)
+	assert.NoError(t, err)
+	assert.Equal(t, "k8scl-one_i10_e20", *profile.Id)
+	assert.Equal(t, "/infra/qos-profiles/k8scl-one_i10_e20", *profile.Path)
+	assert.Equal(t, "i10_e20", nsxutil.FindTag(profile.Tags, common.TagScopePortBandwidth))
+	assert.Equal(t, "k8scl-one", nsxutil.FindTag(profile.Tags, common.TagScopeCluster))
+	assert.Len(t, profile.ShaperConfigurations, 2)
+
+	// The ingress limit of the SubnetPort is realized with the egress rate shaper of NSX, and vice versa.
+	egressShaper, errs := common.NewConverter().ConvertToGolang(profile.ShaperConfigurations[0], model.EgressRateShaperBindingType())
+	assert.Empty(t, errs)
+	assert.Equal(t, int64(10), *egressShaper.(model.EgressRateShaper).AverageBandwidthMbps)
+	ingressShaper, errs := common.NewConverter().ConvertToGolang(profile.ShaperConfigurations[1], model.IngressRateShaperBindingType())
+	assert.Empty(t, errs)
+	assert.Equal(t, int64(20), *ingressShaper.(model.IngressRateShaper).AverageBandwidthMbps)
+
+	profile, err = service.buildQoSProfile(&portBandwidth{egressMbps: 20})
+	assert.NoError(t, err)
+	assert.Len(t, profile.ShaperConfigurations, 1)
+}
+
+func TestSubnetPortService_GarbageCollectQoSProfiles(t *testing.T) {
+	infraClient := &fakeInfraClient{}
+	service := newQoSTestService(infraClient)
+	used := &portBandwidth{ingressMbps: 10}
+	unused := &portBandwidth{egressMbps: 20}
+
+	assert.NoError(t, service.ensureQoSProfile(used))
+	assert.NoError(t, service.ensureQoSProfile(unused))
+	// The existing QoS profile is not patched again.
+	assert.NoError(t, service.ensureQoSProfile(used))
+	assert.Len(t, infraClient.patched, 2)
+
+	service.SubnetPortStore.Add(&model.VpcSubnetPort{
+		Id:         String("port1"),
+		ParentPath: String(subnetPath),
+		Tags:       []model.Tag{{Scope: String(common.TagScopePortBandwidth), Tag: String(used.key())}},
+	})
+	unusedProfiles := service.ListUnusedQoSProfiles()
+	assert.Len(t, unusedProfiles, 1)
+	assert.Equal(t, service.qosProfileID(unused), *unusedProfiles[0].Id)
+	assert.NoError(t, service.GarbageCollectQoSProfiles())
+	assert.Len(t, infraClient.patched, 3)
+	assert.NotNil(t, service.QoSProfileStore.GetByKey(service.qosProfileID(used)))
+	assert.Nil(t, service.QoSProfileStore.GetByKey(service.qosProfileID(unused)))
+}
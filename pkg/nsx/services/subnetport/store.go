@@ -22,6 +22,8 @@ func keyFunc(obj interface{}) (string, error) {
 	switch v := obj.(type) {
 	case *model.VpcSubnetPort:
 		return *v.Id, nil
+	case *model.QosProfile:
+		return *v.Id, nil
 	case types.UID:
 		return string(v), nil
 	case string:
@@ -117,6 +119,24 @@ func subnetPortIndexByStatefulSetName(obj interface{}) ([]string, error) {
 	}
 }
 
+func subnetPortIndexByPodStaticAddress(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.VpcSubnetPort:
+		return filterTag(o.Tags, common.TagScopePodStaticAddress), nil
+	default:
+		return nil, errors.New("subnetPortIndexByPodStaticAddress doesn't support unknown type")
+	}
+}
+
+func subnetPortIndexByPortBandwidth(obj interface{}) ([]string, error) {
+	switch o := obj.(type) {
+	case *model.VpcSubnetPort:
+		return filterTag(o.Tags, common.TagScopePortBandwidth), nil
+	default:
+		return nil, errors.New("subnetPortIndexByPortBandwidth doesn't support unknown type")
+	}
+}
+
 func subnetPortIndexBySts(obj interface{}) ([]string, error) {
 	port, ok := obj.(*model.VpcSubnetPort)
 	if !ok {
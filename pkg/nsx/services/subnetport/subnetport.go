@@ -7,9 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	mpmodel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	v1 "k8s.io/api/core/v1"
@@ -39,7 +42,12 @@ type SubnetPortService struct {
 	SubnetPortStore            *SubnetPortStore
 	VPCService                 servicecommon.VPCServiceProvider
 	IpAddressAllocationService servicecommon.IPAddressAllocationServiceProvider
+	QoSProfileStore            *QoSProfileStore
 	builder                    *servicecommon.PolicyTreeBuilder[*model.VpcSubnetPort]
+	// batchWriter coalesces the writes of the VpcSubnetPorts of the Pods and SubnetPort CRs if it is enabled.
+	batchWriter *servicecommon.PolicyTreeBatchWriter[*model.VpcSubnetPort]
+	// qosProfileLock prevents the QoS profiles from being garbage collected while they are being bound to SubnetPorts.
+	qosProfileLock sync.RWMutex
 }
 
 // InitializeSubnetPort sync NSX resources.
@@ -50,7 +58,7 @@ func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommo
 	wgDone := make(chan bool)
 	fatalErrors := make(chan error)
 
-	wg.Add(1)
+	wg.Add(2)
 
 	subnetPortService := &SubnetPortService{
 		Service:                    service,
@@ -59,9 +67,13 @@ func InitializeSubnetPort(service servicecommon.Service, vpcService servicecommo
 		builder:                    builder,
 	}
 
+	subnetPortService.batchWriter = servicecommon.NewBatchWriterByConfig(builder, service)
 	subnetPortService.SubnetPortStore = setupStore()
+	subnetPortService.QoSProfileStore = setupQoSProfileStore()
 
 	go subnetPortService.InitializeResourceStore(&wg, fatalErrors, ResourceTypeSubnetPort, nil, subnetPortService.SubnetPortStore)
+	go subnetPortService.InitializeResourceStore(&wg, fatalErrors, servicecommon.ResourceTypeQoSProfile,
+		[]model.Tag{{Scope: String(servicecommon.TagScopePortBandwidth)}}, subnetPortService.QoSProfileStore)
 	go func() {
 		wg.Wait()
 		close(wgDone)
@@ -88,10 +100,12 @@ func setupStore() *SubnetPortStore {
 					servicecommon.TagScopeVMNamespace:     subnetPortIndexNamespace,
 					servicecommon.TagScopeNamespace:       subnetPortIndexPodNamespace,
 					// Use Subnet Path instead of Subnet ID as shared Subnet ID on different VPC can be the same
-					servicecommon.IndexKeySubnetPath:      subnetPortIndexBySubnetPath,
-					servicecommon.TagScopeStatefulSetUID:  subnetPortIndexByStatefulSetUID,
-					servicecommon.TagScopeStatefulSetName: subnetPortIndexByStatefulSetName,
-					servicecommon.IndexKeyAllStsPorts:     subnetPortIndexBySts,
+					servicecommon.IndexKeySubnetPath:       subnetPortIndexBySubnetPath,
+					servicecommon.TagScopeStatefulSetUID:   subnetPortIndexByStatefulSetUID,
+					servicecommon.TagScopeStatefulSetName:  subnetPortIndexByStatefulSetName,
+					servicecommon.IndexKeyAllStsPorts:      subnetPortIndexBySts,
+					servicecommon.TagScopePodStaticAddress: subnetPortIndexByPodStaticAddress,
+					servicecommon.TagScopePortBandwidth:    subnetPortIndexByPortBandwidth,
 				}),
 			BindingType: model.VpcSubnetPortBindingType(),
 		}}
@@ -150,6 +164,12 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 		return nil, err
 	}
 	existingSubnetPort := service.SubnetPortStore.GetByKey(*nsxSubnetPort.Id)
+	if existingSubnetPort != nil && existingSubnetPort.ParentPath != nil && *existingSubnetPort.ParentPath != *nsxSubnet.Path {
+		if err := service.migrateSubnetPort(obj, existingSubnetPort, nsxSubnetPort, nsxSubnet, attachmentID); err != nil {
+			return nil, err
+		}
+		existingSubnetPort = nil
+	}
 	isChanged := true
 	if existingSubnetPort != nil {
 		// The existing port's attachment ID should not be changed in any case.
@@ -175,16 +195,12 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 		}
 	} else {
 		log.Info("Updating the NSX subnet port", "existingSubnetPort", existingSubnetPort, "desiredSubnetPort", nsxSubnetPort)
-		err = service.NSXClient.PortClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id, *nsxSubnetPort)
-		err = nsxutil.TransNSXApiError(err)
-		if err != nil {
+		if err = service.patchSubnetPort(obj, nsxSubnetPort, existingSubnetPort, subnetInfo); err != nil {
 			log.Error(err, "failed to create or update subnet port", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
 			return nil, err
 		}
-		err = service.SubnetPortStore.Apply(nsxSubnetPort)
-		if err != nil {
-			return nil, err
-		}
+		// Drop the realization result of the previous version.
+		realizestate.ForgetRealizeState(*nsxSubnetPort.Path)
 		if existingSubnetPort != nil {
 			log.Info("Updated NSX subnet port", "nsxSubnetPort.Path", *nsxSubnetPort.Path)
 		} else {
@@ -193,6 +209,9 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 	}
 	nsxSubnetPortState, err := service.CheckSubnetPortState(obj, *nsxSubnet.Path)
 	if err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			return nil, err
+		}
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "check and update NSX subnet port state failed, would retry with delay", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
 		} else {
@@ -217,6 +236,171 @@ func (service *SubnetPortService) CreateOrUpdateSubnetPort(obj interface{}, nsxS
 	return nsxSubnetPortState, nil
 }
 
+// migrateSubnetPort moves the NSX SubnetPort from the old Subnet to the new Subnet. This happens when the parent of the
+// SubnetPort CR is changed, or when a Pod takes over a retained SubnetPort on another Subnet. The SubnetPort is created
+// on the new Subnet with a staging attachment first, then the attachment is moved from the old SubnetPort to the new
+// one, and the old SubnetPort is deleted last, so that the VIF is not re-provisioned. For the SubnetPort CR, the
+// addresses allocated on the old Subnet are preserved by preserveSubnetPortAddresses.
+func (service *SubnetPortService) migrateSubnetPort(obj interface{}, existingSubnetPort *model.VpcSubnetPort, nsxSubnetPort *model.VpcSubnetPort, nsxSubnet *model.VpcSubnet, attachmentID string) error {
+	// The attachment ID in the CR or the Pod is used in case the previous migration failed after the attachment was
+	// released from the old SubnetPort.
+	if attachmentID == "" && existingSubnetPort.Attachment != nil && existingSubnetPort.Attachment.Id != nil {
+		attachmentID = *existingSubnetPort.Attachment.Id
+	}
+	if attachmentID == "" {
+		attachmentID = *nsxSubnetPort.Attachment.Id
+	}
+	if subnetPort, ok := obj.(*v1alpha1.SubnetPort); ok {
+		if err := preserveSubnetPortAddresses(subnetPort, nsxSubnetPort, nsxSubnet); err != nil {
+			return err
+		}
+	}
+	log.Info("Migrating NSX subnet port", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "oldSubnetPath", *existingSubnetPort.ParentPath, "nsxSubnetPath", *nsxSubnet.Path)
+
+	// The steps are ordered, so the SubnetPorts are patched with PortClient instead of the batch writer.
+	nsxSubnetPort.Attachment.Id = String(uuid.Must(uuid.NewV4()).String())
+	if err := service.patchMigratingSubnetPort(nsxSubnetPort, *nsxSubnet.Path); err != nil {
+		log.Error(err, "failed to create subnet port on the new subnet", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
+		return err
+	}
+	if existingSubnetPort.Attachment != nil {
+		oldSubnetPort := *existingSubnetPort
+		oldAttachment := *existingSubnetPort.Attachment
+		oldAttachment.Id = String(uuid.Must(uuid.NewV4()).String())
+		oldSubnetPort.Attachment = &oldAttachment
+		oldSubnetPort.Revision = nil
+		if err := service.patchMigratingSubnetPort(&oldSubnetPort, *existingSubnetPort.ParentPath); err != nil {
+			log.Error(err, "failed to release attachment from the subnet port on the old subnet", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "oldSubnetPath", *existingSubnetPort.ParentPath)
+			return err
+		}
+	}
+	nsxSubnetPort.Attachment.Id = &attachmentID
+	if err := service.patchMigratingSubnetPort(nsxSubnetPort, *nsxSubnet.Path); err != nil {
+		log.Error(err, "failed to move attachment to the subnet port on the new subnet", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "nsxSubnetPath", *nsxSubnet.Path)
+		return err
+	}
+	if err := service.DeleteSubnetPort(existingSubnetPort); err != nil {
+		log.Error(err, "failed to delete subnet port on the old subnet", "nsxSubnetPort.Id", *nsxSubnetPort.Id, "oldSubnetPath", *existingSubnetPort.ParentPath)
+		return err
+	}
+	return nil
+}
+
+func (service *SubnetPortService) patchMigratingSubnetPort(nsxSubnetPort *model.VpcSubnetPort, subnetPath string) error {
+	subnetInfo, err := servicecommon.ParseVPCResourcePath(subnetPath)
+	if err != nil {
+		return err
+	}
+	err = service.NSXClient.PortClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id, *nsxSubnetPort)
+	return nsxutil.TransNSXApiError(err)
+}
+
+// preserveSubnetPortAddresses preserves the MAC address of the SubnetPort CR on the new Subnet, and the IP addresses if
+// the SubnetPort CR is annotated with nsx.vmware.com/preserve-ip-on-migration and no IP address is specified in the
+// spec. Same as buildSubnetPort, the MAC address is only specified if the new Subnet allocates IP addresses from the
+// static IP pool or the IP addresses are specified.
+func preserveSubnetPortAddresses(subnetPort *v1alpha1.SubnetPort, nsxSubnetPort *model.VpcSubnetPort, nsxSubnet *model.VpcSubnet) error {
+	var ips []string
+	if subnetPort.Annotations[servicecommon.AnnotationPreserveIPOnMigration] == "true" && !hasIPAddressBinding(nsxSubnetPort) {
+		for _, ipConfig := range subnetPort.Status.NetworkInterfaceConfig.IPAddresses {
+			if ipConfig.IPAddress == "" {
+				continue
+			}
+			ip := strings.Split(ipConfig.IPAddress, "/")[0]
+			if !isIPInSubnet(ip, nsxSubnet) {
+				return fmt.Errorf("IP address %s of SubnetPort %s/%s is not in Subnet %s", ip, subnetPort.Namespace, subnetPort.Name, *nsxSubnet.Path)
+			}
+			ips = append(ips, ip)
+		}
+	}
+	if len(ips) > 0 {
+		nsxSubnetPort.AddressBindings = nil
+		for i := range ips {
+			nsxSubnetPort.AddressBindings = append(nsxSubnetPort.AddressBindings, model.PortAddressBindingEntry{IpAddress: &ips[i]})
+		}
+	}
+	macAddress := subnetPort.Status.NetworkInterfaceConfig.MACAddress
+	allocateAddresses := nsxSubnetPort.Attachment.AllocateAddresses
+	if macAddress == "" || allocateAddresses == nil || (*allocateAddresses != "BOTH" && len(ips) == 0) {
+		return nil
+	}
+	if len(nsxSubnetPort.AddressBindings) == 0 {
+		nsxSubnetPort.AddressBindings = []model.PortAddressBindingEntry{{}}
+	}
+	for i := range nsxSubnetPort.AddressBindings {
+		nsxSubnetPort.AddressBindings[i].MacAddress = &macAddress
+	}
+	if *allocateAddresses == "BOTH" {
+		nsxSubnetPort.Attachment.AllocateAddresses = String("IP_POOL")
+	}
+	return nil
+}
+
+func hasIPAddressBinding(nsxSubnetPort *model.VpcSubnetPort) bool {
+	for _, binding := range nsxSubnetPort.AddressBindings {
+		if binding.IpAddress != nil && *binding.IpAddress != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func isIPInSubnet(ip string, nsxSubnet *model.VpcSubnet) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	for _, cidr := range nsxSubnet.IpAddresses {
+		if prefix, err := netip.ParsePrefix(cidr); err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// patchSubnetPort creates or updates the VpcSubnetPort and applies it to the store. The segment profile binding maps
+// are realized with the VpcSubnetPort in the same H-API call, and the QoS profile for the bandwidth limits is created
+// before it is bound.
+func (service *SubnetPortService) patchSubnetPort(obj interface{}, nsxSubnetPort *model.VpcSubnetPort, existingSubnetPort *model.VpcSubnetPort, subnetInfo servicecommon.VPCResourceInfo) error {
+	// The bandwidth limits have been validated when building the VpcSubnetPort.
+	bandwidth, _ := getPortBandwidth(obj)
+	if bandwidth != nil {
+		service.qosProfileLock.RLock()
+		defer service.qosProfileLock.RUnlock()
+		if err := service.ensureQoSProfile(bandwidth); err != nil {
+			return err
+		}
+	}
+	hasBindings := existingSubnetPort != nil && nsxutil.FindTag(existingSubnetPort.Tags, servicecommon.TagScopeSegmentProfiles) != ""
+	var err error
+	if nsxSubnetPort.Children, err = service.buildProfileBindingMaps(obj, bandwidth, hasBindings); err != nil {
+		log.Error(err, "failed to build segment profile binding maps", "nsxSubnetPort.Id", *nsxSubnetPort.Id)
+		return err
+	}
+	if service.batchWriter != nil {
+		err = service.batchWriter.Write(nsxSubnetPort)
+	} else if len(nsxSubnetPort.Children) > 0 {
+		err = service.patchSubnetPortWithChildren(nsxSubnetPort)
+	} else {
+		err = service.NSXClient.PortClient.Patch(subnetInfo.OrgID, subnetInfo.ProjectID, subnetInfo.VPCID, subnetInfo.ID, *nsxSubnetPort.Id, *nsxSubnetPort)
+	}
+	if err = nsxutil.TransNSXApiError(err); err != nil {
+		return err
+	}
+	return service.SubnetPortStore.Apply(nsxSubnetPort)
+}
+
+// patchSubnetPortWithChildren creates or updates the VpcSubnetPort together with its children, e.g. the segment profile
+// binding maps, with H-API.
+func (service *SubnetPortService) patchSubnetPortWithChildren(nsxSubnetPort *model.VpcSubnetPort) error {
+	orgRoot, err := service.builder.BuildOrgRoot([]*model.VpcSubnetPort{nsxSubnetPort}, "")
+	if err != nil {
+		return err
+	}
+	enforceRevisionCheckParam := false
+	return service.NSXClient.OrgRootClient.Patch(*orgRoot, &enforceRevisionCheckParam)
+}
+
 func mergeSubnetPortAddressBinding(existingAddressBinding []model.PortAddressBindingEntry, desiredAddressBinding []model.PortAddressBindingEntry) []model.PortAddressBindingEntry {
 	// For restored SubnetPort, the addressbinding of the existing SubnetPort may be different with the one generated by buildSubnetPort
 	// Especially for allocate_addresses BOTH, updating a SubnetPort with both IP/MAC to a SubnetPort with IP only will get realization error
@@ -237,15 +421,21 @@ func mergeSubnetPortAddressBinding(existingAddressBinding []model.PortAddressBin
 	return desiredAddressBinding
 }
 
-// CheckSubnetPortState will check the port realized status then get the port state to prepare the CR status.
+// CheckSubnetPortState will check the port realized status then get the port state to prepare the CR status. A
+// RealizationPendingError is returned if the port is not realized yet, and the SubnetPort CR or the Pod is requeued
+// when the realization finishes.
 func (service *SubnetPortService) CheckSubnetPortState(obj interface{}, nsxSubnetPath string) (*model.SegmentPortState, error) {
 	var objMeta metav1.ObjectMeta
+	owner := realizestate.RealizationOwner{}
 	switch o := obj.(type) {
 	case *v1alpha1.SubnetPort:
 		objMeta = o.ObjectMeta
+		owner.Kind = servicecommon.ResourceTypeSubnetPort
 	case *v1.Pod:
 		objMeta = o.ObjectMeta
+		owner.Kind = realizestate.RealizationKindPod
 	}
+	owner.NamespacedName = types.NamespacedName{Namespace: objMeta.Namespace, Name: objMeta.Name}
 
 	nsxSubnetPort, err := service.SubnetPortStore.GetVpcSubnetPortByUID(objMeta.UID)
 	if err != nil {
@@ -258,7 +448,11 @@ func (service *SubnetPortService) CheckSubnetPortState(obj interface{}, nsxSubne
 	portID := *nsxSubnetPort.Id
 	realizeService := realizestate.InitializeRealizeState(service.Service)
 
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, *nsxSubnetPort.Path, []string{}); err != nil {
+	if err := realizeService.CheckRealizeStateAsync(*nsxSubnetPort.Path, []string{}, owner); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("SubnetPort is being realized", "nsxSubnetPortPath", *nsxSubnetPort.Path)
+			return nil, err
+		}
 		log.Error(err, "Failed to get realized status", "nsxSubnetPortPath", *nsxSubnetPort.Path)
 		if nsxutil.IsRealizeStateError(err) {
 			realizedStateErr := err.(*nsxutil.RealizeStateError)
@@ -298,9 +492,16 @@ func (service *SubnetPortService) DeleteSubnetPort(nsxSubnetPort *model.VpcSubne
 	if nsxSubnetPort.Path == nil {
 		return errors.New("subnet port path is nil")
 	}
-	subnetPortInfo, _ := servicecommon.ParseVPCResourcePath(*nsxSubnetPort.Path)
-	err := service.NSXClient.PortClient.Delete(subnetPortInfo.OrgID, subnetPortInfo.ProjectID, subnetPortInfo.VPCID, subnetPortInfo.ParentID, *nsxSubnetPort.Id)
-	err = nsxutil.TransNSXApiError(err)
+	var err error
+	if service.batchWriter != nil {
+		deletedSubnetPort := *nsxSubnetPort
+		deletedSubnetPort.MarkedForDelete = servicecommon.Bool(true)
+		err = service.batchWriter.Write(&deletedSubnetPort)
+	} else {
+		subnetPortInfo, _ := servicecommon.ParseVPCResourcePath(*nsxSubnetPort.Path)
+		err = service.NSXClient.PortClient.Delete(subnetPortInfo.OrgID, subnetPortInfo.ProjectID, subnetPortInfo.VPCID, subnetPortInfo.ParentID, *nsxSubnetPort.Id)
+		err = nsxutil.TransNSXApiError(err)
+	}
 	if err != nil {
 		log.Error(err, "failed to delete nsxSubnetPort", "nsxSubnetPort.Path", *nsxSubnetPort.Path)
 		return err
@@ -4,19 +4,19 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
-	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	mpmodel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -30,7 +30,6 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/ipaddressallocation"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/vpc"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
-	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 var (
@@ -223,6 +222,12 @@ func TestSubnetPortService_CreateOrUpdateSubnetPort(t *testing.T) {
 		},
 	}
 
+	subnetPortCRWithProfiles := subnetPortCR.DeepCopy()
+	subnetPortCRWithProfiles.Spec.SegmentProfiles = v1alpha1.SegmentProfiles{
+		MACDiscoveryProfile: "/infra/mac-discovery-profiles/mac-learning",
+		SpoofGuardProfile:   "/infra/spoofguard-profiles/relaxed",
+	}
+
 	nsxSubnet1 := &model.VpcSubnet{
 		Path: &subnetPath,
 		SubnetDhcpConfig: &model.SubnetDhcpConfig{
@@ -392,13 +397,7 @@ func TestSubnetPortService_CreateOrUpdateSubnetPort(t *testing.T) {
 				patches := gomonkey.ApplyMethod(reflect.TypeOf(service.NSXClient.RealizedEntitiesClient), "List", func(_ *fakeRealizedEntitiesClient, intentPathParam string, sitePathParam *string) (model.GenericPolicyRealizedResourceListResult, error) {
 					return model.GenericPolicyRealizedResourceListResult{}, fmt.Errorf("failed to check realized state")
 				})
-				// Mock NSXTRealizeRetry with shorter backoff: 2 retries, 50ms interval
-				patches.ApplyGlobalVar(&util.NSXTRealizeRetry, wait.Backoff{
-					Steps:    2,
-					Duration: 50 * time.Millisecond,
-					Factor:   1.0,
-					Jitter:   0.0,
-				})
+				// The SubnetPort is watched by the realization watcher and the RealizationPendingError is returned.
 				patches.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
 					return false
 				})
@@ -472,6 +471,41 @@ func TestSubnetPortService_CreateOrUpdateSubnetPort(t *testing.T) {
 			nsxSubnet: nsxSubnet1,
 			obj:       subnetPortCR,
 		},
+		{
+			name: "CreateWithSegmentProfiles",
+			prepareFunc: func(service *SubnetPortService) *gomonkey.Patches {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				orgRootClient.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakeQueryClient{}), "List", func(_ *fakeQueryClient, _ string, _ *string, _ *string, _ *int64, _ *bool, _ *string) (model.SearchResponse, error) {
+					return model.SearchResponse{Results: []*data.StructValue{data.NewStructValue("", nil)}}, nil
+				})
+				// The port is patched with the binding maps by H-API instead of PortClient.
+				patches.ApplyMethodSeq(service.NSXClient.PortClient, "Patch", []gomonkey.OutputCell{{
+					Values: gomonkey.Params{fmt.Errorf("unexpected PortClient.Patch")},
+					Times:  1,
+				}})
+				patches.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+					return false
+				})
+				return patches
+			},
+			wantErr:   false,
+			nsxSubnet: nsxSubnet1,
+			obj:       subnetPortCRWithProfiles,
+		},
+		{
+			name: "SegmentProfileNotFound",
+			prepareFunc: func(service *SubnetPortService) *gomonkey.Patches {
+				k8sClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				patches := gomonkey.ApplyMethod(reflect.TypeOf(nsxClient), "NSXCheckVersion", func(_ *nsx.Client, _ int) bool {
+					return false
+				})
+				return patches
+			},
+			wantErr:   true,
+			nsxSubnet: nsxSubnet1,
+			obj:       subnetPortCRWithProfiles,
+		},
 	}
 
 	for _, tt := range tests {
@@ -565,6 +599,173 @@ func TestSubnetPortService_DeleteSubnetPort(t *testing.T) {
 	}
 }
 
+func TestSubnetPortService_migrateSubnetPort(t *testing.T) {
+	newSubnetPath := "/orgs/org1/projects/project1/vpcs/vpc1/subnets/subnet2"
+	existingSubnetPort := &model.VpcSubnetPort{
+		Id:         &subnetPortId1,
+		Path:       &subnetPortPath1,
+		ParentPath: &subnetPath,
+		Attachment: &model.PortAttachment{Id: common.String("attachment-1")},
+	}
+	tests := []struct {
+		name              string
+		macAddress        string
+		ipAddress         string
+		preserveIP        bool
+		allocateAddresses string
+		patchErr          error
+		deleteErr         error
+		wantErr           bool
+		wantPatches       int
+		wantAllocate      string
+		wantMACAddress    *string
+		wantIPAddress     *string
+	}{
+		{
+			name:              "PreserveMACOnStaticIPSubnet",
+			macAddress:        "aa:bb:cc:dd:ee:ff",
+			ipAddress:         "10.0.0.5/24",
+			allocateAddresses: "BOTH",
+			wantPatches:       3,
+			wantAllocate:      "IP_POOL",
+			wantMACAddress:    common.String("aa:bb:cc:dd:ee:ff"),
+		},
+		{
+			name:              "PreserveIPAndMACOnStaticIPSubnet",
+			macAddress:        "aa:bb:cc:dd:ee:ff",
+			ipAddress:         "10.0.1.5/24",
+			preserveIP:        true,
+			allocateAddresses: "BOTH",
+			wantPatches:       3,
+			wantAllocate:      "IP_POOL",
+			wantMACAddress:    common.String("aa:bb:cc:dd:ee:ff"),
+			wantIPAddress:     common.String("10.0.1.5"),
+		},
+		{
+			name:              "PreserveIPAndMACOnDHCPSubnet",
+			macAddress:        "aa:bb:cc:dd:ee:ff",
+			ipAddress:         "10.0.1.5/24",
+			preserveIP:        true,
+			allocateAddresses: "NONE",
+			wantPatches:       3,
+			wantAllocate:      "NONE",
+			wantMACAddress:    common.String("aa:bb:cc:dd:ee:ff"),
+			wantIPAddress:     common.String("10.0.1.5"),
+		},
+		{
+			name:              "IPNotInNewSubnet",
+			macAddress:        "aa:bb:cc:dd:ee:ff",
+			ipAddress:         "10.0.0.5/24",
+			preserveIP:        true,
+			allocateAddresses: "BOTH",
+			wantErr:           true,
+			wantAllocate:      "BOTH",
+		},
+		{
+			name:              "DHCPSubnet",
+			macAddress:        "aa:bb:cc:dd:ee:ff",
+			allocateAddresses: "NONE",
+			wantPatches:       3,
+			wantAllocate:      "NONE",
+		},
+		{
+			name:              "MACNotRealized",
+			allocateAddresses: "BOTH",
+			wantPatches:       3,
+			wantAllocate:      "BOTH",
+		},
+		{
+			name:              "CreateFailure",
+			allocateAddresses: "BOTH",
+			patchErr:          fmt.Errorf("mock error"),
+			wantErr:           true,
+			wantPatches:       1,
+			wantAllocate:      "BOTH",
+		},
+		{
+			name:              "DeleteFailure",
+			allocateAddresses: "BOTH",
+			deleteErr:         fmt.Errorf("mock error"),
+			wantErr:           true,
+			wantPatches:       3,
+			wantAllocate:      "BOTH",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &SubnetPortService{
+				Service: common.Service{
+					NSXClient: &nsx.Client{
+						PortClient: &fakePortClient{},
+					},
+				},
+				SubnetPortStore: &SubnetPortStore{ResourceStore: common.ResourceStore{
+					Indexer: cache.NewIndexer(
+						keyFunc,
+						cache.Indexers{
+							common.TagScopeSubnetPortCRUID: subnetPortIndexByCRUID,
+							common.TagScopePodUID:          subnetPortIndexByPodUID,
+						}),
+					BindingType: model.VpcSubnetPortBindingType(),
+				}},
+			}
+			service.SubnetPortStore.Add(existingSubnetPort)
+			// The operations are recorded in order as "<subnet ID>/<attachment ID>" for patches and "delete/<subnet ID>".
+			var operations []string
+			patchCount := 0
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(&fakePortClient{}), "Patch", func(_ *fakePortClient, _ string, _ string, _ string, subnetID string, _ string, port model.VpcSubnetPort) error {
+				patchCount++
+				operations = append(operations, subnetID+"/"+*port.Attachment.Id)
+				return tt.patchErr
+			})
+			patches.ApplyMethod(reflect.TypeOf(&fakePortClient{}), "Delete", func(_ *fakePortClient, _ string, _ string, _ string, subnetID string, _ string) error {
+				operations = append(operations, "delete/"+subnetID)
+				return tt.deleteErr
+			})
+			defer patches.Reset()
+
+			subnetPort := &v1alpha1.SubnetPort{
+				Status: v1alpha1.SubnetPortStatus{
+					NetworkInterfaceConfig: v1alpha1.NetworkInterfaceConfig{MACAddress: tt.macAddress},
+				},
+			}
+			if tt.ipAddress != "" {
+				subnetPort.Status.NetworkInterfaceConfig.IPAddresses = []v1alpha1.NetworkInterfaceIPAddress{{IPAddress: tt.ipAddress}}
+			}
+			if tt.preserveIP {
+				subnetPort.Annotations = map[string]string{common.AnnotationPreserveIPOnMigration: "true"}
+			}
+			nsxSubnetPort := &model.VpcSubnetPort{
+				Id:         &subnetPortId1,
+				Attachment: &model.PortAttachment{Id: common.String("attachment-2"), AllocateAddresses: common.String(tt.allocateAddresses)},
+			}
+			nsxSubnet := &model.VpcSubnet{Path: &newSubnetPath, IpAddresses: []string{"10.0.1.0/24"}}
+			err := service.migrateSubnetPort(subnetPort, existingSubnetPort, nsxSubnetPort, nsxSubnet, "")
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantAllocate, *nsxSubnetPort.Attachment.AllocateAddresses)
+			assert.Equal(t, tt.wantPatches, patchCount)
+			if tt.wantPatches == 3 {
+				// The new SubnetPort is created with a staging attachment, the attachment is released from the old
+				// SubnetPort and bound to the new one, and the old SubnetPort is deleted last.
+				assert.True(t, strings.HasPrefix(operations[0], "subnet2/"))
+				assert.NotEqual(t, "subnet2/attachment-1", operations[0])
+				assert.True(t, strings.HasPrefix(operations[1], "subnet1/"))
+				assert.NotEqual(t, "subnet1/attachment-1", operations[1])
+				assert.Equal(t, []string{"subnet2/attachment-1", "delete/subnet1"}, operations[2:])
+				assert.Equal(t, "attachment-1", *nsxSubnetPort.Attachment.Id)
+			}
+			if tt.wantMACAddress != nil {
+				assert.Equal(t, 1, len(nsxSubnetPort.AddressBindings))
+				assert.Equal(t, tt.wantMACAddress, nsxSubnetPort.AddressBindings[0].MacAddress)
+				assert.Equal(t, tt.wantIPAddress, nsxSubnetPort.AddressBindings[0].IpAddress)
+			} else {
+				assert.Empty(t, nsxSubnetPort.AddressBindings)
+			}
+			assert.Equal(t, tt.wantErr, service.SubnetPortStore.GetByKey(subnetPortId1) != nil)
+		})
+	}
+}
+
 func TestSubnetPortService_GetSubnetPathForSubnetPortFromStore(t *testing.T) {
 	crUID := types.UID("aaaaaaaa")
 	type args struct {
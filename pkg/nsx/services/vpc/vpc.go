@@ -21,7 +21,6 @@ import (
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/realizestate"
 	nsxutil "github.com/vmware-tanzu/nsx-operator/pkg/nsx/util"
-	"github.com/vmware-tanzu/nsx-operator/pkg/util"
 )
 
 type LBProvider string
@@ -616,6 +615,7 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 		}
 	}
 	// build HAPI request
+	owner := vpcRealizationOwner(obj)
 	createdAttachment, _ := buildVpcAttachment(obj, nsObj, s.NSXConfig.Cluster, nc.Spec.VPCConnectivityProfile, restoreMode)
 
 	// if there is no change in public cidr and private cidr, build partial vpc will return nil
@@ -626,15 +626,15 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 		// Recheck the realizedstate if the Namespace CR is not ready.
 		if !isNamespaceReady(nsObj) {
 			// Check VPC realization state
-			if err := s.checkVPCRealizationState(existingVPC[0], *existingVPC[0].Path); err != nil {
+			if err := s.checkVPCRealizationState(existingVPC[0], *existingVPC[0].Path, owner, false); err != nil {
 				return nil, err
 			}
 			// Check LBS realization
-			if err := s.checkLBSRealization(createdLBS, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
+			if err := s.checkLBSRealization(createdLBS, existingVPC[0], nc, *existingVPC[0].Path, owner, false); err != nil {
 				return nil, err
 			}
 			// Check VpcAttachment realization
-			if err := s.checkVpcAttachmentRealization(createdAttachment, existingVPC[0], nc, *existingVPC[0].Path); err != nil {
+			if err := s.checkVpcAttachmentRealization(createdAttachment, existingVPC[0], nc, *existingVPC[0].Path, owner, false); err != nil {
 				return nil, err
 			}
 		}
@@ -660,22 +660,24 @@ func (s *VPCService) CreateOrUpdateVPC(ctx context.Context, obj *v1alpha1.Networ
 		return nil, err
 	}
 
-	// Check VPC realization state
-	if err := s.checkVPCRealizationState(createdVpc, *newVpc.Path); err != nil {
+	// The VPC is saved to the store before it is realized, so that its realization state is rechecked when the
+	// NetworkInfo CR is requeued by the realization watcher.
+	if err := s.VpcStore.Add(&newVpc); err != nil {
 		return nil, err
 	}
 
-	if err := s.VpcStore.Add(&newVpc); err != nil {
+	// Check VPC realization state
+	if err := s.checkVPCRealizationState(createdVpc, *newVpc.Path, owner, true); err != nil {
 		return nil, err
 	}
 
 	// Check LBS realization
-	if err := s.checkLBSRealization(createdLBS, createdVpc, nc, *newVpc.Path); err != nil {
+	if err := s.checkLBSRealization(createdLBS, createdVpc, nc, *newVpc.Path, owner, true); err != nil {
 		return nil, err
 	}
 
 	// Check VpcAttachment realization
-	if err := s.checkVpcAttachmentRealization(createdAttachment, createdVpc, nc, *newVpc.Path); err != nil {
+	if err := s.checkVpcAttachmentRealization(createdAttachment, createdVpc, nc, *newVpc.Path, owner, true); err != nil {
 		return nil, err
 	}
 
@@ -709,10 +711,29 @@ func (s *VPCService) createNSXVPC(createdVpc *model.Vpc, nc *v1alpha1.VPCNetwork
 	return nil
 }
 
-func (s *VPCService) checkVPCRealizationState(createdVpc *model.Vpc, newVpcPath string) error {
+// vpcRealizationOwner returns the owner requeued when the VPC, LBS and VpcAttachment of the NetworkInfo CR are
+// realized.
+func vpcRealizationOwner(obj *v1alpha1.NetworkInfo) realizestate.RealizationOwner {
+	return realizestate.RealizationOwner{
+		Kind:           common.ResourceTypeVpc,
+		NamespacedName: types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name},
+	}
+}
+
+// checkVPCRealizationState returns a RealizationPendingError if the VPC is not realized yet, the NetworkInfo CR is
+// requeued when the realization finishes. updated is set if the VPC is just patched, the result of the previous
+// version is dropped then.
+func (s *VPCService) checkVPCRealizationState(createdVpc *model.Vpc, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 	log.Trace("Check VPC realization state", "VPC", *createdVpc.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err := realizeService.CheckRealizeState(util.NSXTRealizeRetry, newVpcPath, []string{common.GatewayInterfaceId}); err != nil {
+	if updated {
+		realizestate.ForgetRealizeState(newVpcPath)
+	}
+	if err := realizeService.CheckRealizeStateAsync(newVpcPath, []string{common.GatewayInterfaceId}, owner); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("VPC is being realized", "VPC", *createdVpc.Id)
+			return err
+		}
 		log.Error(err, "Failed to check VPC realization state", "VPC", *createdVpc.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created VPC is in error realization state, cleaning the resource", "VPC", *createdVpc.Id)
@@ -749,7 +770,7 @@ func (s *VPCService) buildLBServiceIPAllocForRestore(obj *v1alpha1.NetworkInfo,
 	return buildNSXLBServiceIPAllocation(obj.VPCs[0].LoadBalancerIPAddresses), nil
 }
 
-func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 	if createdLBS == nil {
 		return nil
 	}
@@ -770,7 +791,14 @@ func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc
 
 	log.Trace("Check LBS realization state", "LBS", *createdLBS.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err = realizeService.CheckRealizeState(util.NSXTRealizeRetry, *newLBS.Path, []string{}); err != nil {
+	if updated {
+		realizestate.ForgetRealizeState(*newLBS.Path)
+	}
+	if err = realizeService.CheckRealizeStateAsync(*newLBS.Path, []string{}, owner); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("LBS is being realized", "LBS", *createdLBS.Id)
+			return err
+		}
 		log.Error(err, "Failed to check LBS realization state", "LBS", *createdLBS.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created LBS is in error realization state, cleaning the resource", "LBS", *createdLBS.Id)
@@ -785,7 +813,7 @@ func (s *VPCService) checkLBSRealization(createdLBS *model.LBService, createdVpc
 	return nil
 }
 
-func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 	if createdAttachment == nil {
 		return nil
 	}
@@ -804,7 +832,14 @@ func (s *VPCService) checkVpcAttachmentRealization(createdAttachment *model.VpcA
 	}
 	log.Trace("Check VPC attachment realization state", "VpcAttachment", *createdAttachment.Id)
 	realizeService := realizestate.InitializeRealizeState(s.Service)
-	if err = realizeService.CheckRealizeState(util.NSXTRealizeRetry, *newAttachment.Path, []string{}); err != nil {
+	if updated {
+		realizestate.ForgetRealizeState(*newAttachment.Path)
+	}
+	if err = realizeService.CheckRealizeStateAsync(*newAttachment.Path, []string{}, owner); err != nil {
+		if nsxutil.IsRealizationPendingError(err) {
+			log.Info("VPC attachment is being realized", "VpcAttachment", *createdAttachment.Id)
+			return err
+		}
 		log.Error(err, "Failed to check VPC attachment realization state", "VpcAttachment", *createdAttachment.Id)
 		if nsxutil.IsRealizeStateError(err) {
 			log.Error(err, "The created VPC attachment is in error realization state, cleaning the resource", "VpcAttachment", *createdAttachment.Id)
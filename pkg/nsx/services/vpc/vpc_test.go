@@ -2264,13 +2264,13 @@ func TestVPCService_CreateOrUpdateVPC(t *testing.T) {
 						{Path: &vpcPath, Id: &fakeVPCID},
 					}
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
 				return patches
@@ -2311,13 +2311,13 @@ func TestVPCService_CreateOrUpdateVPC(t *testing.T) {
 				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "createNSXVPC", func(_ *VPCService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, orgRoot *model.OrgRoot) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVPCRealizationState", func(_ *VPCService, createdVpc *model.Vpc, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkLBSRealization", func(_ *VPCService, createdLBS *model.LBService, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
-				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string) error {
+				patches.ApplyPrivateMethod(reflect.TypeOf(vpcService), "checkVpcAttachmentRealization", func(_ *VPCService, createdAttachment *model.VpcAttachment, createdVpc *model.Vpc, nc *v1alpha1.VPCNetworkConfiguration, newVpcPath string, owner realizestate.RealizationOwner, updated bool) error {
 					return nil
 				})
 				vpcPath := "/vpc/1"
@@ -2445,6 +2445,28 @@ func TestVPCService_checkVPCRealizationState(t *testing.T) {
 			},
 			expectedErr: "mocked deletion error",
 		},
+		{
+			name: "Pending",
+			prepareFunc: func() *gomonkey.Patches {
+				patches := gomonkey.ApplyMethod(service.NSXClient.RealizedEntitiesClient, "List",
+					func(c *fakeRealizedEntitiesClient, intentPathParam string, sitePathParam *string) (model.GenericPolicyRealizedResourceListResult, error) {
+						return model.GenericPolicyRealizedResourceListResult{
+							Results: []model.GenericPolicyRealizedResource{
+								{
+									Id:    common.String(common.GatewayInterfaceId),
+									State: common.String(model.GenericPolicyRealizedResource_STATE_UNREALIZED),
+								},
+							},
+						}, nil
+					})
+				patches.ApplyMethod(service, "DeleteVPC", func(s *VPCService, path string) error {
+					assert.FailNow(t, "The VPC being realized should not be deleted")
+					return nil
+				})
+				return patches
+			},
+			expectedErr: "is being realized",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2453,7 +2475,8 @@ func TestVPCService_checkVPCRealizationState(t *testing.T) {
 				patches := tt.prepareFunc()
 				defer patches.Reset()
 			}
-			err := service.checkVPCRealizationState(&model.Vpc{Id: common.String("vpc-1")}, "/vpc-path")
+			defer realizestate.ForgetRealizeState("/vpc-path")
+			err := service.checkVPCRealizationState(&model.Vpc{Id: common.String("vpc-1")}, "/vpc-path", realizestate.RealizationOwner{}, true)
 			if tt.expectedErr != "" {
 				assert.Contains(t, err.Error(), tt.expectedErr)
 			} else {
@@ -2572,6 +2595,8 @@ func TestVPCService_checkLBSRealization(t *testing.T) {
 				&model.Vpc{Id: common.String("vpc-1")},
 				tt.nc,
 				"/vpc-path",
+				realizestate.RealizationOwner{},
+				true,
 			)
 			if tt.expectedErr != "" {
 				assert.Contains(t, err.Error(), tt.expectedErr)
@@ -2691,6 +2716,8 @@ func TestVPCService_checkVpcAttachmentRealization(t *testing.T) {
 				&model.Vpc{Id: common.String("vpc-1")},
 				tt.nc,
 				"/vpc-path",
+				realizestate.RealizationOwner{},
+				true,
 			)
 			if tt.expectedErr != "" {
 				assert.Contains(t, err.Error(), tt.expectedErr)
@@ -0,0 +1,224 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+)
+
+// Stable reason codes of the errors, they are set to the Reason of the Ready=False condition of the CRs, so that
+// tooling can alert on them without matching the error messages. The codes must not be changed once released.
+const (
+	ErrorReasonIPBlockExhausted      = "IPBlockExhausted"
+	ErrorReasonSubnetIPExhausted     = "SubnetIPExhausted"
+	ErrorReasonAddressOverlap        = "AddressOverlap"
+	ErrorReasonInvalidIPAllocation   = "InvalidIPAllocation"
+	ErrorReasonResourceInUse         = "ResourceInUse"
+	ErrorReasonResourcePendingDelete = "ResourcePendingDelete"
+	ErrorReasonResourceNotFound      = "NSXResourceNotFound"
+	ErrorReasonRealizationFailed     = "RealizationFailed"
+	ErrorReasonInvalidLicense        = "InvalidLicense"
+	ErrorReasonAuthenticationFailed  = "NSXAuthenticationFailed"
+	ErrorReasonNSXUnavailable        = "NSXUnavailable"
+	ErrorReasonTagLimitExceeded      = "TagLimitExceeded"
+	ErrorReasonGroupCapacityReached  = "GroupCapacityReached"
+	ErrorReasonUnsupportedConfig     = "UnsupportedConfiguration"
+	ErrorReasonInvalidConfig         = "InvalidConfiguration"
+)
+
+// errorReasonHints are the user-facing remediation hints of the reason codes.
+var errorReasonHints = map[string]string{
+	ErrorReasonIPBlockExhausted:      "All the IP blocks are exhausted, add IP blocks to the VPC connectivity profile or release unused Subnets.",
+	ErrorReasonSubnetIPExhausted:     "No IP is available in the Subnet, release unused IPs or use a Subnet with more IPs.",
+	ErrorReasonAddressOverlap:        "The addresses overlap with existing NSX resources, use non-overlapping CIDRs.",
+	ErrorReasonInvalidIPAllocation:   "The IP allocation of the Subnet is invalid, check the IP pool and reserved IP ranges.",
+	ErrorReasonResourceInUse:         "The NSX resource is still referenced by other resources, remove the references first.",
+	ErrorReasonResourcePendingDelete: "The NSX resource is being deleted, it will be retried after the deletion completes.",
+	ErrorReasonResourceNotFound:      "A referenced NSX resource is not found, check that it exists in NSX.",
+	ErrorReasonRealizationFailed:     "NSX failed to realize the resource, check the alarms of the resource in NSX.",
+	ErrorReasonInvalidLicense:        "NSX has no valid license for the feature, apply a license in NSX.",
+	ErrorReasonAuthenticationFailed:  "The operator failed to authenticate with NSX, check the NSX credentials or certificates.",
+	ErrorReasonNSXUnavailable:        "NSX is unavailable or busy, it will be retried.",
+	ErrorReasonTagLimitExceeded:      "Too many tags are set on the NSX resource, reduce the labels or tags of the CR.",
+	ErrorReasonGroupCapacityReached:  "The NSX group has reached its maximum capacity, reduce the members of the group.",
+	ErrorReasonUnsupportedConfig:     "The configuration is not supported by the NSX version.",
+	ErrorReasonInvalidConfig:         "The configuration is rejected by NSX, correct the CR spec.",
+}
+
+// errorCodeReasons are the reason codes of the NSX API error codes.
+var errorCodeReasons = map[int64]string{
+	InvalidLicenseErrorCode:                   ErrorReasonInvalidLicense,
+	IPAllocationErrorCode:                     ErrorReasonSubnetIPExhausted,
+	ReservedIPRangesOverlappedErrorCode:       ErrorReasonInvalidIPAllocation,
+	ReservedIPRangesOutOfSubnetRangeErrorCode: ErrorReasonInvalidIPAllocation,
+	MixedModeNotSupportedErrorCode:            ErrorReasonUnsupportedConfig,
+	ResourceInUseErrorCode:                    ErrorReasonResourceInUse,
+	PendingDeleteErrorCode:                    ErrorReasonResourcePendingDelete,
+	OverlapAddressesErrorCode:                 ErrorReasonAddressOverlap,
+	OverlapVlanErrorCode:                      ErrorReasonAddressOverlap,
+}
+
+// errorTypeReasons are the reason codes of the NSX API error types not classified by the error codes.
+var errorTypeReasons = map[apierrors.ErrorTypeEnum]string{
+	apierrors.ErrorType_SERVICE_UNAVAILABLE:           ErrorReasonNSXUnavailable,
+	apierrors.ErrorType_TIMED_OUT:                     ErrorReasonNSXUnavailable,
+	apierrors.ErrorType_RESOURCE_BUSY:                 ErrorReasonNSXUnavailable,
+	apierrors.ErrorType_UNAUTHENTICATED:               ErrorReasonAuthenticationFailed,
+	apierrors.ErrorType_UNAUTHORIZED:                  ErrorReasonAuthenticationFailed,
+	apierrors.ErrorType_UNVERIFIED_PEER:               ErrorReasonAuthenticationFailed,
+	apierrors.ErrorType_RESOURCE_IN_USE:               ErrorReasonResourceInUse,
+	apierrors.ErrorType_NOT_FOUND:                     ErrorReasonResourceNotFound,
+	apierrors.ErrorType_UNSUPPORTED:                   ErrorReasonUnsupportedConfig,
+	apierrors.ErrorType_INVALID_REQUEST:               ErrorReasonInvalidConfig,
+	apierrors.ErrorType_INVALID_ARGUMENT:              ErrorReasonInvalidConfig,
+	apierrors.ErrorType_UNABLE_TO_ALLOCATE_RESOURCE:   ErrorReasonIPBlockExhausted,
+	apierrors.ErrorType_INVALID_ELEMENT_CONFIGURATION: ErrorReasonInvalidConfig,
+}
+
+// ReasonedError is an error classified with a stable reason code and a remediation hint.
+type ReasonedError struct {
+	Reason string
+	Hint   string
+	Err    error
+}
+
+func (e *ReasonedError) Error() string {
+	if e.Hint == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s. %s", e.Err.Error(), e.Hint)
+}
+
+func (e *ReasonedError) Unwrap() error {
+	return e.Err
+}
+
+// WithErrorReason returns the ReasonedError of err if it is classified by ClassifyError, otherwise err is returned.
+func WithErrorReason(err error) error {
+	if err == nil {
+		return nil
+	}
+	var reasonedErr *ReasonedError
+	if errors.As(err, &reasonedErr) {
+		return err
+	}
+	reason := ClassifyError(err)
+	if reason == "" {
+		return err
+	}
+	return &ReasonedError{Reason: reason, Hint: errorReasonHints[reason], Err: err}
+}
+
+// ErrorReason returns the reason code of err, defaultReason is returned if err is not classified.
+func ErrorReason(err error, defaultReason string) string {
+	var reasonedErr *ReasonedError
+	if errors.As(err, &reasonedErr) {
+		return reasonedErr.Reason
+	}
+	if reason := ClassifyError(err); reason != "" {
+		return reason
+	}
+	return defaultReason
+}
+
+// ClassifyError returns the stable reason code of err, empty string is returned if err is not a known NSX error.
+func ClassifyError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var realizeStateErr *RealizeStateError
+	var nsxAPIErr *NSXApiError
+	switch {
+	case errors.As(err, &IPBlockAllExhaustedError{}):
+		return ErrorReasonIPBlockExhausted
+	case errors.As(err, &SubnetExhaustedError{}):
+		return ErrorReasonSubnetIPExhausted
+	case errors.As(err, &ExceedTagsError{}):
+		return ErrorReasonTagLimitExceeded
+	case errors.As(err, &realizeStateErr):
+		if realizeStateErr.GetCode() == IPAllocationErrorCode {
+			return ErrorReasonSubnetIPExhausted
+		}
+		return ErrorReasonRealizationFailed
+	case errors.As(err, &nsxAPIErr):
+		return classifyNSXApiError(nsxAPIErr)
+	}
+	return classifyNsxError(err)
+}
+
+// classifyNsxError classifies the errors converted from the NSX HTTP responses.
+func classifyNsxError(err error) string {
+	var (
+		overlapAddresses *NsxOverlapAddresses
+		overlapVlan      *NsxOverlapVlan
+		resourceInUse    *ResourceInUse
+		pendingDelete    *NsxPendingDelete
+		resourceNotFound *ResourceNotFound
+		backendNotFound  *BackendResourceNotFound
+		invalidLicense   *InvalidLicense
+		invalidCreds     *InvalidCredentials
+		certNotTrusted   *ClientCertificateNotTrusted
+		badXSRFToken     *BadXSRFToken
+		serverBusy       ServerBusy
+		cannotConnect    *CannotConnectToServer
+		connectionErr    *ConnectionError
+		timeout          *Timeout
+		clusterDown      *ServiceClusterUnavailable
+		txnAborted       *APITransactionAborted
+		groupFull        *NSGroupIsFull
+		groupMaxReached  *SecurityGroupMaximumCapacityReached
+		invalidInput     NsxLibInvalidInput
+	)
+	switch {
+	case errors.As(err, &overlapAddresses), errors.As(err, &overlapVlan):
+		return ErrorReasonAddressOverlap
+	case errors.As(err, &resourceInUse):
+		return ErrorReasonResourceInUse
+	case errors.As(err, &pendingDelete):
+		return ErrorReasonResourcePendingDelete
+	case errors.As(err, &resourceNotFound), errors.As(err, &backendNotFound):
+		return ErrorReasonResourceNotFound
+	case errors.As(err, &invalidLicense):
+		return ErrorReasonInvalidLicense
+	case errors.As(err, &invalidCreds), errors.As(err, &certNotTrusted), errors.As(err, &badXSRFToken):
+		return ErrorReasonAuthenticationFailed
+	case errors.As(err, &serverBusy), errors.As(err, &cannotConnect), errors.As(err, &connectionErr),
+		errors.As(err, &timeout), errors.As(err, &clusterDown), errors.As(err, &txnAborted):
+		return ErrorReasonNSXUnavailable
+	case errors.As(err, &groupFull), errors.As(err, &groupMaxReached):
+		return ErrorReasonGroupCapacityReached
+	case errors.As(err, &invalidInput):
+		return ErrorReasonInvalidConfig
+	}
+	return ""
+}
+
+// classifyNSXApiError classifies the NSX API error by its error code and related error codes, then by its error type.
+func classifyNSXApiError(err *NSXApiError) string {
+	if err.ApiError != nil {
+		if err.ErrorCode != nil {
+			if reason := classifyErrorCode(*err.ErrorCode); reason != "" {
+				return reason
+			}
+		}
+		for _, relatedErr := range err.RelatedErrors {
+			if relatedErr.ErrorCode != nil {
+				if reason := classifyErrorCode(*relatedErr.ErrorCode); reason != "" {
+					return reason
+				}
+			}
+		}
+	}
+	return errorTypeReasons[err.ErrorTypeEnum]
+}
+
+func classifyErrorCode(code int64) string {
+	if IsMixedModeIPAllocationError(code) {
+		return ErrorReasonInvalidIPAllocation
+	}
+	return errorCodeReasons[code]
+}
@@ -0,0 +1,76 @@
+/* Copyright © 2026 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: Apache-2.0 */
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "nil", err: nil, expected: ""},
+		{name: "unknown error", err: errors.New("unknown"), expected: ""},
+		{name: "IP blocks exhausted", err: IPBlockAllExhaustedError{Desc: "exhausted"}, expected: ErrorReasonIPBlockExhausted},
+		{name: "Subnet exhausted", err: SubnetExhaustedError{Desc: "exhausted"}, expected: ErrorReasonSubnetIPExhausted},
+		{name: "wrapped tags exceeded", err: fmt.Errorf("failed to build: %w", ExceedTagsError{Desc: "too many tags"}), expected: ErrorReasonTagLimitExceeded},
+		{name: "realized with errors", err: NewRealizeStateError("realized with errors", 0), expected: ErrorReasonRealizationFailed},
+		{name: "no IP in Subnet", err: NewRealizeStateError("realized with errors", IPAllocationErrorCode), expected: ErrorReasonSubnetIPExhausted},
+		{name: "overlapping addresses", err: CreateNsxOverlapAddresses("10.0.0.0/24"), expected: ErrorReasonAddressOverlap},
+		{name: "resource in use", err: CreateResourceInUse(), expected: ErrorReasonResourceInUse},
+		{name: "invalid license", err: CreateInvalidLicense("no license"), expected: ErrorReasonInvalidLicense},
+		{name: "server busy", err: &TooManyRequests{}, expected: ErrorReasonNSXUnavailable},
+		{name: "invalid input", err: CreateNsxLibInvalidInput("bad input"), expected: ErrorReasonInvalidConfig},
+		{
+			name:     "NSX API error code",
+			err:      NewNSXApiError(&model.ApiError{ErrorCode: int64Ptr(500105)}, apierrors.ErrorType_INVALID_REQUEST),
+			expected: ErrorReasonAddressOverlap,
+		},
+		{
+			name:     "NSX API related error code",
+			err:      NewNSXApiError(&model.ApiError{ErrorCode: int64Ptr(1), RelatedErrors: []model.RelatedApiError{{ErrorCode: int64Ptr(660003)}}}, apierrors.ErrorType_INVALID_REQUEST),
+			expected: ErrorReasonInvalidIPAllocation,
+		},
+		{
+			name:     "NSX API error type",
+			err:      NewNSXApiError(&model.ApiError{}, apierrors.ErrorType_SERVICE_UNAVAILABLE),
+			expected: ErrorReasonNSXUnavailable,
+		},
+		{
+			name:     "unknown NSX API error",
+			err:      NewNSXApiError(&model.ApiError{}, apierrors.ErrorType_ERROR),
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ClassifyError(tt.err))
+		})
+	}
+}
+
+func TestWithErrorReason(t *testing.T) {
+	assert.Nil(t, WithErrorReason(nil))
+
+	err := errors.New("unknown")
+	assert.Equal(t, err, WithErrorReason(err))
+	assert.Equal(t, "NotReady", ErrorReason(err, "NotReady"))
+
+	err = CreateResourceInUse()
+	reasonedErr := WithErrorReason(err)
+	assert.Equal(t, ErrorReasonResourceInUse, ErrorReason(reasonedErr, "NotReady"))
+	assert.Equal(t, fmt.Sprintf("%s. %s", err.Error(), errorReasonHints[ErrorReasonResourceInUse]), reasonedErr.Error())
+	assert.ErrorIs(t, reasonedErr, err)
+	// The classified error is not wrapped again.
+	assert.Equal(t, reasonedErr, WithErrorReason(reasonedErr))
+}
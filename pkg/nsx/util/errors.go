@@ -4,6 +4,7 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -15,6 +16,10 @@ const (
 	IPAllocationErrorCode                     = 8212
 	ReservedIPRangesOverlappedErrorCode       = 508134
 	ReservedIPRangesOutOfSubnetRangeErrorCode = 508135
+	ResourceInUseErrorCode                    = 500030
+	PendingDeleteErrorCode                    = 500045
+	OverlapAddressesErrorCode                 = 500105
+	OverlapVlanErrorCode                      = 8327
 	// MixedModeNotSupportedErrorCode is returned by NSX when both
 	// static_ip_allocation.enabled=true and subnet_dhcp_config.mode=DHCP_SERVER
 	// are set simultaneously and the NSX version does not support mixed-mode subnets.
@@ -598,6 +603,15 @@ func (err IPBlockAllExhaustedError) Error() string {
 	return err.Desc
 }
 
+// SubnetExhaustedError is returned when no IP is available in the Subnets to allocate a port.
+type SubnetExhaustedError struct {
+	Desc string
+}
+
+func (err SubnetExhaustedError) Error() string {
+	return err.Desc
+}
+
 type ExceedTagsError struct {
 	Desc string
 }
@@ -663,6 +677,25 @@ func NewRetryRealizeError(msg string) *RetryRealizeError {
 	return &RetryRealizeError{message: msg}
 }
 
+// RealizationPendingError indicates the intent path is not realized yet and is being watched, the owner CR is
+// requeued once it is realized or fails to be realized.
+type RealizationPendingError struct {
+	message string
+}
+
+func (e *RealizationPendingError) Error() string {
+	return e.message
+}
+
+func NewRealizationPendingError(msg string) *RealizationPendingError {
+	return &RealizationPendingError{message: msg}
+}
+
+func IsRealizationPendingError(err error) bool {
+	var pendingErr *RealizationPendingError
+	return errors.As(err, &pendingErr)
+}
+
 func IsRetryRealizeError(alarm model.PolicyAlarmResource) bool {
 	// The ProviderNotReady error indicates NSX get timeout when waiting for the dependencies
 	// and may become Realized after retry.
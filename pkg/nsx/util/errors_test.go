@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -217,6 +218,15 @@ func TestRetryRealizeError(t *testing.T) {
 	})
 }
 
+func TestRealizationPendingError(t *testing.T) {
+	err := NewRealizationPendingError("pending message")
+	assert.Equal(t, "pending message", err.Error())
+	assert.True(t, IsRealizationPendingError(err))
+	assert.True(t, IsRealizationPendingError(fmt.Errorf("wrapped: %w", err)))
+	assert.False(t, IsRealizationPendingError(NewRealizeStateError("test", 1)))
+	assert.False(t, IsRealizationPendingError(nil))
+}
+
 func TestIsRetryRealizeError(t *testing.T) {
 	tests := []struct {
 		name     string
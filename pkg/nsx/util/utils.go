@@ -190,17 +190,17 @@ var (
 		},
 		"400": // http.StatusBadRequest
 		{
-			"60508":  func() NsxError { return &NsxIndexingInProgress{} },
-			"60514":  func() NsxError { return &NsxSearchTimeout{} },
-			"60515":  func() NsxError { return &NsxSearchOutOfSync{} },
-			"8327":   func() NsxError { return &NsxOverlapVlan{} },
-			"500045": func() NsxError { return &NsxPendingDelete{} },
-			"500030": func() NsxError { return &ResourceInUse{} },
-			"500087": func() NsxError { return &StaleRevision{} },
-			"500105": func() NsxError { return &NsxOverlapAddresses{} },
-			"500232": func() NsxError { return &StaleRevision{} },
-			"503040": func() NsxError { return &NsxSegmentWithVM{} },
-			"100148": func() NsxError { return &StaleRevision{} },
+			"60508":                                 func() NsxError { return &NsxIndexingInProgress{} },
+			"60514":                                 func() NsxError { return &NsxSearchTimeout{} },
+			"60515":                                 func() NsxError { return &NsxSearchOutOfSync{} },
+			strconv.Itoa(OverlapVlanErrorCode):      func() NsxError { return &NsxOverlapVlan{} },
+			strconv.Itoa(PendingDeleteErrorCode):    func() NsxError { return &NsxPendingDelete{} },
+			strconv.Itoa(ResourceInUseErrorCode):    func() NsxError { return &ResourceInUse{} },
+			"500087":                                func() NsxError { return &StaleRevision{} },
+			strconv.Itoa(OverlapAddressesErrorCode): func() NsxError { return &NsxOverlapAddresses{} },
+			"500232":                                func() NsxError { return &StaleRevision{} },
+			"503040":                                func() NsxError { return &NsxSegmentWithVM{} },
+			"100148":                                func() NsxError { return &StaleRevision{} },
 		},
 		"500": // http.StatusInternalServerError
 		{
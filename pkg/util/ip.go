@@ -229,20 +229,26 @@ func rangesAbstractRange(ranges [][]net.IP, except []net.IP) [][]net.IP {
 	return results
 }
 
+// GetCIDRRangesWithExcept returns the IP ranges of the CIDR after removing the excepts, e.g.
+// "172.0.0.0/16" except ["172.0.100.0/24"] -> ["172.0.0.0-172.0.99.255", "172.0.101.0-172.0.255.255"]
+// Both IPv4 and IPv6 are supported, the excepts must be in the same IP family as the CIDR.
 func GetCIDRRangesWithExcept(cidr string, excepts []string) ([]string, error) {
 	var calculatedRanges [][]net.IP
 	var resultRanges []string
 	mainStartIP, mainEndIP, err := parseCIDRRange(cidr)
-	calculatedRanges = append(calculatedRanges, []net.IP{mainStartIP, mainEndIP})
 	if err != nil {
 		return nil, err
 	}
+	calculatedRanges = append(calculatedRanges, []net.IP{mainStartIP, mainEndIP})
 	for _, ept := range excepts {
 		except := ept
 		exceptStartIP, exceptEndIP, err := parseCIDRRange(except)
 		if err != nil {
 			return nil, err
 		}
+		if len(exceptStartIP) != len(mainStartIP) {
+			return nil, fmt.Errorf("except %s is not in the same IP family as CIDR %s", except, cidr)
+		}
 		newCalculatedRanges := rangesAbstractRange(calculatedRanges, []net.IP{exceptStartIP, exceptEndIP})
 		calculatedRanges = newCalculatedRanges
 		log.Trace("Abstracted ranges after removing excepts", "except", except, "ranges", calculatedRanges)
@@ -392,9 +392,10 @@ func TestGetCIDRRangesWithExcept_IPv6(t *testing.T) {
 		excepts []string
 	}
 	tests := []struct {
-		name string
-		args args
-		want []string
+		name    string
+		args    args
+		want    []string
+		wantErr bool
 	}{
 		{
 			name: "IPv6 single except",
@@ -412,9 +413,39 @@ func TestGetCIDRRangesWithExcept_IPv6(t *testing.T) {
 			},
 			want: []string{"fd00::-fd00::9", "fd00::b-fd00::13", "fd00::15-fd00::ffff"},
 		},
+		{
+			name: "IPv6 except covers the whole CIDR",
+			args: args{
+				cidr:    "fd00::/120",
+				excepts: []string{"fd00::/112"},
+			},
+			want: nil,
+		},
+		{
+			name: "IPv6 CIDR with IPv4 except",
+			args: args{
+				cidr:    "::/0",
+				excepts: []string{"10.0.0.0/8"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IPv4 CIDR with IPv6 except",
+			args: args{
+				cidr:    "10.0.0.0/8",
+				excepts: []string{"2001:db8::/32"},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		got, err := GetCIDRRangesWithExcept(tt.args.cidr, tt.args.excepts)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s failed: expected error", tt.name)
+			}
+			continue
+		}
 		if err != nil {
 			t.Errorf("%s failed: %s", tt.name, err)
 		}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 )
 
@@ -24,14 +29,34 @@ var (
 	AnnotationForceRestore   = "force_restore"
 	RestoreStatusInitial     = "INITIAL"
 	RestoreStatusSuccess     = "SUCCESS"
+	// OperatorRestoreName is the name of the OperatorRestore recording the restore progress.
+	OperatorRestoreName = "nsx-operator-restore"
 )
 
+// maxRestoreStatusItems is the maximum number of the CRs or NSX resources listed for a reconciler in the
+// OperatorRestore status, the status is bounded by the object size limit of the API server.
+const maxRestoreStatusItems = 100
+
 type ReconcilerProvider interface {
 	RestoreReconcile() error
 	CollectGarbage(ctx context.Context) error
 	StartController(mgr ctrl.Manager, hookServer webhook.Server) error
 }
 
+// RestorePlan is the changes a reconciler would make in the restore.
+type RestorePlan struct {
+	// CRsToRestore is the namespaced names of the CRs which would be re-realized.
+	CRsToRestore []string
+	// NSXResourcesToDelete is the paths of the NSX resources which would be deleted by the garbage collection.
+	NSXResourcesToDelete []string
+}
+
+// RestoreDryRunner is implemented by the reconcilers supporting the restore dry run. RestoreDryRun returns the
+// changes RestoreReconcile and CollectGarbage would make without changing NSX or the CRs.
+type RestoreDryRunner interface {
+	RestoreDryRun(ctx context.Context) (*RestorePlan, error)
+}
+
 func CompareNSXRestore(k8sClient client.Client, nsxClient *nsx.Client) (bool, error) {
 	ctx := context.TODO()
 	gvk := schema.GroupVersionKind{
@@ -125,36 +150,263 @@ func updateRestoreEndTime(k8sClient client.Client) error {
 	})
 }
 
+// ProcessRestore collects the garbage and re-realizes the CRs of the reconcilers, the progress is recorded to the
+// OperatorRestore status. The changes reported by the previous dry run are kept in the status.
+//
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=operatorrestores,verbs=get;create;update
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=operatorrestores/status,verbs=get;update
 func ProcessRestore(reconcilerList []ReconcilerProvider, client client.Client) error {
 	log.Info("Enter restore mode")
+	recorder := newRestoreRecorder(client, reconcilerList, false)
 	var errList []error
 	// Collect Garbage with reverse order, e.g. SubnetPort -> Subnet -> VPC
 	for i := len(reconcilerList) - 1; i >= 0; i-- {
 		if reconcilerList[i] != nil {
-			if err := reconcilerList[i].CollectGarbage(context.TODO()); err != nil {
+			err := reconcilerList[i].CollectGarbage(context.TODO())
+			if err != nil {
 				errList = append(errList, err)
 			}
+			recorder.record(i, func(status *v1alpha1.ReconcilerRestoreStatus) {
+				status.GarbageCollection = restorePhase(err)
+				setRestoreError(status, err)
+			})
 		}
 	}
 	if len(errList) > 0 {
+		recorder.finish(v1alpha1.RestorePhaseFailed)
 		return fmt.Errorf("failed to collect garbage: %v", errList)
 	}
 	log.Info("Garbage collection succeeds in restore mode")
 	// Restore resource in order, e.g. VPC -> Subnet -> SubnetPort
-	for _, reconciler := range reconcilerList {
+	for i, reconciler := range reconcilerList {
 		if reconciler != nil {
-			if err := reconciler.RestoreReconcile(); err != nil {
+			err := reconciler.RestoreReconcile()
+			if err != nil {
 				errList = append(errList, err)
 			}
+			recorder.record(i, func(status *v1alpha1.ReconcilerRestoreStatus) {
+				status.Restore = restorePhase(err)
+				setRestoreError(status, err)
+			})
 		}
 	}
 	if len(errList) > 0 {
+		recorder.finish(v1alpha1.RestorePhaseFailed)
 		return fmt.Errorf("failed to restore resources: %v", errList)
 	}
 	log.Info("Restore reconcile succeeds in restore mode")
 
 	if err := updateRestoreEndTime(client); err != nil {
+		recorder.finish(v1alpha1.RestorePhaseFailed)
 		return fmt.Errorf("failed to update restore end time: %w", err)
 	}
+	recorder.finish(v1alpha1.RestorePhaseSucceeded)
+	return nil
+}
+
+// ProcessRestoreDryRun reports the CRs which would be re-realized and the NSX resources which would be deleted by
+// the garbage collection to the OperatorRestore status, without changing NSX or the restore end time. The
+// reconcilers not implementing RestoreDryRunner are reported as skipped.
+func ProcessRestoreDryRun(reconcilerList []ReconcilerProvider, client client.Client) error {
+	log.Info("Enter restore dry run mode")
+	recorder := newRestoreRecorder(client, reconcilerList, true)
+	var errList []error
+	for i, reconciler := range reconcilerList {
+		if reconciler == nil {
+			continue
+		}
+		dryRunner, ok := reconciler.(RestoreDryRunner)
+		if !ok {
+			recorder.record(i, func(status *v1alpha1.ReconcilerRestoreStatus) {
+				status.GarbageCollection = v1alpha1.RestorePhaseSkipped
+				status.Restore = v1alpha1.RestorePhaseSkipped
+				status.Error = "dry run is not supported"
+			})
+			continue
+		}
+		plan, err := dryRunner.RestoreDryRun(context.TODO())
+		if err != nil {
+			errList = append(errList, err)
+			recorder.record(i, func(status *v1alpha1.ReconcilerRestoreStatus) {
+				status.GarbageCollection = v1alpha1.RestorePhaseFailed
+				status.Restore = v1alpha1.RestorePhaseFailed
+				setRestoreError(status, err)
+			})
+			continue
+		}
+		log.Info("Restore dry run of reconciler completed", "reconciler", reconcilerName(reconciler),
+			"CRsToRestore", len(plan.CRsToRestore), "NSXResourcesToDelete", len(plan.NSXResourcesToDelete))
+		recorder.record(i, func(status *v1alpha1.ReconcilerRestoreStatus) {
+			status.GarbageCollection = v1alpha1.RestorePhaseDryRunCompleted
+			status.Restore = v1alpha1.RestorePhaseDryRunCompleted
+			status.CRsToRestoreCount = len(plan.CRsToRestore)
+			status.CRsToRestore = truncateRestoreStatusItems(plan.CRsToRestore)
+			status.NSXResourcesToDeleteCount = len(plan.NSXResourcesToDelete)
+			status.NSXResourcesToDelete = truncateRestoreStatusItems(plan.NSXResourcesToDelete)
+		})
+	}
+	if len(errList) > 0 {
+		recorder.finish(v1alpha1.RestorePhaseFailed)
+		return fmt.Errorf("failed to dry run restore: %v", errList)
+	}
+	recorder.finish(v1alpha1.RestorePhaseDryRunCompleted)
 	return nil
 }
+
+// IsRestoreDryRun returns true if the restore is requested to dry run by the spec of the OperatorRestore. false is
+// returned if the OperatorRestore or its CRD does not exist.
+func IsRestoreDryRun(k8sClient client.Client) (bool, error) {
+	obj := &v1alpha1.OperatorRestore{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Name: OperatorRestoreName}, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get OperatorRestore %s: %w", OperatorRestoreName, err)
+	}
+	return obj.Spec.DryRun, nil
+}
+
+// WaitForRestoreCommit blocks until the dry run is turned off in the spec of the OperatorRestore.
+func WaitForRestoreCommit(k8sClient client.Client, interval time.Duration) error {
+	log.Info("Waiting for the restore to be committed by setting spec.dryRun of OperatorRestore to false", "OperatorRestore", OperatorRestoreName)
+	return wait.PollUntilContextCancel(context.TODO(), interval, false, func(_ context.Context) (bool, error) {
+		dryRun, err := IsRestoreDryRun(k8sClient)
+		if err != nil {
+			log.Error(err, "Failed to check restore dry run")
+			return false, nil
+		}
+		return !dryRun, nil
+	})
+}
+
+// restoreRecorder records the restore progress to the status of the OperatorRestore. The failure to record is only
+// logged, so that the restore itself does not fail because of the status.
+type restoreRecorder struct {
+	client client.Client
+	status v1alpha1.OperatorRestoreStatus
+	// indexes maps the index in the reconciler list to the index in status.Reconcilers.
+	indexes map[int]int
+}
+
+func newRestoreRecorder(k8sClient client.Client, reconcilerList []ReconcilerProvider, dryRun bool) *restoreRecorder {
+	now := metav1.Now()
+	recorder := &restoreRecorder{
+		client: k8sClient,
+		status: v1alpha1.OperatorRestoreStatus{
+			Phase:     v1alpha1.RestorePhaseRunning,
+			DryRun:    dryRun,
+			StartTime: &now,
+		},
+		indexes: make(map[int]int),
+	}
+	for i, reconciler := range reconcilerList {
+		if reconciler == nil {
+			continue
+		}
+		recorder.indexes[i] = len(recorder.status.Reconcilers)
+		recorder.status.Reconcilers = append(recorder.status.Reconcilers, v1alpha1.ReconcilerRestoreStatus{
+			Name:              reconcilerName(reconciler),
+			GarbageCollection: v1alpha1.RestorePhasePending,
+			Restore:           v1alpha1.RestorePhasePending,
+		})
+	}
+	if !dryRun {
+		recorder.keepDryRunPlans()
+	}
+	recorder.save()
+	return recorder
+}
+
+// keepDryRunPlans copies the CRs and the NSX resources reported by the previous dry run to the status, so that the
+// committed restore can be compared with what the dry run reported.
+func (r *restoreRecorder) keepDryRunPlans() {
+	if r.client == nil {
+		return
+	}
+	obj := &v1alpha1.OperatorRestore{}
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: OperatorRestoreName}, obj); err != nil {
+		if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			log.Error(err, "Failed to get the dry run result", "OperatorRestore", OperatorRestoreName)
+		}
+		return
+	}
+	if !obj.Status.DryRun {
+		return
+	}
+	// The reconcilers are in the same order in the dry run, which is run in the same process before the commit.
+	for i := range r.status.Reconcilers {
+		if i >= len(obj.Status.Reconcilers) || obj.Status.Reconcilers[i].Name != r.status.Reconcilers[i].Name {
+			continue
+		}
+		planned := obj.Status.Reconcilers[i]
+		r.status.Reconcilers[i].CRsToRestore = planned.CRsToRestore
+		r.status.Reconcilers[i].CRsToRestoreCount = planned.CRsToRestoreCount
+		r.status.Reconcilers[i].NSXResourcesToDelete = planned.NSXResourcesToDelete
+		r.status.Reconcilers[i].NSXResourcesToDeleteCount = planned.NSXResourcesToDeleteCount
+	}
+}
+
+func (r *restoreRecorder) record(i int, update func(status *v1alpha1.ReconcilerRestoreStatus)) {
+	index, ok := r.indexes[i]
+	if !ok {
+		return
+	}
+	update(&r.status.Reconcilers[index])
+	r.save()
+}
+
+func (r *restoreRecorder) finish(phase v1alpha1.RestorePhase) {
+	now := metav1.Now()
+	r.status.Phase = phase
+	r.status.EndTime = &now
+	r.save()
+}
+
+// save writes the status to the OperatorRestore, which is created if it does not exist.
+func (r *restoreRecorder) save() {
+	if r.client == nil {
+		return
+	}
+	ctx := context.TODO()
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj := &v1alpha1.OperatorRestore{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: OperatorRestoreName}, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			obj.SetName(OperatorRestoreName)
+			if err = r.client.Create(ctx, obj); err != nil {
+				return err
+			}
+		}
+		obj.Status = *r.status.DeepCopy()
+		return r.client.Status().Update(ctx, obj)
+	})
+	if err != nil {
+		log.Error(err, "Failed to update OperatorRestore status", "OperatorRestore", OperatorRestoreName)
+	}
+}
+
+func reconcilerName(reconciler ReconcilerProvider) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", reconciler), "*")
+}
+
+func restorePhase(err error) v1alpha1.RestorePhase {
+	if err != nil {
+		return v1alpha1.RestorePhaseFailed
+	}
+	return v1alpha1.RestorePhaseSucceeded
+}
+
+func setRestoreError(status *v1alpha1.ReconcilerRestoreStatus, err error) {
+	if err != nil {
+		status.Error = err.Error()
+	}
+}
+
+func truncateRestoreStatusItems(items []string) []string {
+	if len(items) > maxRestoreStatusItems {
+		return items[:maxRestoreStatusItems]
+	}
+	return items
+}
@@ -10,14 +10,19 @@ import (
 
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
 	"go.uber.org/mock/gomock"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 	mock_client "github.com/vmware-tanzu/nsx-operator/pkg/mock/controller-runtime/client"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx"
 	"github.com/vmware-tanzu/nsx-operator/pkg/nsx/services/common"
@@ -295,6 +300,150 @@ func TestProcessRestore(t *testing.T) {
 
 }
 
+func newOperatorRestoreClient() client.Client {
+	scheme := runtime.NewScheme()
+	v1alpha1.AddToScheme(scheme)
+	return fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&v1alpha1.OperatorRestore{}).Build()
+}
+
+func getOperatorRestore(t *testing.T, k8sClient client.Client) *v1alpha1.OperatorRestore {
+	obj := &v1alpha1.OperatorRestore{}
+	require.NoError(t, k8sClient.Get(context.TODO(), types.NamespacedName{Name: OperatorRestoreName}, obj))
+	return obj
+}
+
+func TestProcessRestoreStatus(t *testing.T) {
+	reconcilerList := []ReconcilerProvider{
+		&fakeReconcilerProvider{"VPC reconciler"},
+		nil,
+		&fakeReconcilerProvider{"Subnet reconciler"},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		k8sClient := newOperatorRestoreClient()
+		patches := gomonkey.ApplyFunc(updateRestoreEndTime, func(k8sClient client.Client) error {
+			return nil
+		})
+		defer patches.Reset()
+		require.NoError(t, ProcessRestore(reconcilerList, k8sClient))
+
+		obj := getOperatorRestore(t, k8sClient)
+		assert.Equal(t, v1alpha1.RestorePhaseSucceeded, obj.Status.Phase)
+		assert.False(t, obj.Status.DryRun)
+		assert.NotNil(t, obj.Status.StartTime)
+		assert.NotNil(t, obj.Status.EndTime)
+		require.Len(t, obj.Status.Reconcilers, 2)
+		for _, status := range obj.Status.Reconcilers {
+			assert.Equal(t, "util.fakeReconcilerProvider", status.Name)
+			assert.Equal(t, v1alpha1.RestorePhaseSucceeded, status.GarbageCollection)
+			assert.Equal(t, v1alpha1.RestorePhaseSucceeded, status.Restore)
+			assert.Empty(t, status.Error)
+		}
+	})
+
+	t.Run("RestoreError", func(t *testing.T) {
+		k8sClient := newOperatorRestoreClient()
+		patches := gomonkey.ApplyFunc((*fakeReconcilerProvider).RestoreReconcile, func(r *fakeReconcilerProvider) error {
+			if r.Name == "Subnet reconciler" {
+				return fmt.Errorf("mocked restore error for Subnet")
+			}
+			return nil
+		})
+		defer patches.Reset()
+		require.Error(t, ProcessRestore(reconcilerList, k8sClient))
+
+		obj := getOperatorRestore(t, k8sClient)
+		assert.Equal(t, v1alpha1.RestorePhaseFailed, obj.Status.Phase)
+		require.Len(t, obj.Status.Reconcilers, 2)
+		assert.Equal(t, v1alpha1.RestorePhaseSucceeded, obj.Status.Reconcilers[0].Restore)
+		assert.Equal(t, v1alpha1.RestorePhaseSucceeded, obj.Status.Reconcilers[1].GarbageCollection)
+		assert.Equal(t, v1alpha1.RestorePhaseFailed, obj.Status.Reconcilers[1].Restore)
+		assert.Equal(t, "mocked restore error for Subnet", obj.Status.Reconcilers[1].Error)
+	})
+}
+
+func TestProcessRestoreDryRun(t *testing.T) {
+	var crs []string
+	for i := 0; i < maxRestoreStatusItems+1; i++ {
+		crs = append(crs, fmt.Sprintf("ns/subnet-%d", i))
+	}
+	dryRunner := &fakeDryRunReconciler{plan: &RestorePlan{
+		CRsToRestore:         crs,
+		NSXResourcesToDelete: []string{"/orgs/default/projects/default/vpcs/vpc/subnets/stale"},
+	}}
+	reconcilerList := []ReconcilerProvider{
+		&fakeReconcilerProvider{"VPC reconciler"},
+		dryRunner,
+	}
+	k8sClient := newOperatorRestoreClient()
+	patches := gomonkey.ApplyFunc((*fakeReconcilerProvider).CollectGarbage, func(r *fakeReconcilerProvider, ctx context.Context) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	patches.ApplyFunc(updateRestoreEndTime, func(k8sClient client.Client) error {
+		assert.FailNow(t, "should not be called")
+		return nil
+	})
+	defer func() { patches.Reset() }()
+
+	require.NoError(t, ProcessRestoreDryRun(reconcilerList, k8sClient))
+	obj := getOperatorRestore(t, k8sClient)
+	assert.Equal(t, v1alpha1.RestorePhaseDryRunCompleted, obj.Status.Phase)
+	assert.True(t, obj.Status.DryRun)
+	require.Len(t, obj.Status.Reconcilers, 2)
+	assert.Equal(t, v1alpha1.RestorePhaseSkipped, obj.Status.Reconcilers[0].GarbageCollection)
+	assert.Equal(t, v1alpha1.RestorePhaseSkipped, obj.Status.Reconcilers[0].Restore)
+	status := obj.Status.Reconcilers[1]
+	assert.Equal(t, "util.fakeDryRunReconciler", status.Name)
+	assert.Equal(t, v1alpha1.RestorePhaseDryRunCompleted, status.Restore)
+	assert.Equal(t, maxRestoreStatusItems+1, status.CRsToRestoreCount)
+	assert.Len(t, status.CRsToRestore, maxRestoreStatusItems)
+	assert.Equal(t, 1, status.NSXResourcesToDeleteCount)
+	assert.Equal(t, dryRunner.plan.NSXResourcesToDelete, status.NSXResourcesToDelete)
+
+	dryRunner.err = fmt.Errorf("mocked dry run error")
+	assert.ErrorContains(t, ProcessRestoreDryRun(reconcilerList, k8sClient), "mocked dry run error")
+	obj = getOperatorRestore(t, k8sClient)
+	assert.Equal(t, v1alpha1.RestorePhaseFailed, obj.Status.Phase)
+	assert.Equal(t, "mocked dry run error", obj.Status.Reconcilers[1].Error)
+
+	// The committed restore keeps the changes reported by the dry run.
+	dryRunner.err = nil
+	require.NoError(t, ProcessRestoreDryRun(reconcilerList, k8sClient))
+	patches.Reset()
+	patches = gomonkey.ApplyFunc(updateRestoreEndTime, func(k8sClient client.Client) error {
+		return nil
+	})
+	require.NoError(t, ProcessRestore(reconcilerList, k8sClient))
+	obj = getOperatorRestore(t, k8sClient)
+	assert.Equal(t, v1alpha1.RestorePhaseSucceeded, obj.Status.Phase)
+	assert.False(t, obj.Status.DryRun)
+	status = obj.Status.Reconcilers[1]
+	assert.Equal(t, v1alpha1.RestorePhaseSucceeded, status.Restore)
+	assert.Equal(t, maxRestoreStatusItems+1, status.CRsToRestoreCount)
+	assert.Len(t, status.CRsToRestore, maxRestoreStatusItems)
+	assert.Equal(t, dryRunner.plan.NSXResourcesToDelete, status.NSXResourcesToDelete)
+	assert.Empty(t, obj.Status.Reconcilers[0].CRsToRestore)
+}
+
+func TestIsRestoreDryRun(t *testing.T) {
+	k8sClient := newOperatorRestoreClient()
+	dryRun, err := IsRestoreDryRun(k8sClient)
+	require.NoError(t, err)
+	assert.False(t, dryRun)
+
+	obj := &v1alpha1.OperatorRestore{Spec: v1alpha1.OperatorRestoreSpec{DryRun: true}}
+	obj.SetName(OperatorRestoreName)
+	require.NoError(t, k8sClient.Create(context.TODO(), obj))
+	dryRun, err = IsRestoreDryRun(k8sClient)
+	require.NoError(t, err)
+	assert.True(t, dryRun)
+
+	obj.Spec.DryRun = false
+	require.NoError(t, k8sClient.Update(context.TODO(), obj))
+	assert.NoError(t, WaitForRestoreCommit(k8sClient, time.Millisecond))
+}
+
 type fakeStatusClient struct{}
 
 func (c *fakeStatusClient) Get(restoreComponentParam *string) (model.ClusterRestoreStatus, error) {
@@ -316,3 +465,13 @@ func (r *fakeReconcilerProvider) CollectGarbage(ctx context.Context) error {
 func (r *fakeReconcilerProvider) StartController(mgr ctrl.Manager, hookServer webhook.Server) error {
 	return nil
 }
+
+type fakeDryRunReconciler struct {
+	fakeReconcilerProvider
+	plan *RestorePlan
+	err  error
+}
+
+func (r *fakeDryRunReconciler) RestoreDryRun(ctx context.Context) (*RestorePlan, error) {
+	return r.plan, r.err
+}
@@ -395,6 +395,10 @@ func BuildBasicTags(cluster string, obj interface{}, namespaceID types.UID) []mo
 			tags = append(tags, model.Tag{Scope: String(common.TagScopeStatefulSetName), Tag: String(ref.Name)})
 			tags = append(tags, model.Tag{Scope: String(common.TagScopeStatefulSetUID), Tag: String(string(ref.UID))})
 		}
+	case *v1.Service:
+		tags = append(tags, model.Tag{Scope: String(common.TagScopeNamespace), Tag: String(i.ObjectMeta.Namespace)})
+		tags = append(tags, model.Tag{Scope: String(common.TagScopeServiceName), Tag: String(i.ObjectMeta.Name)})
+		tags = append(tags, model.Tag{Scope: String(common.TagScopeServiceUID), Tag: String(string(i.UID))})
 	case *v1alpha1.NetworkInfo:
 		tags = append(tags, model.Tag{Scope: String(common.TagScopeNamespace), Tag: String(i.ObjectMeta.Namespace)})
 	case *v1alpha1.IPAddressAllocation: